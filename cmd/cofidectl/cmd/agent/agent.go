@@ -0,0 +1,202 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cofide/cofidectl/internal/pkg/spireagent"
+	"github.com/cofide/cofidectl/internal/pkg/trustprovider"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/spf13/cobra"
+)
+
+type AgentCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewAgentCommand(cmdCtx *cmdcontext.CommandContext) *AgentCommand {
+	return &AgentCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var agentRootCmdDesc = `
+This command consists of multiple sub-commands to manage SPIRE agents.
+`
+
+func (c *AgentCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "agent config|token|list|show|evict|ban [ARGS]",
+		Short: "Manage SPIRE agents",
+		Long:  agentRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.GetConfigCommand(),
+		c.GetTokenCommand(),
+		c.GetListCommand(),
+		c.GetShowCommand(),
+		c.GetEvictCommand(),
+		c.GetBanCommand(),
+	)
+
+	return cmd
+}
+
+var agentConfigCmdDesc = `
+This command will generate the configuration for a SPIRE agent in a trust zone that runs outside
+of a cluster managed by cofidectl, such as on a VM or bare-metal host.
+
+The following files are written to the output directory, and should be installed in the
+directory specified by --config-dir on the host:
+
+  agent.conf            SPIRE agent configuration
+  bundle.crt            Trust bundle of the trust zone, used to bootstrap the agent
+  spire-agent.service   systemd unit for the agent (with --systemd)
+
+The SPIRE server address defaults to the host of the trust zone's bundle endpoint, which is set
+once the trust zone has been deployed. The node attestor defaults to join_token and the workload
+attestor to unix. For an agent running in a Kubernetes cluster, specify --node-attestor k8s_psat
+and the cluster with --cluster, whose trust provider determines the workload attestor.
+`
+
+type configOpts struct {
+	trustZone         string
+	clusterName       string
+	outputDir         string
+	configDir         string
+	dataDir           string
+	socketPath        string
+	serverAddress     string
+	serverPort        int
+	logLevel          string
+	joinToken         string
+	nodeAttestor      string
+	workloadAttestors []string
+	insecureBootstrap bool
+	systemd           bool
+	binaryPath        string
+}
+
+func (c *AgentCommand) GetConfigCommand() *cobra.Command {
+	opts := configOpts{}
+	cmd := &cobra.Command{
+		Use:   "config [ARGS]",
+		Short: "Generate SPIRE agent configuration for a trust zone",
+		Long:  agentConfigCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := writeConfig(ctx, ds, opts); err != nil {
+				return err
+			}
+			fmt.Printf("Wrote SPIRE agent configuration to %s\n", opts.outputDir)
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the agent")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster in the trust zone running the agent (required with the k8s_psat node attestor)")
+	f.StringVar(&opts.outputDir, "output-dir", "spire-agent", "Directory to write the configuration files to")
+	f.StringVar(&opts.configDir, "config-dir", spireagent.DefaultConfigDir, "Directory on the host from which the agent reads the configuration files")
+	f.StringVar(&opts.dataDir, "data-dir", spireagent.DefaultDataDir, "Directory on the host in which the agent stores its data")
+	f.StringVar(&opts.socketPath, "socket-path", spireagent.DefaultSocketPath, "Path on the host of the SPIFFE Workload API socket")
+	f.StringVar(&opts.serverAddress, "server-address", "", "Address of the SPIRE server, defaults to the host of the trust zone's bundle endpoint")
+	f.IntVar(&opts.serverPort, "server-port", spireagent.DefaultServerPort, "Port of the SPIRE server")
+	f.StringVar(&opts.logLevel, "agent-log-level", spireagent.DefaultLogLevel, "Log level of the agent")
+	f.StringVar(&opts.joinToken, "join-token", "", "Join token to include in the configuration")
+	f.StringVar(&opts.nodeAttestor, "node-attestor", "", fmt.Sprintf("Node attestor, one of %s", strings.Join(spireagent.NodeAttestors, ", ")))
+	f.StringSliceVar(&opts.workloadAttestors, "workload-attestor", nil, fmt.Sprintf("Workload attestors, any of %s", strings.Join(spireagent.WorkloadAttestors, ", ")))
+	f.BoolVar(&opts.insecureBootstrap, "insecure-bootstrap", false, "Bootstrap the agent without a trust bundle if the trust zone does not have one")
+	f.BoolVar(&opts.systemd, "systemd", false, "Generate a systemd unit for the agent")
+	f.StringVar(&opts.binaryPath, "binary-path", spireagent.DefaultBinaryPath, "Path on the host of the spire-agent binary, used in the systemd unit")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+// writeConfig generates the SPIRE agent configuration files and writes them to the output
+// directory.
+func writeConfig(ctx context.Context, ds datasource.DataSourceV2, opts configOpts) error {
+	if opts.nodeAttestor == spireagent.NodeAttestorK8sPSAT && opts.clusterName == "" {
+		return errors.New("the k8s_psat node attestor requires --cluster")
+	}
+	if opts.clusterName != "" && opts.nodeAttestor != spireagent.NodeAttestorK8sPSAT {
+		return errors.New("--cluster can only be used with the k8s_psat node attestor")
+	}
+
+	trustZone, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
+	if err != nil {
+		return err
+	}
+
+	agentOpts := spireagent.Opts{
+		ServerAddress:     opts.serverAddress,
+		ServerPort:        opts.serverPort,
+		ConfigDir:         opts.configDir,
+		DataDir:           opts.dataDir,
+		SocketPath:        opts.socketPath,
+		LogLevel:          opts.logLevel,
+		JoinToken:         opts.joinToken,
+		NodeAttestor:      opts.nodeAttestor,
+		WorkloadAttestors: opts.workloadAttestors,
+		InsecureBootstrap: opts.insecureBootstrap,
+	}
+
+	if opts.clusterName != "" {
+		cluster, err := trustzone.GetClusterFromTrustZoneByName(trustZone, opts.clusterName, datasource.ToV1(ctx, ds))
+		if err != nil {
+			return err
+		}
+		agentOpts.TrustProvider, err = trustprovider.NewTrustProvider(cluster.GetTrustProvider())
+		if err != nil {
+			return err
+		}
+		agentOpts.ClusterName = cluster.GetName()
+	}
+
+	files, err := spireagent.Generate(trustZone, agentOpts)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.outputDir, 0o755); err != nil {
+		return err
+	}
+
+	// The agent's configuration may contain a join token.
+	if err := os.WriteFile(filepath.Join(opts.outputDir, spireagent.ConfigFileName), files.Config, 0o600); err != nil {
+		return err
+	}
+
+	if files.Bundle != nil {
+		if err := os.WriteFile(filepath.Join(opts.outputDir, spireagent.BundleFileName), files.Bundle, 0o644); err != nil {
+			return err
+		}
+	}
+
+	if opts.systemd {
+		unit := spireagent.SystemdUnit(opts.binaryPath, opts.configDir)
+		if err := os.WriteFile(filepath.Join(opts.outputDir, spireagent.SystemdUnitFileName), unit, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		opts             configOpts
+		wantNodeAttestor string
+		wantFiles        []string
+		wantErrString    string
+	}{
+		{
+			name:             "defaults",
+			opts:             configOpts{trustZone: "tz1"},
+			wantNodeAttestor: "join_token",
+			wantFiles:        []string{"agent.conf", "bundle.crt"},
+		},
+		{
+			name:             "cluster trust provider and systemd",
+			opts:             configOpts{trustZone: "tz1", clusterName: "local1", nodeAttestor: "k8s_psat", systemd: true},
+			wantNodeAttestor: "k8s_psat",
+			wantFiles:        []string{"agent.conf", "bundle.crt", "spire-agent.service"},
+		},
+		{
+			name:          "k8s_psat without cluster",
+			opts:          configOpts{trustZone: "tz1", nodeAttestor: "k8s_psat"},
+			wantErrString: "the k8s_psat node attestor requires --cluster",
+		},
+		{
+			name:          "cluster without k8s_psat",
+			opts:          configOpts{trustZone: "tz1", clusterName: "local1"},
+			wantErrString: "--cluster can only be used with the k8s_psat node attestor",
+		},
+		{
+			name:          "unknown cluster",
+			opts:          configOpts{trustZone: "tz1", clusterName: "invalid", nodeAttestor: "k8s_psat"},
+			wantErrString: "cluster \"invalid\" not found in trust zone \"tz1\"",
+		},
+		{
+			name:          "unknown trust zone",
+			opts:          configOpts{trustZone: "invalid"},
+			wantErrString: "failed to find trust zone invalid in local config",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := newFakeDataSource(t, defaultConfig())
+			tt.opts.outputDir = filepath.Join(t.TempDir(), "agent")
+
+			err := writeConfig(context.Background(), datasource.FromV1(ds), tt.opts)
+			if tt.wantErrString != "" {
+				require.ErrorContains(t, err, tt.wantErrString)
+				return
+			}
+			require.NoError(t, err)
+
+			entries, err := os.ReadDir(tt.opts.outputDir)
+			require.NoError(t, err)
+			var files []string
+			for _, entry := range entries {
+				files = append(files, entry.Name())
+			}
+			assert.Equal(t, tt.wantFiles, files)
+
+			data, err := os.ReadFile(filepath.Join(tt.opts.outputDir, "agent.conf"))
+			require.NoError(t, err)
+			var agentConfig struct {
+				Plugins struct {
+					NodeAttestor map[string]any
+				}
+			}
+			require.NoError(t, json.Unmarshal(data, &agentConfig))
+			assert.Contains(t, agentConfig.Plugins.NodeAttestor, tt.wantNodeAttestor)
+		})
+	}
+}
+
+func newFakeDataSource(t *testing.T, cfg *config.Config) datasource.DataSource {
+	configLoader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	lds, err := local.NewLocalDataSource(configLoader)
+	require.NoError(t, err)
+	return lds
+}
+
+func defaultConfig() *config.Config {
+	return &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+		},
+		Clusters: []*clusterpb.Cluster{
+			fixtures.Cluster("local1"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/spf13/cobra"
+)
+
+var agentListCmdDesc = `
+This command will list the SPIRE agents attested to the SPIRE server of a trust zone, along with
+the status of the SPIRE agent pods in the cluster.
+
+The list may be filtered by attestation type, SVID expiry and whether the agents can re-attest.
+--expires-before accepts either a duration relative to now (e.g. 24h) or an RFC3339 timestamp.
+`
+
+type agentFilter struct {
+	attestationType string
+	expiresBefore   string
+	canReattest     *bool
+}
+
+type listOpts struct {
+	trustZone   string
+	clusterName string
+	filter      agentFilter
+	output      string
+}
+
+func (c *AgentCommand) GetListCommand() *cobra.Command {
+	opts := listOpts{}
+	var canReattest bool
+	cmd := &cobra.Command{
+		Use:   "list [ARGS]",
+		Short: "List SPIRE agents in a trust zone",
+		Long:  agentListCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output, outputText, outputJSON); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("can-reattest") {
+				opts.filter.canReattest = &canReattest
+			}
+
+			client, err := c.getKubeClient(cmd, opts.trustZone, opts.clusterName)
+			if err != nil {
+				return err
+			}
+
+			status, err := spire.GetAgentStatus(cmd.Context(), client)
+			if err != nil {
+				return fmt.Errorf("failed to list agents: %w", err)
+			}
+
+			agents, err := filterAgents(status.Agents, opts.filter, time.Now())
+			if err != nil {
+				return err
+			}
+			return renderAgents(os.Stdout, agents, opts.output)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the agents")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.StringVar(&opts.filter.attestationType, "attestation-type", "", "Only list agents with this attestation type, e.g. k8s_psat or join_token")
+	f.StringVar(&opts.filter.expiresBefore, "expires-before", "", "Only list agents whose SVIDs expire before this duration from now or RFC3339 timestamp")
+	f.BoolVar(&canReattest, "can-reattest", false, "Only list agents that can (true) or cannot (false) re-attest")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text or json")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+var agentShowCmdDesc = `
+This command will show the details of a SPIRE agent in a trust zone. The agent may be specified by
+its SPIFFE ID or by the name of its pod.
+`
+
+type showOpts struct {
+	trustZone   string
+	clusterName string
+	output      string
+}
+
+func (c *AgentCommand) GetShowCommand() *cobra.Command {
+	opts := showOpts{}
+	cmd := &cobra.Command{
+		Use:   "show SPIFFE_ID|POD [ARGS]",
+		Short: "Show a SPIRE agent in a trust zone",
+		Long:  agentShowCmdDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output, outputText, outputJSON); err != nil {
+				return err
+			}
+
+			client, err := c.getKubeClient(cmd, opts.trustZone, opts.clusterName)
+			if err != nil {
+				return err
+			}
+
+			agent, err := getAgent(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+			return renderAgent(os.Stdout, agent, opts.output)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the agent")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text or json")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+var agentEvictCmdDesc = `
+This command will evict a SPIRE agent from the SPIRE server of a trust zone. The agent may be
+specified by its SPIFFE ID or by the name of its pod.
+
+An evicted agent must re-attest to the SPIRE server before it is issued a new SVID. Agents that
+cannot re-attest, such as those attested using a join token, require a new join token.
+`
+
+var agentBanCmdDesc = `
+This command will ban a SPIRE agent from the SPIRE server of a trust zone. The agent may be
+specified by its SPIFFE ID or by the name of its pod.
+
+A banned agent is not able to re-attest to the SPIRE server using the same SPIFFE ID until it has
+been evicted. Use this command when the node running the agent has been compromised.
+`
+
+type agentActionOpts struct {
+	trustZone   string
+	clusterName string
+	yes         bool
+}
+
+func (c *AgentCommand) GetEvictCommand() *cobra.Command {
+	return c.getAgentActionCommand("evict", "evicted", "Evict a SPIRE agent from a trust zone", agentEvictCmdDesc, spire.EvictAgent)
+}
+
+func (c *AgentCommand) GetBanCommand() *cobra.Command {
+	return c.getAgentActionCommand("ban", "banned", "Ban a SPIRE agent from a trust zone", agentBanCmdDesc, spire.BanAgent)
+}
+
+// getAgentActionCommand returns a command that performs a destructive action on a SPIRE agent,
+// after prompting the user for confirmation.
+func (c *AgentCommand) getAgentActionCommand(action, done, short, long string, fn func(context.Context, *kubeutil.Client, string) error) *cobra.Command {
+	opts := agentActionOpts{}
+	cmd := &cobra.Command{
+		Use:   action + " SPIFFE_ID|POD [ARGS]",
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := c.getKubeClient(cmd, opts.trustZone, opts.clusterName)
+			if err != nil {
+				return err
+			}
+
+			agent, err := getAgent(cmd.Context(), client, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !opts.yes {
+				prompt := fmt.Sprintf("Are you sure you want to %s agent %s in trust zone %s?", action, agent.Id, opts.trustZone)
+				ok, err := confirm(os.Stdin, os.Stdout, prompt)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					fmt.Println("Aborted")
+					return nil
+				}
+			}
+
+			if err := fn(cmd.Context(), client, agent.Id); err != nil {
+				return fmt.Errorf("failed to %s agent %s: %w", action, agent.Id, err)
+			}
+			fmt.Printf("Agent %s %s\n", agent.Id, done)
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the agent")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+// getKubeClient returns a Kubernetes client for the cluster running the SPIRE server of a
+// trust zone.
+func (c *AgentCommand) getKubeClient(cmd *cobra.Command, tzName, clusterName string) (*kubeutil.Client, error) {
+	ctx := cmd.Context()
+	ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfig, err := cmd.Flags().GetString("kube-config")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the kubeconfig file location")
+	}
+
+	_, client, err := trustzone.GetServerClient(datasource.ToV1(ctx, ds), kubeConfig, tzName, clusterName)
+	return client, err
+}
+
+// getAgent retrieves a SPIRE agent by its SPIFFE ID or pod name. The pod name and status are
+// populated from the SPIRE agent pods in the cluster.
+func getAgent(ctx context.Context, client *kubeutil.Client, idOrPod string) (*spire.Agent, error) {
+	status, err := spire.GetAgentStatus(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agents: %w", err)
+	}
+
+	id, err := resolveAgentID(status.Agents, idOrPod)
+	if err != nil {
+		return nil, err
+	}
+
+	agent, err := spire.GetAgent(ctx, client, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent %s: %w", id, err)
+	}
+
+	for _, a := range status.Agents {
+		if a.Id == agent.Id {
+			agent.Name = a.Name
+			agent.Status = a.Status
+			break
+		}
+	}
+	return agent, nil
+}
+
+// resolveAgentID returns the SPIFFE ID of an agent specified by its SPIFFE ID or pod name.
+func resolveAgentID(agents []spire.Agent, idOrPod string) (string, error) {
+	if strings.HasPrefix(idOrPod, "spiffe://") {
+		return idOrPod, nil
+	}
+	for _, agent := range agents {
+		if agent.Name == idOrPod {
+			if agent.Id == "unknown" {
+				return "", fmt.Errorf("agent pod %s has not attested to the SPIRE server", idOrPod)
+			}
+			return agent.Id, nil
+		}
+	}
+	return "", fmt.Errorf("failed to find an agent with pod name %s", idOrPod)
+}
+
+// filterAgents returns the agents that match the filter.
+func filterAgents(agents []spire.Agent, filter agentFilter, now time.Time) ([]spire.Agent, error) {
+	var expiresBefore time.Time
+	if filter.expiresBefore != "" {
+		var err error
+		expiresBefore, err = parseExpiry(filter.expiresBefore, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := []spire.Agent{}
+	for _, agent := range agents {
+		if filter.attestationType != "" && agent.AttestationType != filter.attestationType {
+			continue
+		}
+		if !expiresBefore.IsZero() && !agent.ExpirationTime.Before(expiresBefore) {
+			continue
+		}
+		if filter.canReattest != nil && agent.CanReattest != *filter.canReattest {
+			continue
+		}
+		result = append(result, agent)
+	}
+	return result, nil
+}
+
+// parseExpiry parses either a duration relative to now or an RFC3339 timestamp.
+func parseExpiry(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(d), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %q, expected a duration or an RFC3339 timestamp", value)
+	}
+	return t, nil
+}
+
+// confirm prompts the user for confirmation, returning true if the user answers yes.
+func confirm(in io.Reader, out io.Writer, prompt string) (bool, error) {
+	if _, err := fmt.Fprintf(out, "%s [y/N] ", prompt); err != nil {
+		return false, err
+	}
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+type agentJSON struct {
+	Pod             string   `json:"pod"`
+	Status          string   `json:"status"`
+	ID              string   `json:"id"`
+	AttestationType string   `json:"attestation_type"`
+	ExpiresAt       string   `json:"x509svid_expires_at"`
+	Serial          string   `json:"x509svid_serial_number"`
+	CanReattest     bool     `json:"can_reattest"`
+	Banned          bool     `json:"banned"`
+	Selectors       []string `json:"selectors,omitempty"`
+}
+
+func newAgentJSON(agent *spire.Agent) agentJSON {
+	return agentJSON{
+		Pod:             agent.Name,
+		Status:          agent.Status,
+		ID:              agent.Id,
+		AttestationType: agent.AttestationType,
+		ExpiresAt:       agent.ExpirationTime.UTC().Format(time.RFC3339),
+		Serial:          agent.Serial,
+		CanReattest:     agent.CanReattest,
+		Banned:          agent.Banned,
+		Selectors:       agent.Selectors,
+	}
+}
+
+// renderAgents writes SPIRE agents to the writer in the specified format.
+func renderAgents(w io.Writer, agents []spire.Agent, output string) error {
+	switch output {
+	case outputJSON:
+		result := []agentJSON{}
+		for i := range agents {
+			result = append(result, newAgentJSON(&agents[i]))
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	default:
+		data := make([][]string, 0, len(agents))
+		for _, agent := range agents {
+			data = append(data, []string{
+				agent.Name,
+				agent.Status,
+				agent.Id,
+				agent.AttestationType,
+				agent.ExpirationTime.UTC().Format(time.RFC3339),
+				strconv.FormatBool(agent.CanReattest),
+			})
+		}
+		_, err := renderer.NewTableRenderer(w).RenderTables(renderer.Table{
+			Header: []string{"Pod", "Status", "SPIFFE ID", "Attestation Type", "SVID Expires", "Can Re-attest"},
+			Data:   data,
+		})
+		return err
+	}
+}
+
+// renderAgent writes the details of a SPIRE agent to the writer in the specified format.
+func renderAgent(w io.Writer, agent *spire.Agent, output string) error {
+	switch output {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(newAgentJSON(agent))
+	default:
+		_, err := renderer.NewTableRenderer(w).RenderTables(renderer.Table{
+			Header: []string{"Field", "Value"},
+			Data: [][]string{
+				{"Pod", agent.Name},
+				{"Status", agent.Status},
+				{"SPIFFE ID", agent.Id},
+				{"Attestation Type", agent.AttestationType},
+				{"SVID Expires", agent.ExpirationTime.UTC().Format(time.RFC3339)},
+				{"SVID Serial", agent.Serial},
+				{"Can Re-attest", strconv.FormatBool(agent.CanReattest)},
+				{"Banned", strconv.FormatBool(agent.Banned)},
+				{"Selectors", strings.Join(agent.Selectors, "\n")},
+			},
+		})
+		return err
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+var testAgents = []spire.Agent{
+	{
+		Name:            "spire-agent-1",
+		Status:          "Running",
+		Id:              "spiffe://td1/spire/agent/k8s_psat/cluster1/node1",
+		AttestationType: "k8s_psat",
+		ExpirationTime:  now.Add(30 * time.Minute),
+		Serial:          "1",
+		CanReattest:     true,
+	},
+	{
+		Name:            "unknown",
+		Status:          "unknown",
+		Id:              "spiffe://td1/spire/agent/join_token/token1",
+		AttestationType: "join_token",
+		ExpirationTime:  now.Add(2 * time.Hour),
+		Serial:          "2",
+		CanReattest:     false,
+	},
+	{
+		Name:            "spire-agent-2",
+		Status:          "Pending",
+		Id:              "unknown",
+		AttestationType: "unknown",
+		ExpirationTime:  time.Unix(0, 0),
+		Serial:          "unknown",
+	},
+}
+
+func Test_filterAgents(t *testing.T) {
+	canReattest := false
+	tests := []struct {
+		name          string
+		filter        agentFilter
+		want          []string
+		wantErrString string
+	}{
+		{
+			name:   "no filter",
+			filter: agentFilter{},
+			want:   []string{"spiffe://td1/spire/agent/k8s_psat/cluster1/node1", "spiffe://td1/spire/agent/join_token/token1", "unknown"},
+		},
+		{
+			name:   "attestation type",
+			filter: agentFilter{attestationType: "join_token"},
+			want:   []string{"spiffe://td1/spire/agent/join_token/token1"},
+		},
+		{
+			name:   "expires before duration",
+			filter: agentFilter{attestationType: "k8s_psat", expiresBefore: "1h"},
+			want:   []string{"spiffe://td1/spire/agent/k8s_psat/cluster1/node1"},
+		},
+		{
+			name:   "expires before timestamp",
+			filter: agentFilter{expiresBefore: "2026-01-01T13:00:00Z"},
+			want:   []string{"spiffe://td1/spire/agent/k8s_psat/cluster1/node1", "unknown"},
+		},
+		{
+			name:   "cannot re-attest",
+			filter: agentFilter{attestationType: "join_token", canReattest: &canReattest},
+			want:   []string{"spiffe://td1/spire/agent/join_token/token1"},
+		},
+		{
+			name:          "invalid expiry",
+			filter:        agentFilter{expiresBefore: "tomorrow"},
+			wantErrString: "invalid expiry \"tomorrow\", expected a duration or an RFC3339 timestamp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := filterAgents(testAgents, tt.filter, now)
+			if tt.wantErrString != "" {
+				require.EqualError(t, err, tt.wantErrString)
+				return
+			}
+			require.NoError(t, err)
+			ids := []string{}
+			for _, agent := range got {
+				ids = append(ids, agent.Id)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func Test_resolveAgentID(t *testing.T) {
+	tests := []struct {
+		name          string
+		idOrPod       string
+		want          string
+		wantErrString string
+	}{
+		{name: "SPIFFE ID", idOrPod: "spiffe://td1/spire/agent/join_token/token1", want: "spiffe://td1/spire/agent/join_token/token1"},
+		{name: "pod", idOrPod: "spire-agent-1", want: "spiffe://td1/spire/agent/k8s_psat/cluster1/node1"},
+		{name: "pod not attested", idOrPod: "spire-agent-2", wantErrString: "agent pod spire-agent-2 has not attested to the SPIRE server"},
+		{name: "pod not found", idOrPod: "spire-agent-3", wantErrString: "failed to find an agent with pod name spire-agent-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveAgentID(testAgents, tt.idOrPod)
+			if tt.wantErrString != "" {
+				require.EqualError(t, err, tt.wantErrString)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_confirm(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "yes", input: "yes\n", want: true},
+		{name: "y", input: "Y\n", want: true},
+		{name: "no", input: "n\n", want: false},
+		{name: "empty", input: "\n", want: false},
+		{name: "EOF", input: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			got, err := confirm(strings.NewReader(tt.input), &out, "Continue?")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "Continue? [y/N] ", out.String())
+		})
+	}
+}
+
+func Test_renderAgents(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, renderAgents(&buf, testAgents[:1], outputJSON))
+	assert.Equal(t, `[
+  {
+    "pod": "spire-agent-1",
+    "status": "Running",
+    "id": "spiffe://td1/spire/agent/k8s_psat/cluster1/node1",
+    "attestation_type": "k8s_psat",
+    "x509svid_expires_at": "2026-01-01T12:30:00Z",
+    "x509svid_serial_number": "1",
+    "can_reattest": true,
+    "banned": false
+  }
+]
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, renderAgents(&buf, testAgents, outputText))
+	assert.Contains(t, buf.String(), "spire-agent-1")
+	assert.Contains(t, buf.String(), "spiffe://td1/spire/agent/join_token/token1")
+}
+
+func Test_renderAgent(t *testing.T) {
+	agent := testAgents[0]
+	agent.Banned = true
+	agent.Selectors = []string{"k8s_psat:cluster:cluster1"}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderAgent(&buf, &agent, outputText))
+	assert.Contains(t, buf.String(), "spiffe://td1/spire/agent/k8s_psat/cluster1/node1")
+	assert.Contains(t, buf.String(), "k8s_psat:cluster:cluster1")
+}
@@ -0,0 +1,284 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/spf13/cobra"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+)
+
+const (
+	outputText  = "text"
+	outputValue = "value"
+	outputJSON  = "json"
+)
+
+var agentTokenCmdDesc = `
+This command consists of multiple sub-commands to manage join tokens for attesting SPIRE agents.
+`
+
+func (c *AgentCommand) GetTokenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token create|list [ARGS]",
+		Short: "Manage join tokens for SPIRE agents",
+		Long:  agentTokenCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.GetTokenCreateCommand(),
+		c.GetTokenListCommand(),
+	)
+
+	return cmd
+}
+
+var agentTokenCreateCmdDesc = `
+This command will create a join token that a SPIRE agent may use to attest to the SPIRE server
+of a trust zone.
+
+The token may be passed to 'cofidectl agent config --join-token' to include it in the agent's
+configuration. Use '--output value' to print only the token, for example:
+
+  cofidectl agent config --trust-zone tz1 \
+    --join-token "$(cofidectl agent token create --trust-zone tz1 --output value)"
+`
+
+type tokenCreateOpts struct {
+	trustZone   string
+	clusterName string
+	ttl         time.Duration
+	spiffeID    string
+	output      string
+}
+
+func (c *AgentCommand) GetTokenCreateCommand() *cobra.Command {
+	opts := tokenCreateOpts{}
+	cmd := &cobra.Command{
+		Use:   "create [ARGS]",
+		Short: "Create a join token for a SPIRE agent",
+		Long:  agentTokenCreateCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output, outputText, outputValue, outputJSON); err != nil {
+				return err
+			}
+			if opts.ttl < time.Second {
+				return fmt.Errorf("invalid TTL %s, must be at least 1s", opts.ttl)
+			}
+
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+			if err != nil {
+				return err
+			}
+
+			kubeConfig, err := cmd.Flags().GetString("kube-config")
+			if err != nil {
+				return fmt.Errorf("failed to retrieve the kubeconfig file location")
+			}
+
+			trustZone, client, err := trustzone.GetServerClient(datasource.ToV1(ctx, ds), kubeConfig, opts.trustZone, opts.clusterName)
+			if err != nil {
+				return err
+			}
+
+			var spiffeID string
+			if opts.spiffeID != "" {
+				spiffeID, err = renderSPIFFEID(trustZone.GetTrustDomain(), opts.spiffeID)
+				if err != nil {
+					return err
+				}
+			}
+
+			token, err := spire.CreateJoinToken(ctx, client, opts.ttl, spiffeID)
+			if err != nil {
+				return fmt.Errorf("failed to create join token: %w", err)
+			}
+			return renderJoinToken(os.Stdout, token, trustZone.GetTrustDomain(), spiffeID, opts.output)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the agent")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.DurationVar(&opts.ttl, "ttl", time.Hour, "Time to live of the join token")
+	f.StringVar(&opts.spiffeID, "spiffe-id", "", "Optional SPIFFE ID path to alias the agent to, within the trust domain of the trust zone")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text, value or json")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+var agentTokenListCmdDesc = `
+This command will list the join tokens that have been used to attest SPIRE agents in a trust zone.
+
+SPIRE does not provide a way to list join tokens that have not yet been used.
+`
+
+type tokenListOpts struct {
+	trustZone   string
+	clusterName string
+	output      string
+}
+
+func (c *AgentCommand) GetTokenListCommand() *cobra.Command {
+	opts := tokenListOpts{}
+	cmd := &cobra.Command{
+		Use:   "list [ARGS]",
+		Short: "List join tokens used by SPIRE agents",
+		Long:  agentTokenListCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output, outputText, outputJSON); err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+			if err != nil {
+				return err
+			}
+
+			kubeConfig, err := cmd.Flags().GetString("kube-config")
+			if err != nil {
+				return fmt.Errorf("failed to retrieve the kubeconfig file location")
+			}
+
+			_, client, err := trustzone.GetServerClient(datasource.ToV1(ctx, ds), kubeConfig, opts.trustZone, opts.clusterName)
+			if err != nil {
+				return err
+			}
+
+			tokens, err := spire.ListAttestedJoinTokens(ctx, client)
+			if err != nil {
+				return fmt.Errorf("failed to list join tokens: %w", err)
+			}
+			return renderAttestedJoinTokens(os.Stdout, tokens, opts.output)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the agents")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text or json")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+// renderSPIFFEID returns a SPIFFE ID for a path in a trust domain.
+func renderSPIFFEID(trustDomain, path string) (string, error) {
+	td, err := spiffeid.TrustDomainFromString(trustDomain)
+	if err != nil {
+		return "", err
+	}
+	id, err := spiffeid.FromPath(td, "/"+strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return "", fmt.Errorf("invalid SPIFFE ID path %q: %w", path, err)
+	}
+	return id.String(), nil
+}
+
+func validateOutput(output string, allowed ...string) error {
+	if slices.Contains(allowed, output) {
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q, expected one of %s", output, strings.Join(allowed, ", "))
+}
+
+type joinTokenJSON struct {
+	Value     string `json:"value"`
+	ExpiresAt string `json:"expires_at"`
+	AgentID   string `json:"agent_id"`
+	SPIFFEID  string `json:"spiffe_id,omitempty"`
+}
+
+// renderJoinToken writes a newly created join token to the writer in the specified format.
+func renderJoinToken(w io.Writer, token *spire.JoinToken, trustDomain, spiffeID, output string) error {
+	agentID := fmt.Sprintf("spiffe://%s/spire/agent/join_token/%s", trustDomain, token.Value)
+	switch output {
+	case outputValue:
+		_, err := fmt.Fprintln(w, token.Value)
+		return err
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(joinTokenJSON{
+			Value:     token.Value,
+			ExpiresAt: token.ExpiresAt.UTC().Format(time.RFC3339),
+			AgentID:   agentID,
+			SPIFFEID:  spiffeID,
+		})
+	default:
+		data := [][]string{
+			{"Token", token.Value},
+			{"Expires", token.ExpiresAt.UTC().Format(time.RFC3339)},
+			{"Agent ID", agentID},
+		}
+		if spiffeID != "" {
+			data = append(data, []string{"SPIFFE ID", spiffeID})
+		}
+		_, err := renderer.NewTableRenderer(w).RenderTables(renderer.Table{
+			Header: []string{"Field", "Value"},
+			Data:   data,
+		})
+		return err
+	}
+}
+
+type attestedJoinTokenJSON struct {
+	Value          string   `json:"value"`
+	AgentID        string   `json:"agent_id"`
+	AliasIDs       []string `json:"alias_ids,omitempty"`
+	ExpirationTime string   `json:"x509svid_expires_at"`
+}
+
+// renderAttestedJoinTokens writes join tokens used by agents to the writer in the specified format.
+func renderAttestedJoinTokens(w io.Writer, tokens []*spire.AttestedJoinToken, output string) error {
+	switch output {
+	case outputJSON:
+		result := []attestedJoinTokenJSON{}
+		for _, token := range tokens {
+			result = append(result, attestedJoinTokenJSON{
+				Value:          token.Value,
+				AgentID:        token.AgentID,
+				AliasIDs:       token.AliasIDs,
+				ExpirationTime: token.ExpirationTime.UTC().Format(time.RFC3339),
+			})
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	default:
+		data := make([][]string, 0, len(tokens))
+		for _, token := range tokens {
+			data = append(data, []string{
+				token.Value,
+				token.AgentID,
+				strings.Join(token.AliasIDs, ", "),
+				token.ExpirationTime.UTC().Format(time.RFC3339),
+			})
+		}
+		_, err := renderer.NewTableRenderer(w).RenderTables(renderer.Table{
+			Header: []string{"Token", "Agent ID", "Alias IDs", "SVID Expires"},
+			Data:   data,
+		})
+		return err
+	}
+}
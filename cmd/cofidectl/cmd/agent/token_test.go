@@ -0,0 +1,118 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderSPIFFEID(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		want          string
+		wantErrString string
+	}{
+		{name: "path", path: "vm/vm1", want: "spiffe://td1/vm/vm1"},
+		{name: "leading slash", path: "/vm/vm1", want: "spiffe://td1/vm/vm1"},
+		{name: "invalid", path: "vm/../vm1", wantErrString: "invalid SPIFFE ID path \"vm/../vm1\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderSPIFFEID("td1", tt.path)
+			if tt.wantErrString != "" {
+				require.ErrorContains(t, err, tt.wantErrString)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_renderJoinToken(t *testing.T) {
+	token := &spire.JoinToken{Value: "fake-token", ExpiresAt: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		name     string
+		spiffeID string
+		output   string
+		want     string
+	}{
+		{
+			name:   "value",
+			output: outputValue,
+			want:   "fake-token\n",
+		},
+		{
+			name:     "json",
+			spiffeID: "spiffe://td1/vm1",
+			output:   outputJSON,
+			want: `{
+  "value": "fake-token",
+  "expires_at": "2026-01-01T12:00:00Z",
+  "agent_id": "spiffe://td1/spire/agent/join_token/fake-token",
+  "spiffe_id": "spiffe://td1/vm1"
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := renderJoinToken(&buf, token, "td1", tt.spiffeID, tt.output)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := renderJoinToken(&buf, token, "td1", "spiffe://td1/vm1", outputText)
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "fake-token")
+		assert.Contains(t, buf.String(), "2026-01-01T12:00:00Z")
+		assert.Contains(t, buf.String(), "spiffe://td1/vm1")
+	})
+}
+
+func Test_renderAttestedJoinTokens(t *testing.T) {
+	tokens := []*spire.AttestedJoinToken{
+		{
+			Value:          "token1",
+			AgentID:        "spiffe://td1/spire/agent/join_token/token1",
+			AliasIDs:       []string{"spiffe://td1/vm1"},
+			ExpirationTime: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderAttestedJoinTokens(&buf, tokens, outputJSON))
+	assert.Equal(t, `[
+  {
+    "value": "token1",
+    "agent_id": "spiffe://td1/spire/agent/join_token/token1",
+    "alias_ids": [
+      "spiffe://td1/vm1"
+    ],
+    "x509svid_expires_at": "2026-01-01T12:00:00Z"
+  }
+]
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, renderAttestedJoinTokens(&buf, tokens, outputText))
+	assert.Contains(t, buf.String(), "token1")
+	assert.Contains(t, buf.String(), "spiffe://td1/vm1")
+}
+
+func Test_validateOutput(t *testing.T) {
+	assert.NoError(t, validateOutput(outputJSON, outputText, outputJSON))
+	assert.EqualError(t, validateOutput(outputValue, outputText, outputJSON), "unsupported output format \"value\", expected one of text, json")
+}
@@ -4,6 +4,7 @@
 package apbinding
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -68,16 +69,17 @@ func (c *APBindingCommand) GetListCommand() *cobra.Command {
 		Long:  apBindingListCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			bindings, err := c.list(ds, opts)
+			bindings, err := c.list(ctx, ds, opts)
 			if err != nil {
 				return err
 			}
-			return renderList(ds, bindings)
+			return renderList(ctx, ds, bindings)
 		},
 	}
 
@@ -88,23 +90,23 @@ func (c *APBindingCommand) GetListCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *APBindingCommand) list(source datasource.DataSource, opts ListOpts) ([]*ap_binding_proto.APBinding, error) {
+func (c *APBindingCommand) list(ctx context.Context, source datasource.DataSourceV2, opts ListOpts) ([]*ap_binding_proto.APBinding, error) {
 	filter := &datasourcepb.ListAPBindingsRequest_Filter{}
 	if opts.trustZone != "" {
-		trustZone, err := source.GetTrustZoneByName(opts.trustZone)
+		trustZone, err := source.GetTrustZoneByName(ctx, opts.trustZone)
 		if err != nil {
 			return nil, err
 		}
 		filter.TrustZoneId = trustZone.Id
 	}
 	if opts.attestationPolicy != "" {
-		policy, err := source.GetAttestationPolicyByName(opts.attestationPolicy)
+		policy, err := source.GetAttestationPolicyByName(ctx, opts.attestationPolicy)
 		if err != nil {
 			return nil, err
 		}
 		filter.PolicyId = policy.Id
 	}
-	return source.ListAPBindings(filter)
+	return source.ListAPBindings(ctx, filter)
 }
 
 func renderFederations(bindings []*ap_binding_proto.APBindingFederation, tzMap map[string]string) string {
@@ -116,8 +118,8 @@ func renderFederations(bindings []*ap_binding_proto.APBindingFederation, tzMap m
 	return strings.Join(federations, ", ")
 }
 
-func renderList(source datasource.DataSource, bindings []*ap_binding_proto.APBinding) error {
-	tzs, err := source.ListTrustZones()
+func renderList(ctx context.Context, source datasource.DataSourceV2, bindings []*ap_binding_proto.APBinding) error {
+	tzs, err := source.ListTrustZones(ctx)
 	if err != nil {
 		return err
 	}
@@ -126,7 +128,7 @@ func renderList(source datasource.DataSource, bindings []*ap_binding_proto.APBin
 		tzMap[tz.GetId()] = tz.GetName()
 	}
 
-	policies, err := source.ListAttestationPolicies()
+	policies, err := source.ListAttestationPolicies(ctx)
 	if err != nil {
 		return err
 	}
@@ -170,11 +172,12 @@ func (c *APBindingCommand) GetAddCommand() *cobra.Command {
 		Long:  apBindingAddCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
-			return c.addAPBinding(opts, ds)
+			return c.addAPBinding(ctx, opts, ds)
 		},
 	}
 
@@ -189,14 +192,14 @@ func (c *APBindingCommand) GetAddCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *APBindingCommand) addAPBinding(opts AddOpts, ds datasource.DataSource) error {
-	tz, err := ds.GetTrustZoneByName(opts.trustZone)
+func (c *APBindingCommand) addAPBinding(ctx context.Context, opts AddOpts, ds datasource.DataSourceV2) error {
+	tz, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 	if err != nil {
 		return err
 	}
 	trustZoneID := tz.GetId()
 
-	policy, err := ds.GetAttestationPolicyByName(opts.attestationPolicy)
+	policy, err := ds.GetAttestationPolicyByName(ctx, opts.attestationPolicy)
 	if err != nil {
 		return err
 	}
@@ -204,7 +207,7 @@ func (c *APBindingCommand) addAPBinding(opts AddOpts, ds datasource.DataSource)
 
 	federations := []*ap_binding_proto.APBindingFederation{}
 	if len(opts.federatesWith) != 0 {
-		tzs, err := ds.ListTrustZones()
+		tzs, err := ds.ListTrustZones(ctx)
 		if err != nil {
 			return err
 		}
@@ -231,7 +234,7 @@ func (c *APBindingCommand) addAPBinding(opts AddOpts, ds datasource.DataSource)
 		PolicyId:    &policyID,
 		Federations: federations,
 	}
-	_, err = ds.AddAPBinding(binding)
+	_, err = ds.AddAPBinding(ctx, binding)
 	return err
 }
 
@@ -251,22 +254,23 @@ func (c *APBindingCommand) GetDelCommand() *cobra.Command {
 		Long:  apBindingDelCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			trustZone, err := ds.GetTrustZoneByName(opts.trustZone)
+			trustZone, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 			if err != nil {
 				return err
 			}
 
-			policy, err := ds.GetAttestationPolicyByName(opts.attestationPolicy)
+			policy, err := ds.GetAttestationPolicyByName(ctx, opts.attestationPolicy)
 			if err != nil {
 				return err
 			}
 
-			bindings, err := ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{
+			bindings, err := ds.ListAPBindings(ctx, &datasourcepb.ListAPBindingsRequest_Filter{
 				TrustZoneId: trustZone.Id,
 				PolicyId:    policy.Id,
 			})
@@ -280,7 +284,7 @@ func (c *APBindingCommand) GetDelCommand() *cobra.Command {
 				return errors.New("multiple bindings found")
 			}
 			binding := bindings[0]
-			return ds.DestroyAPBinding(binding.GetId())
+			return ds.DestroyAPBinding(ctx, binding.GetId())
 		},
 	}
 
@@ -314,11 +318,12 @@ func (c *APBindingCommand) GetUpdateCommand() *cobra.Command {
 		Long:  apBindingUpdateCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
-			return c.updateAPBinding(opts, cmd, ds)
+			return c.updateAPBinding(ctx, opts, cmd, ds)
 		},
 	}
 
@@ -334,7 +339,7 @@ func (c *APBindingCommand) GetUpdateCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *APBindingCommand) updateAPBinding(opts updateOpts, cmd *cobra.Command, ds datasource.DataSource) error {
+func (c *APBindingCommand) updateAPBinding(ctx context.Context, opts updateOpts, cmd *cobra.Command, ds datasource.DataSourceV2) error {
 	updatableFlags := []string{"federates-with", "clear-federations"}
 	if !slices.ContainsFunc(updatableFlags, cmd.Flags().Changed) {
 		fmt.Println("No changes specified")
@@ -345,17 +350,17 @@ func (c *APBindingCommand) updateAPBinding(opts updateOpts, cmd *cobra.Command,
 		return errors.New("cannot simultaneously specify --federates-with and --clear-federations")
 	}
 
-	trustZone, err := ds.GetTrustZoneByName(opts.trustZone)
+	trustZone, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 	if err != nil {
 		return fmt.Errorf("failed to get trust zone %s: %w", opts.trustZone, err)
 	}
 
-	policy, err := ds.GetAttestationPolicyByName(opts.attestationPolicy)
+	policy, err := ds.GetAttestationPolicyByName(ctx, opts.attestationPolicy)
 	if err != nil {
 		return fmt.Errorf("failed to get attestation policy %s: %w", opts.attestationPolicy, err)
 	}
 
-	bindings, err := ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{
+	bindings, err := ds.ListAPBindings(ctx, &datasourcepb.ListAPBindingsRequest_Filter{
 		TrustZoneId: trustZone.Id,
 		PolicyId:    policy.Id,
 	})
@@ -376,7 +381,7 @@ func (c *APBindingCommand) updateAPBinding(opts updateOpts, cmd *cobra.Command,
 
 	if cmd.Flags().Changed("federates-with") && len(opts.federatesWith) > 0 {
 		federations := []*ap_binding_proto.APBindingFederation{}
-		tzs, err := ds.ListTrustZones()
+		tzs, err := ds.ListTrustZones(ctx)
 		if err != nil {
 			return err
 		}
@@ -399,6 +404,6 @@ func (c *APBindingCommand) updateAPBinding(opts updateOpts, cmd *cobra.Command,
 		binding.Federations = federations
 	}
 
-	_, err = ds.UpdateAPBinding(binding)
+	_, err = ds.UpdateAPBinding(ctx, binding)
 	return err
 }
@@ -4,6 +4,7 @@
 package apbinding
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -157,7 +158,7 @@ func TestAPBindingCommand_updateAPBinding(t *testing.T) {
 			}
 
 			c := APBindingCommand{}
-			err := c.updateAPBinding(opts, cmd, ds)
+			err := c.updateAPBinding(context.Background(), opts, cmd, datasource.FromV1(ds))
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErrMessage)
@@ -274,7 +275,7 @@ func TestAPBindingCommand_addAPBinding(t *testing.T) {
 			}
 
 			c := APBindingCommand{}
-			err := c.addAPBinding(opts, ds)
+			err := c.addAPBinding(context.Background(), opts, datasource.FromV1(ds))
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErrMessage)
@@ -60,12 +60,13 @@ func (c *AttestationPolicyCommand) GetListCommand() *cobra.Command {
 		Long:  attestationPolicyListCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			attestationPolicies, err := ds.ListAttestationPolicies()
+			attestationPolicies, err := ds.ListAttestationPolicies(ctx)
 			if err != nil {
 				return err
 			}
@@ -233,7 +234,8 @@ func (c *AttestationPolicyCommand) GetAddK8sCommand() *cobra.Command {
 		Long:  attestationPolicyAddK8sCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
@@ -261,7 +263,7 @@ func (c *AttestationPolicyCommand) GetAddK8sCommand() *cobra.Command {
 					Kubernetes: kubernetes,
 				},
 			}
-			_, err = ds.AddAttestationPolicy(newAttestationPolicy)
+			_, err = ds.AddAttestationPolicy(ctx, newAttestationPolicy)
 			if err != nil {
 				return err
 			}
@@ -304,7 +306,8 @@ func (c *AttestationPolicyCommand) GetAddStaticCommand() *cobra.Command {
 		Long:  attestationPolicyAddStaticCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
@@ -325,7 +328,7 @@ func (c *AttestationPolicyCommand) GetAddStaticCommand() *cobra.Command {
 					},
 				},
 			}
-			_, err = ds.AddAttestationPolicy(newAttestationPolicy)
+			_, err = ds.AddAttestationPolicy(ctx, newAttestationPolicy)
 			if err != nil {
 				return err
 			}
@@ -366,7 +369,8 @@ func (c *AttestationPolicyCommand) GetAddTPMNodeCommand() *cobra.Command {
 		Long:  attestationPolicyAddTPMNodeCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
@@ -384,7 +388,7 @@ func (c *AttestationPolicyCommand) GetAddTPMNodeCommand() *cobra.Command {
 					TpmNode: tpmNode,
 				},
 			}
-			_, err = ds.AddAttestationPolicy(newAttestationPolicy)
+			_, err = ds.AddAttestationPolicy(ctx, newAttestationPolicy)
 			if err != nil {
 				return err
 			}
@@ -478,18 +482,18 @@ func (c *AttestationPolicyCommand) getDelCommand() *cobra.Command {
 }
 
 func (c *AttestationPolicyCommand) deletePolicy(ctx context.Context, name string) error {
-	ds, err := c.cmdCtx.PluginManager.GetDataSource(ctx)
+	ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 	if err != nil {
 		return err
 	}
 
-	ap, err := ds.GetAttestationPolicyByName(name)
+	ap, err := ds.GetAttestationPolicyByName(ctx, name)
 	if err != nil {
 		return err
 	}
 
 	// Check for any APBindings which will cause deletion to fail
-	bindings, err := ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{PolicyId: ap.Id})
+	bindings, err := ds.ListAPBindings(ctx, &datasourcepb.ListAPBindingsRequest_Filter{PolicyId: ap.Id})
 	if err != nil {
 		return err
 	}
@@ -500,5 +504,5 @@ if len(bindings) > 0 {
 		)
 	}
 
-	return ds.DestroyAttestationPolicy(ap.GetId())
+	return ds.DestroyAttestationPolicy(ctx, ap.GetId())
 }
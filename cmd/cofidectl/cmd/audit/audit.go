@@ -0,0 +1,206 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	"github.com/cofide/cofidectl/internal/pkg/audit"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/spf13/cobra"
+)
+
+type AuditCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewAuditCommand(cmdCtx *cmdcontext.CommandContext) *AuditCommand {
+	return &AuditCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var auditRootCmdDesc = `
+This command consists of multiple sub-commands to inspect the audit log of changes made by cofidectl.
+`
+
+func (c *AuditCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit show [ARGS]",
+		Short: "Inspect the audit log",
+		Long:  auditRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.getShowCommand(),
+	)
+
+	return cmd
+}
+
+var auditShowCmdDesc = `
+This command will list records in the audit log, oldest first.
+
+The audit log records each change made to the Cofide configuration, and each invocation of up and down.
+Its location is set using --audit-log.
+`
+
+// entityTypes maps the values accepted by the --entity-type flag to entity types.
+var entityTypes = map[string]string{
+	"trust-zone":         config.EntityTrustZone,
+	"cluster":            config.EntityCluster,
+	"attestation-policy": config.EntityAttestationPolicy,
+	"ap-binding":         config.EntityAPBinding,
+	"federation":         config.EntityFederation,
+}
+
+var operations = []string{
+	config.OperationAdd,
+	config.OperationUpdate,
+	config.OperationDelete,
+	audit.OperationUp,
+	audit.OperationDown,
+}
+
+type showOpts struct {
+	operation  string
+	entityType string
+	id         string
+	user       string
+	since      string
+	limit      int
+}
+
+func (c *AuditCommand) getShowCommand() *cobra.Command {
+	opts := showOpts{}
+	cmd := &cobra.Command{
+		Use:   "show [ARGS]",
+		Short: "List audit log records",
+		Long:  auditShowCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log := c.cmdCtx.PluginManager.GetAuditLog()
+			if log == nil {
+				return errors.New("the audit log is disabled, set its location using --audit-log")
+			}
+
+			filter, err := getFilter(opts, time.Now())
+			if err != nil {
+				return err
+			}
+
+			records, err := audit.Read(log.Path(), filter)
+			if err != nil {
+				return err
+			}
+			if opts.limit > 0 && len(records) > opts.limit {
+				records = records[len(records)-opts.limit:]
+			}
+			return renderRecords(os.Stdout, records)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.operation, "operation", "", fmt.Sprintf("Show only records of this operation, one of: %s", strings.Join(operations, ", ")))
+	f.StringVar(&opts.entityType, "entity-type", "", fmt.Sprintf("Show only records for this entity type, one of: %s", strings.Join(entityTypeFlagValues(), ", ")))
+	f.StringVar(&opts.id, "id", "", "Show only records for the entity with this ID or name")
+	f.StringVar(&opts.user, "user", "", "Show only records made by this user")
+	f.StringVar(&opts.since, "since", "", "Show only records since this time, either as a duration (e.g. 24h) or an RFC 3339 timestamp")
+	f.IntVar(&opts.limit, "limit", 0, "Show at most this many of the most recent records")
+
+	return cmd
+}
+
+// getFilter returns an audit log filter for the command options.
+func getFilter(opts showOpts, now time.Time) (*audit.Filter, error) {
+	filter := &audit.Filter{
+		Operation: opts.operation,
+		ID:        opts.id,
+		User:      opts.user,
+	}
+
+	if opts.operation != "" && !slices.Contains(operations, opts.operation) {
+		return nil, fmt.Errorf("unexpected operation %s, valid operations: %s", opts.operation, strings.Join(operations, ", "))
+	}
+
+	if opts.entityType != "" {
+		entityType, ok := entityTypes[opts.entityType]
+		if !ok {
+			return nil, fmt.Errorf("unexpected entity type %s, valid entity types: %s", opts.entityType, strings.Join(entityTypeFlagValues(), ", "))
+		}
+		filter.EntityType = entityType
+	}
+
+	if opts.since != "" {
+		if duration, err := time.ParseDuration(opts.since); err == nil {
+			filter.Since = now.Add(-duration)
+		} else if since, err := time.Parse(time.RFC3339, opts.since); err == nil {
+			filter.Since = since
+		} else {
+			return nil, fmt.Errorf("invalid --since value %s: expected a duration or RFC 3339 timestamp", opts.since)
+		}
+	}
+	return filter, nil
+}
+
+func entityTypeFlagValues() []string {
+	return slices.Sorted(maps.Keys(entityTypes))
+}
+
+func renderRecords(w io.Writer, records []*audit.Record) error {
+	data := make([][]string, 0, len(records))
+	for _, record := range records {
+		data = append(data, []string{
+			record.Time.Local().Format(time.DateTime),
+			record.User,
+			record.Operation,
+			record.EntityType,
+			describeEntity(record),
+			strings.Join(record.ChangedFields, ", "),
+			describeResult(record),
+		})
+	}
+
+	tr := renderer.NewTableRenderer(w)
+	table := renderer.Table{
+		Header: []string{"Time", "User", "Operation", "Entity Type", "Entity", "Changed Fields", "Result"},
+		Data:   data,
+	}
+	_, err := tr.RenderTables(table)
+	return err
+}
+
+// describeEntity returns the entity name if known, or its ID, or the targeted trust zones for
+// up and down.
+func describeEntity(record *audit.Record) string {
+	switch {
+	case record.Name != "":
+		return record.Name
+	case record.ID != "":
+		return record.ID
+	case record.Operation == audit.OperationUp || record.Operation == audit.OperationDown:
+		if len(record.TrustZones) == 0 {
+			return "all trust zones"
+		}
+		return strings.Join(record.TrustZones, ", ")
+	}
+	return ""
+}
+
+func describeResult(record *audit.Record) string {
+	if record.Error != "" {
+		return "failed: " + record.Error
+	}
+	return "succeeded"
+}
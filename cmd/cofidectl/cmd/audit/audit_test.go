@@ -0,0 +1,101 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cofide/cofidectl/internal/pkg/audit"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getFilter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		opts    showOpts
+		want    *audit.Filter
+		wantErr string
+	}{
+		{
+			name: "empty",
+			want: &audit.Filter{},
+		},
+		{
+			name: "all filters",
+			opts: showOpts{operation: "delete", entityType: "trust-zone", id: "tz1", user: "alice", since: "24h"},
+			want: &audit.Filter{
+				Operation:  config.OperationDelete,
+				EntityType: config.EntityTrustZone,
+				ID:         "tz1",
+				User:       "alice",
+				Since:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "since timestamp",
+			opts: showOpts{since: "2025-12-01T00:00:00Z"},
+			want: &audit.Filter{Since: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "invalid operation",
+			opts:    showOpts{operation: "create"},
+			wantErr: "unexpected operation create, valid operations: add, update, delete, up, down",
+		},
+		{
+			name:    "invalid entity type",
+			opts:    showOpts{entityType: "trust zone"},
+			wantErr: "unexpected entity type trust zone, valid entity types: ap-binding, attestation-policy, cluster, federation, trust-zone",
+		},
+		{
+			name:    "invalid since",
+			opts:    showOpts{since: "yesterday"},
+			wantErr: "invalid --since value yesterday: expected a duration or RFC 3339 timestamp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getFilter(tt.opts, now)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_renderRecords(t *testing.T) {
+	records := []*audit.Record{
+		{
+			Time:          time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			User:          "alice",
+			Operation:     config.OperationUpdate,
+			EntityType:    config.EntityCluster,
+			ID:            "local1-id",
+			Name:          "local1",
+			ChangedFields: []string{"extra_helm_values"},
+		},
+		{
+			Time:      time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC),
+			User:      "bob",
+			Operation: audit.OperationUp,
+			Error:     "timed out",
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderRecords(&buf, records))
+	out := buf.String()
+	assert.Contains(t, out, "local1")
+	assert.Contains(t, out, "extra_helm_values")
+	assert.Contains(t, out, "succeeded")
+	assert.Contains(t, out, "all trust zones")
+	assert.Contains(t, out, "failed: timed out")
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// cliPluginAnnotation is the cobra command annotation that marks a CLI plugin placeholder command.
+// Its value is the directory containing the plugin.
+const cliPluginAnnotation = "cofidectl.cli-plugin-dir"
+
+// reservedCommands are added by cobra when the root command is executed, and may not be
+// overridden by CLI plugins.
+var reservedCommands = []string{"help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd}
+
+// addCliPluginCommands adds a placeholder command to rootCmd for each CLI plugin in the plugin
+// search path, so that CLI plugins are listed in help output and support shell completion.
+// Plugins that conflict with an existing command are skipped. The commands are added before cobra
+// parses the command line, so the --config and --kube-config flags are parsed from args in order
+// to include the plugins.path setting of the config file in the search path.
+// Discovery reads the config file and the plugin directories, so it is skipped if args name a
+// builtin command, for which the placeholder commands are not needed.
+func addCliPluginCommands(rootCmd *cobra.Command, cmdCtx *cmdcontext.CommandContext, args []string) {
+	if !cliPluginCommandsNeeded(rootCmd, args) {
+		return
+	}
+	configFile, kubeConfig := configFlagsFromArgs(rootCmd, args)
+	if err := cmdCtx.UpdateConfigFile(configFile, kubeConfig); err != nil {
+		slog.Warn("Failed to load config file, not listing CLI plugins", "error", err)
+		return
+	}
+	dirs, err := cmdCtx.PluginManager.GetPluginDirs()
+	if err != nil {
+		slog.Warn("Failed to get plugin directories, not listing CLI plugins", "error", err)
+		return
+	}
+	discovered, err := plugin.DiscoverCliPlugins(dirs)
+	if err != nil {
+		slog.Warn("Failed to discover CLI plugins", "error", err)
+		return
+	}
+
+	for _, cliPlugin := range discovered {
+		if IsReservedCommand(cliPlugin.Command) || hasSubcommand(rootCmd, cliPlugin.Command) {
+			slog.Debug("Ignoring CLI plugin that conflicts with a command", "plugin", cliPlugin.Plugin.Name, "path", cliPlugin.Plugin.Path)
+			continue
+		}
+		rootCmd.AddCommand(newCliPluginCommand(cliPlugin))
+	}
+}
+
+// cliPluginCommandsNeeded returns whether the CLI plugin placeholder commands are needed to
+// execute args: for help output of the root command, shell completion, or a command that is not
+// a builtin command.
+func cliPluginCommandsNeeded(rootCmd *cobra.Command, args []string) bool {
+	found, _, err := rootCmd.Find(args)
+	return err != nil || found == rootCmd
+}
+
+// configFlagsFromArgs returns the values of the root command's --config and --kube-config flags
+// in args, or their default values if not specified. Other flags are ignored.
+func configFlagsFromArgs(rootCmd *cobra.Command, args []string) (configFile, kubeConfig string) {
+	pf := rootCmd.PersistentFlags()
+	flags := pflag.NewFlagSet(rootCmd.Name(), pflag.ContinueOnError)
+	flags.ParseErrorsAllowlist.UnknownFlags = true
+	flags.SetOutput(io.Discard)
+	flags.StringVar(&configFile, "config", pf.Lookup("config").DefValue, "")
+	flags.StringVar(&kubeConfig, "kube-config", pf.Lookup("kube-config").DefValue, "")
+	// Prevent --help from stopping the parser.
+	flags.BoolP("help", "h", false, "")
+	if err := flags.Parse(args); err != nil {
+		slog.Debug("Failed to parse config flags", "error", err)
+	}
+	return configFile, kubeConfig
+}
+
+// IsReservedCommand returns whether name is a command added by cobra when the root command is
+// executed.
+func IsReservedCommand(name string) bool {
+	return slices.Contains(reservedCommands, name)
+}
+
+// hasSubcommand returns whether cmd has a subcommand with the specified name or alias.
+func hasSubcommand(cmd *cobra.Command, name string) bool {
+	for _, subcmd := range cmd.Commands() {
+		if subcmd.Name() == name || subcmd.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// newCliPluginCommand returns a placeholder command for a CLI plugin.
+// CLI plugins are normally executed before cobra parses the command line, but the placeholder
+// also executes the plugin if it is reached.
+func newCliPluginCommand(cliPlugin *plugin.DiscoveredCliPlugin) *cobra.Command {
+	short := fmt.Sprintf("Run the %s CLI plugin", cliPlugin.Plugin.Name)
+	var long string
+	if cliPlugin.Manifest != nil {
+		if cliPlugin.Manifest.Short != "" {
+			short = cliPlugin.Manifest.Short
+		}
+		long = cliPlugin.Manifest.Long
+	}
+
+	return &cobra.Command{
+		Use:                cliPlugin.Command,
+		Short:              short,
+		Long:               long,
+		DisableFlagParsing: true,
+		Annotations:        map[string]string{cliPluginAnnotation: cliPlugin.Plugin.Dir.Path},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			completions, directive, err := completeCliPlugin(cliPlugin.Plugin, args, toComplete)
+			if err != nil {
+				slog.Debug("Failed to complete CLI plugin arguments", "plugin", cliPlugin.Plugin.Name, "error", err)
+				return nil, cobra.ShellCompDirectiveDefault
+			}
+			return completions, directive
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cp, err := newCliPlugin(cliPlugin.Plugin, args)
+			if err != nil {
+				return err
+			}
+			return cp.Execute()
+		},
+	}
+}
+
+// newCliPlugin returns a CliPlugin for a resolved plugin. Root command flags are not parsed for
+// placeholder commands, so unsigned plugins may only be allowed using the environment variable.
+func newCliPlugin(resolved *plugin.ResolvedPlugin, args []string) (*plugin.CliPlugin, error) {
+	policy, err := plugin.NewSignaturePolicy(plugin.AllowUnsignedFromEnv())
+	if err != nil {
+		return nil, err
+	}
+	cp := plugin.NewCliPlugin(resolved.Name, args)
+	cp.PluginDir = resolved.Dir.Path
+	cp.SignaturePolicy = policy
+	return cp, nil
+}
+
+// IsCliPluginCommand returns whether cmd is a placeholder command for a CLI plugin.
+func IsCliPluginCommand(cmd *cobra.Command) bool {
+	_, ok := cmd.Annotations[cliPluginAnnotation]
+	return ok
+}
+
+// completeCliPlugin delegates shell completion to a CLI plugin using cobra's hidden __complete
+// command. The plugin is verified before it is executed.
+func completeCliPlugin(resolved *plugin.ResolvedPlugin, args []string, toComplete string) ([]string, cobra.ShellCompDirective, error) {
+	cp, err := newCliPlugin(resolved, args)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveDefault, err
+	}
+	pluginPath, err := cp.Verify()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveDefault, err
+	}
+
+	completeArgs := append([]string{cobra.ShellCompRequestCmd}, args...)
+	completeArgs = append(completeArgs, toComplete)
+	output, err := exec.Command(pluginPath, completeArgs...).Output()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveDefault, fmt.Errorf("failed to execute plugin completion: %w", err)
+	}
+	return parseCompletions(output)
+}
+
+// parseCompletions parses the output of cobra's __complete command: one completion per line,
+// followed by a line containing the shell completion directive, prefixed with a colon.
+func parseCompletions(output []byte) ([]string, cobra.ShellCompDirective, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, cobra.ShellCompDirectiveDefault, err
+	}
+	if len(lines) == 0 {
+		return nil, cobra.ShellCompDirectiveDefault, fmt.Errorf("no completion directive in plugin output")
+	}
+
+	last := lines[len(lines)-1]
+	value, ok := strings.CutPrefix(last, ":")
+	if !ok {
+		return nil, cobra.ShellCompDirectiveDefault, fmt.Errorf("no completion directive in plugin output")
+	}
+	directive, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveDefault, fmt.Errorf("invalid completion directive %q: %w", last, err)
+	}
+	return lines[:len(lines)-1], cobra.ShellCompDirective(directive), nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseCompletions(t *testing.T) {
+	tests := []struct {
+		name          string
+		output        string
+		want          []string
+		wantDirective cobra.ShellCompDirective
+		wantErr       string
+	}{
+		{
+			name:          "completions",
+			output:        "foo\tThe foo command\nbar\n:4\n",
+			want:          []string{"foo\tThe foo command", "bar"},
+			wantDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{
+			name:          "no completions",
+			output:        ":0\n",
+			want:          []string{},
+			wantDirective: cobra.ShellCompDirectiveDefault,
+		},
+		{
+			name:    "empty",
+			output:  "",
+			wantErr: "no completion directive in plugin output",
+		},
+		{
+			name:    "missing directive",
+			output:  "foo\nbar\n",
+			wantErr: "no completion directive in plugin output",
+		},
+		{
+			name:    "invalid directive",
+			output:  "foo\n:bar\n",
+			wantErr: "invalid completion directive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, directive, err := parseCompletions([]byte(tt.output))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDirective, directive)
+		})
+	}
+}
+
+func Test_configFlagsFromArgs(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "cofidectl"}
+	pf := rootCmd.PersistentFlags()
+	pf.String("config", "cofide.yaml", "")
+	pf.String("kube-config", "kubeconfig", "")
+
+	tests := []struct {
+		name           string
+		args           []string
+		wantConfig     string
+		wantKubeConfig string
+	}{
+		{
+			name:           "defaults",
+			args:           []string{"foo", "bar"},
+			wantConfig:     "cofide.yaml",
+			wantKubeConfig: "kubeconfig",
+		},
+		{
+			name:           "flags",
+			args:           []string{"--config", "other.yaml", "foo", "--kube-config=other-kubeconfig"},
+			wantConfig:     "other.yaml",
+			wantKubeConfig: "other-kubeconfig",
+		},
+		{
+			name:           "other flags",
+			args:           []string{"--log-level", "DEBUG", "-h", "--help", "--config=other.yaml", "foo", "--bar"},
+			wantConfig:     "other.yaml",
+			wantKubeConfig: "kubeconfig",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configFile, kubeConfig := configFlagsFromArgs(rootCmd, tt.args)
+			assert.Equal(t, tt.wantConfig, configFile)
+			assert.Equal(t, tt.wantKubeConfig, kubeConfig)
+		})
+	}
+}
+
+func Test_addCliPluginCommands_configPluginPath(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(plugin.PluginPathEnvVar, "")
+
+	pluginDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(pluginDir, plugin.CliPluginPrefix+"foo"), []byte("#!/bin/sh\n"), 0o700)
+	require.NoError(t, err)
+	configFile := filepath.Join(t.TempDir(), "other.yaml")
+	err = os.WriteFile(configFile, []byte("plugins:\n  path:\n    - "+pluginDir+"\n"), 0o600)
+	require.NoError(t, err)
+
+	cmdCtx := cmdcontext.NewCommandContext("cofide.yaml", nil)
+	defer cmdCtx.Shutdown()
+	rootCmd := &cobra.Command{Use: "cofidectl"}
+	pf := rootCmd.PersistentFlags()
+	pf.String("config", "cofide.yaml", "")
+	pf.String("kube-config", "", "")
+
+	addCliPluginCommands(rootCmd, cmdCtx, []string{"--config", configFile, "foo"})
+	found, _, err := rootCmd.Find([]string{"foo"})
+	require.NoError(t, err)
+	assert.True(t, IsCliPluginCommand(found))
+}
+
+func Test_cliPluginCommandsNeeded(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "cofidectl"}
+	rootCmd.PersistentFlags().String("config", "cofide.yaml", "")
+	configCmd := &cobra.Command{Use: "config"}
+	configCmd.AddCommand(&cobra.Command{Use: "validate", Run: func(*cobra.Command, []string) {}})
+	rootCmd.AddCommand(&cobra.Command{Use: "version", Run: func(*cobra.Command, []string) {}}, configCmd)
+
+	tests := []struct {
+		name string
+		args []string
+		want bool
+	}{
+		{name: "no args", args: []string{}, want: true},
+		{name: "root help", args: []string{"--config", "other.yaml", "--help"}, want: true},
+		{name: "help command", args: []string{"help"}, want: true},
+		{name: "completion", args: []string{cobra.ShellCompRequestCmd, "fo"}, want: true},
+		{name: "unknown command", args: []string{"--config", "other.yaml", "foo", "bar"}, want: true},
+		{name: "builtin command", args: []string{"--config", "other.yaml", "version"}, want: false},
+		{name: "builtin subcommand", args: []string{"config", "validate", "--help"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cliPluginCommandsNeeded(rootCmd, tt.args))
+		})
+	}
+}
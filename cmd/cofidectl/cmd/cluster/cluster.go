@@ -5,6 +5,7 @@ package cluster
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
@@ -18,8 +19,9 @@ import (
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
 	"github.com/cofide/cofidectl/internal/pkg/trustprovider"
 	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
-	helmprovider "github.com/cofide/cofidectl/pkg/provider/helm"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 )
@@ -87,11 +89,12 @@ func (c *ClusterCommand) getAddCommand() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
-			return c.addCluster(opts, ds)
+			return c.addCluster(ctx, opts, ds)
 		},
 	}
 	f := cmd.Flags()
@@ -106,8 +109,8 @@ func (c *ClusterCommand) getAddCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *ClusterCommand) addCluster(opts addOpts, ds datasource.DataSource) error {
-	tz, err := ds.GetTrustZoneByName(opts.trustZone)
+func (c *ClusterCommand) addCluster(ctx context.Context, opts addOpts, ds datasource.DataSourceV2) error {
+	tz, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 	if err != nil {
 		return fmt.Errorf("failed to get trust zone %s: %w", opts.trustZone, err)
 	}
@@ -139,7 +142,7 @@ func (c *ClusterCommand) addCluster(opts addOpts, ds datasource.DataSource) erro
 		newCluster.OidcIssuerCaCert = caBytes
 	}
 
-	_, err = ds.AddCluster(newCluster)
+	_, err = ds.AddCluster(ctx, newCluster)
 	return err
 }
 
@@ -159,11 +162,12 @@ func (c *ClusterCommand) getGetCommand() *cobra.Command {
 		Long:  clusterGetCmdDesc,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
-			return c.getCluster(args[0], opts.trustZone, ds, os.Stdout)
+			return c.getCluster(ctx, args[0], opts.trustZone, ds, os.Stdout)
 		},
 	}
 	f := cmd.Flags()
@@ -173,13 +177,13 @@ func (c *ClusterCommand) getGetCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *ClusterCommand) getCluster(name, trustZoneName string, ds datasource.DataSource, writer io.Writer) error {
-	tz, err := ds.GetTrustZoneByName(trustZoneName)
+func (c *ClusterCommand) getCluster(ctx context.Context, name, trustZoneName string, ds datasource.DataSourceV2, writer io.Writer) error {
+	tz, err := ds.GetTrustZoneByName(ctx, trustZoneName)
 	if err != nil {
 		return fmt.Errorf("failed to get trust zone %s: %w", trustZoneName, err)
 	}
 
-	cluster, err := ds.GetClusterByName(name, tz.GetId())
+	cluster, err := ds.GetClusterByName(ctx, name, tz.GetId())
 	if err != nil {
 		return err
 	}
@@ -237,17 +241,17 @@ func (c *ClusterCommand) getListClustersCommand() *cobra.Command {
 }
 
 func (c *ClusterCommand) ListClusters(ctx context.Context) error {
-	ds, err := c.cmdCtx.PluginManager.GetDataSource(ctx)
+	ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 	if err != nil {
 		return err
 	}
-	zones, err := ds.ListTrustZones()
+	zones, err := ds.ListTrustZones(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to list trust zones: %v", err)
 	}
 	data := make([][]string, 0)
 	for _, zone := range zones {
-		clusters, err := ds.ListClusters(&datasourcepb.ListClustersRequest_Filter{
+		clusters, err := ds.ListClusters(ctx, &datasourcepb.ListClustersRequest_Filter{
 			TrustZoneId: zone.Id,
 		})
 		if err != nil {
@@ -307,31 +311,38 @@ func (c *ClusterCommand) getDelCommand() *cobra.Command {
 }
 
 func (c *ClusterCommand) deleteCluster(ctx context.Context, name, trustZoneName, kubeConfig string, force bool) error {
-	ds, err := c.cmdCtx.PluginManager.GetDataSource(ctx)
+	ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 	if err != nil {
 		return err
 	}
 
-	tz, err := ds.GetTrustZoneByName(trustZoneName)
+	tz, err := ds.GetTrustZoneByName(ctx, trustZoneName)
 	if err != nil {
 		return fmt.Errorf("failed to get trust zone %s: %w", trustZoneName, err)
 	}
 
-	cluster, err := ds.GetClusterByName(name, tz.GetId())
+	cluster, err := ds.GetClusterByName(ctx, name, tz.GetId())
 	if err != nil {
 		return err
 	}
 
 	if !force {
-		// Fail if the cluster is reachable and SPIRE is deployed.
-		if deployed, err := helmprovider.IsClusterDeployed(ctx, cluster, kubeConfig); err != nil {
+		// Fail if the cluster is deployed.
+		reporter, err := c.cmdCtx.PluginManager.GetStatusReporter(ctx)
+		if errors.Is(err, plugin.ErrNotSupported) {
+			return fmt.Errorf("%w: use --force to skip pre-delete checks", err)
+		} else if err != nil {
+			return err
+		}
+		opts := &provision.IsDeployedOpts{KubeCfgFile: kubeConfig, ClusterID: cluster.GetId()}
+		if deployed, err := reporter.IsDeployed(ctx, datasource.ToV1(ctx, ds), opts); err != nil {
 			return err
 		} else if deployed {
 			return fmt.Errorf("cluster %s in trust zone %s cannot be deleted while it is up", name, trustZoneName)
 		}
 	}
 
-	return ds.DestroyCluster(cluster.GetId())
+	return ds.DestroyCluster(ctx, cluster.GetId())
 }
 
 var clusterUpdateCmdDesc = `
@@ -364,11 +375,12 @@ func (c *ClusterCommand) getUpdateCommand() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
-			return c.updateCluster(args[0], opts, cmd, ds)
+			return c.updateCluster(ctx, args[0], opts, cmd, ds)
 		},
 	}
 	f := cmd.Flags()
@@ -382,19 +394,19 @@ func (c *ClusterCommand) getUpdateCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *ClusterCommand) updateCluster(name string, opts updateOpts, cmd *cobra.Command, ds datasource.DataSource) error {
+func (c *ClusterCommand) updateCluster(ctx context.Context, name string, opts updateOpts, cmd *cobra.Command, ds datasource.DataSourceV2) error {
 	updatableFlags := []string{"kubernetes-context", "external-server", "kubernetes-oidc-issuer", "kubernetes-ca-cert"}
 	if !slices.ContainsFunc(updatableFlags, cmd.Flags().Changed) {
 		fmt.Println("No changes specified")
 		return nil
 	}
 
-	tz, err := ds.GetTrustZoneByName(opts.trustZone)
+	tz, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 	if err != nil {
 		return fmt.Errorf("failed to get trust zone %s: %w", opts.trustZone, err)
 	}
 
-	cluster, err := ds.GetClusterByName(name, tz.GetId())
+	cluster, err := ds.GetClusterByName(ctx, name, tz.GetId())
 	if err != nil {
 		return err
 	}
@@ -416,7 +428,7 @@ func (c *ClusterCommand) updateCluster(name string, opts updateOpts, cmd *cobra.
 		cluster.OidcIssuerCaCert = caBytes
 	}
 
-	_, err = ds.UpdateCluster(cluster)
+	_, err = ds.UpdateCluster(ctx, cluster)
 	return err
 }
 
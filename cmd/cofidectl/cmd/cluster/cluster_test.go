@@ -5,6 +5,7 @@ package cluster
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -150,7 +151,7 @@ func TestClusterCommand_addCluster(t *testing.T) {
 			}
 
 			c := ClusterCommand{}
-			err := c.addCluster(opts, ds)
+			err := c.addCluster(context.Background(), opts, datasource.FromV1(ds))
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErrMessage)
@@ -260,7 +261,7 @@ func TestClusterCommand_getCluster(t *testing.T) {
 
 			var buf bytes.Buffer
 			c := ClusterCommand{}
-			err := c.getCluster(tt.clusterName, tt.trustZoneName, ds, &buf)
+			err := c.getCluster(context.Background(), tt.clusterName, tt.trustZoneName, datasource.FromV1(ds), &buf)
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErrMessage)
@@ -432,7 +433,7 @@ func TestClusterCommand_updateCluster(t *testing.T) {
 			}
 
 			c := ClusterCommand{}
-			err := c.updateCluster(tt.clusterName, opts, cmd, ds)
+			err := c.updateCluster(context.Background(), tt.clusterName, opts, cmd, datasource.FromV1(ds))
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErrMessage)
@@ -471,7 +472,7 @@ func TestClusterCommand_updateCluster_withKubeCACert(t *testing.T) {
 	}
 
 	c := ClusterCommand{}
-	err = c.updateCluster("local1", opts, cmd, ds)
+	err = c.updateCluster(context.Background(), "local1", opts, cmd, datasource.FromV1(ds))
 	require.NoError(t, err)
 
 	tz, err := ds.GetTrustZoneByName("tz1")
@@ -0,0 +1,161 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/spf13/cobra"
+)
+
+type ConfigCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewConfigCommand(cmdCtx *cmdcontext.CommandContext) *ConfigCommand {
+	return &ConfigCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var configRootCmdDesc = `
+This command consists of multiple sub-commands to inspect and manage the Cofide configuration.
+`
+
+func (c *ConfigCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config history|revert|validate [ARGS]",
+		Short: "Manage the Cofide configuration",
+		Long:  configRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.getHistoryCommand(),
+		c.getRevertCommand(),
+		c.getValidateCommand(),
+	)
+
+	return cmd
+}
+
+var configHistoryCmdDesc = `
+This command will list the revisions of a Git-backed Cofide configuration, most recent first.
+
+The configuration must be Git-backed, e.g. using --config git:cofide.yaml.
+`
+
+func (c *ConfigCommand) getHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List revisions of the configuration",
+		Long:  configHistoryCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loader, err := c.getHistoryLoader()
+			if err != nil {
+				return err
+			}
+			return renderHistory(os.Stdout, loader)
+		},
+	}
+	return cmd
+}
+
+var configRevertCmdDesc = `
+This command will restore a Git-backed Cofide configuration to its state at revision REV.
+
+The restoration is recorded as a new revision, so it may itself be reverted.
+The configuration must be Git-backed, e.g. using --config git:cofide.yaml.
+`
+
+func (c *ConfigCommand) getRevertCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revert REV",
+		Short: "Restore the configuration to a previous revision",
+		Long:  configRevertCmdDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loader, err := c.getHistoryLoader()
+			if err != nil {
+				return err
+			}
+			if err := loader.Revert(args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("Reverted configuration to %s\n", args[0])
+			return nil
+		},
+	}
+	return cmd
+}
+
+var configValidateCmdDesc = `
+This command will validate the Cofide configuration against its schema, and the configuration of
+the data source and provision plugins against any schemas that the plugins declare.
+`
+
+func (c *ConfigCommand) getValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the configuration",
+		Long:  configValidateCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Reading the configuration validates it against the schema.
+			if _, err := c.cmdCtx.PluginManager.GetConfigLoader().Read(); err != nil {
+				return err
+			}
+			if err := c.cmdCtx.PluginManager.ValidatePluginConfigs(cmd.Context()); err != nil {
+				return err
+			}
+			fmt.Println("Configuration is valid")
+			return nil
+		},
+	}
+	return cmd
+}
+
+// getHistoryLoader returns the config loader if it records a history of revisions.
+func (c *ConfigCommand) getHistoryLoader() (config.HistoryLoader, error) {
+	loader, ok := c.cmdCtx.PluginManager.GetConfigLoader().(config.HistoryLoader)
+	if !ok {
+		return nil, fmt.Errorf("configuration history is only available for Git-backed configuration, e.g. --config %scofide.yaml", config.GitURIPrefix)
+	}
+	return loader, nil
+}
+
+func renderHistory(w io.Writer, loader config.HistoryLoader) error {
+	revisions, err := loader.History()
+	if err != nil {
+		return err
+	}
+
+	data := make([][]string, 0, len(revisions))
+	for _, revision := range revisions {
+		// Only show the subject line of multi-line messages.
+		subject, _, _ := strings.Cut(revision.Message, "\n")
+		data = append(data, []string{
+			config.ShortHash(revision.Hash),
+			revision.Time.Local().Format(time.DateTime),
+			revision.Author,
+			subject,
+		})
+	}
+
+	tr := renderer.NewTableRenderer(w)
+	table := renderer.Table{
+		Header: []string{"Revision", "Time", "Author", "Change"},
+		Data:   data,
+	}
+	_, err = tr.RenderTables(table)
+	return err
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigCommand_getHistoryLoader(t *testing.T) {
+	cmdCtx := cmdcontext.NewCommandContext("cofide.yaml", nil)
+	defer cmdCtx.Shutdown()
+	c := NewConfigCommand(cmdCtx)
+
+	_, err := c.getHistoryLoader()
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "configuration history is only available for Git-backed configuration")
+
+	require.NoError(t, cmdCtx.UpdateConfigFile("git:cofide.yaml", ""))
+	loader, err := c.getHistoryLoader()
+	require.NoError(t, err)
+	assert.IsType(t, &config.GitLoader{}, loader)
+}
+
+func Test_renderHistory(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	loader := config.NewGitLoader(filepath.Join(t.TempDir(), "cofide.yaml"))
+	cfg := config.NewConfig()
+	require.NoError(t, loader.Write(cfg))
+	cfg.TrustZones = []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1"), fixtures.TrustZone("tz2")}
+	require.NoError(t, loader.Write(cfg))
+
+	var buf bytes.Buffer
+	require.NoError(t, renderHistory(&buf, loader))
+
+	out := buf.String()
+	assert.Contains(t, out, "REVISION")
+	assert.Contains(t, out, "add trust zone tz1 and 1 other changes")
+	assert.NotContains(t, out, "- add trust zone tz2")
+	assert.Contains(t, out, "initialise configuration")
+}
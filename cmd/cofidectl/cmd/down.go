@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/statusspinner"
+	"github.com/cofide/cofidectl/internal/pkg/audit"
 	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 	provisionplugin "github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/spf13/cobra"
 )
@@ -38,17 +40,18 @@ func (d *DownCommand) DownCmd() *cobra.Command {
 		Long:  downCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := d.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := d.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			provision, err := d.cmdCtx.PluginManager.GetProvision(cmd.Context())
+			provision, err := d.cmdCtx.PluginManager.GetProvision(ctx)
 			if err != nil {
 				return err
 			}
 
-			tzs, err := ds.ListTrustZones()
+			tzs, err := ds.ListTrustZones(ctx)
 			if err != nil {
 				return err
 			}
@@ -72,11 +75,15 @@ func (d *DownCommand) DownCmd() *cobra.Command {
 				KubeCfgFile:  kubeCfgFile,
 				TrustZoneIDs: trustZoneIDs,
 			}
-			statusCh, err := provision.TearDown(cmd.Context(), ds, &tearDownOpts)
+			recorder := audit.NewProvisionRecorder(d.cmdCtx.PluginManager.GetAuditLog(), audit.OperationDown, opts.trustZones)
+			statusCh, err := provision.TearDown(ctx, datasource.ToV1(ctx, ds), &tearDownOpts)
 			if err != nil {
+				recorder.Finish(err)
 				return err
 			}
-			return statusspinner.WatchProvisionStatus(cmd.Context(), statusCh, opts.quiet)
+			err = statusspinner.WatchProvisionStatus(ctx, recorder.Watch(statusCh), opts.quiet)
+			recorder.Finish(err)
+			return err
 		},
 	}
 
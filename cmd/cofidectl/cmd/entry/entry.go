@@ -0,0 +1,393 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package entry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	"github.com/cofide/cofidectl/internal/pkg/spirecrd"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+
+	k8sPodUIDSelectorPrefix = "k8s:pod-uid:"
+)
+
+type EntryCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewEntryCommand(cmdCtx *cmdcontext.CommandContext) *EntryCommand {
+	return &EntryCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var entryRootCmdDesc = `
+This command consists of multiple sub-commands to explore SPIRE registration entries.
+`
+
+func (c *EntryCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entry list|show [ARGS]",
+		Short: "Explore SPIRE registration entries in a trust zone",
+		Long:  entryRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.GetListCommand(),
+		c.GetShowCommand(),
+	)
+
+	return cmd
+}
+
+var entryListCmdDesc = `
+This command will list the registration entries in the SPIRE server of a trust zone.
+
+Each entry is annotated with the attestation policies whose bindings may have produced it. The
+SPIRE controller manager does not record the resource that produced an entry, so this is inferred
+from the entry's SPIFFE ID, parent ID and the namespace of its pod.
+`
+
+type entryFilter struct {
+	spiffeIDPrefix string
+	selectors      []string
+	namespace      string
+}
+
+type listOpts struct {
+	trustZone   string
+	clusterName string
+	filter      entryFilter
+	output      string
+}
+
+func (c *EntryCommand) GetListCommand() *cobra.Command {
+	opts := listOpts{}
+	cmd := &cobra.Command{
+		Use:   "list [ARGS]",
+		Short: "List registration entries in a trust zone",
+		Long:  entryListCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output); err != nil {
+				return err
+			}
+
+			trustZone, resources, client, err := c.getServerClient(cmd, opts.trustZone, opts.clusterName)
+			if err != nil {
+				return err
+			}
+
+			entries, err := spire.ListEntries(cmd.Context(), client)
+			if err != nil {
+				return fmt.Errorf("failed to list registration entries: %w", err)
+			}
+
+			podNamespaces, err := getPodNamespaces(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+
+			result := []*entry{}
+			for _, e := range filterEntries(entries, opts.filter, podNamespaces) {
+				result = append(result, newEntry(e, trustZone, resources, podNamespaces))
+			}
+			return renderEntries(os.Stdout, result, opts.output)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the registration entries")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.StringVar(&opts.filter.spiffeIDPrefix, "spiffe-id-prefix", "", "Only list entries with a SPIFFE ID that has this prefix")
+	f.StringSliceVar(&opts.filter.selectors, "selector", nil, "Only list entries that have this selector, in type:value form (may be repeated)")
+	f.StringVar(&opts.filter.namespace, "namespace", "", "Only list entries for workloads in this Kubernetes namespace")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text or json")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+var entryShowCmdDesc = `
+This command will show the details of a registration entry in the SPIRE server of a trust zone.
+`
+
+type showOpts struct {
+	trustZone   string
+	clusterName string
+	output      string
+}
+
+func (c *EntryCommand) GetShowCommand() *cobra.Command {
+	opts := showOpts{}
+	cmd := &cobra.Command{
+		Use:   "show ENTRY_ID [ARGS]",
+		Short: "Show a registration entry in a trust zone",
+		Long:  entryShowCmdDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output); err != nil {
+				return err
+			}
+
+			trustZone, resources, client, err := c.getServerClient(cmd, opts.trustZone, opts.clusterName)
+			if err != nil {
+				return err
+			}
+
+			e, err := spire.GetEntry(cmd.Context(), client, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get registration entry %s: %w", args[0], err)
+			}
+
+			podNamespaces, err := getPodNamespaces(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+			return renderEntry(os.Stdout, newEntry(e, trustZone, resources, podNamespaces), opts.output)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone of the registration entry")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text or json")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+// getServerClient returns a trust zone, its SPIRE controller manager custom resources, and a
+// Kubernetes client for the cluster running its SPIRE server.
+func (c *EntryCommand) getServerClient(cmd *cobra.Command, tzName, clusterName string) (*trust_zone_proto.TrustZone, *spirecrd.Resources, *kubeutil.Client, error) {
+	ctx := cmd.Context()
+	ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	kubeConfig, err := cmd.Flags().GetString("kube-config")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to retrieve the kubeconfig file location")
+	}
+
+	trustZone, client, err := trustzone.GetServerClient(datasource.ToV1(ctx, ds), kubeConfig, tzName, clusterName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resources, err := getResources(ctx, trustZone, ds)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return trustZone, resources, client, nil
+}
+
+func getResources(ctx context.Context, trustZone *trust_zone_proto.TrustZone, ds datasource.DataSourceV2) (*spirecrd.Resources, error) {
+	resources, err := trustzone.GetSPIRECRDs(trustZone, datasource.ToV1(ctx, ds))
+	if err != nil {
+		return nil, err
+	}
+	resources.SetDefaults("")
+	return resources, nil
+}
+
+// getPodNamespaces returns the namespaces of the pods in a cluster, keyed by pod UID. The SPIRE
+// controller manager identifies the pod of an entry by a k8s:pod-uid selector, so this is used to
+// resolve the namespace of an entry.
+func getPodNamespaces(ctx context.Context, client *kubeutil.Client) (map[string]string, error) {
+	pods, err := client.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	podNamespaces := map[string]string{}
+	for _, pod := range pods.Items {
+		podNamespaces[string(pod.UID)] = pod.Namespace
+	}
+	return podNamespaces, nil
+}
+
+// getNamespace returns the namespace of the pod of an entry, or an empty string if the entry is
+// not for a known pod.
+func getNamespace(e *spire.Entry, podNamespaces map[string]string) string {
+	for _, selector := range e.Selectors {
+		if uid, ok := strings.CutPrefix(selector, k8sPodUIDSelectorPrefix); ok {
+			return podNamespaces[uid]
+		}
+	}
+	return ""
+}
+
+func validateOutput(output string) error {
+	if output != outputText && output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, expected one of %s, %s", output, outputText, outputJSON)
+	}
+	return nil
+}
+
+// filterEntries returns the registration entries that match the filter. podNamespaces maps pod
+// UIDs to namespaces, as returned by getPodNamespaces.
+func filterEntries(entries []*spire.Entry, filter entryFilter, podNamespaces map[string]string) []*spire.Entry {
+	result := []*spire.Entry{}
+	for _, e := range entries {
+		if !strings.HasPrefix(e.SPIFFEID, filter.spiffeIDPrefix) {
+			continue
+		}
+		if filter.namespace != "" && getNamespace(e, podNamespaces) != filter.namespace {
+			continue
+		}
+		if !containsAll(e.Selectors, filter.selectors) {
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+func containsAll(selectors, required []string) bool {
+	for _, selector := range required {
+		if !slices.Contains(selectors, selector) {
+			return false
+		}
+	}
+	return true
+}
+
+// entry is a registration entry annotated with the attestation policies that may have produced it.
+type entry struct {
+	*spire.Entry
+	Policies []string
+}
+
+func newEntry(e *spire.Entry, trustZone *trust_zone_proto.TrustZone, resources *spirecrd.Resources, podNamespaces map[string]string) *entry {
+	return &entry{
+		Entry:    e,
+		Policies: resources.EntryOwners(trustZone.GetTrustDomain(), e.SPIFFEID, e.ParentID, getNamespace(e, podNamespaces)),
+	}
+}
+
+type entryJSON struct {
+	ID            string   `json:"id"`
+	SPIFFEID      string   `json:"spiffe_id"`
+	ParentID      string   `json:"parent_id"`
+	Selectors     []string `json:"selectors"`
+	DNSNames      []string `json:"dns_names,omitempty"`
+	FederatesWith []string `json:"federates_with,omitempty"`
+	X509SVIDTTL   int64    `json:"x509_svid_ttl"`
+	JWTSVIDTTL    int64    `json:"jwt_svid_ttl"`
+	Admin         bool     `json:"admin"`
+	Downstream    bool     `json:"downstream"`
+	Hint          string   `json:"hint,omitempty"`
+	ExpiresAt     string   `json:"expires_at,omitempty"`
+	Policies      []string `json:"policies"`
+}
+
+func newEntryJSON(e *entry) entryJSON {
+	result := entryJSON{
+		ID:            e.ID,
+		SPIFFEID:      e.SPIFFEID,
+		ParentID:      e.ParentID,
+		Selectors:     e.Selectors,
+		DNSNames:      e.DNSNames,
+		FederatesWith: e.FederatesWith,
+		X509SVIDTTL:   int64(e.X509SVIDTTL.Seconds()),
+		JWTSVIDTTL:    int64(e.JWTSVIDTTL.Seconds()),
+		Admin:         e.Admin,
+		Downstream:    e.Downstream,
+		Hint:          e.Hint,
+		Policies:      e.Policies,
+	}
+	if !e.ExpiresAt.IsZero() {
+		result.ExpiresAt = e.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return result
+}
+
+// renderEntries writes registration entries to the writer in the specified format.
+func renderEntries(w io.Writer, entries []*entry, output string) error {
+	switch output {
+	case outputJSON:
+		result := []entryJSON{}
+		for _, e := range entries {
+			result = append(result, newEntryJSON(e))
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	default:
+		data := make([][]string, 0, len(entries))
+		for _, e := range entries {
+			data = append(data, []string{
+				e.ID,
+				e.SPIFFEID,
+				e.ParentID,
+				strings.Join(e.Selectors, "\n"),
+				strings.Join(e.Policies, ", "),
+			})
+		}
+		_, err := renderer.NewTableRenderer(w).RenderTables(renderer.Table{
+			Header: []string{"Entry ID", "SPIFFE ID", "Parent ID", "Selectors", "Policies"},
+			Data:   data,
+		})
+		return err
+	}
+}
+
+// renderEntry writes the details of a registration entry to the writer in the specified format.
+func renderEntry(w io.Writer, e *entry, output string) error {
+	switch output {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(newEntryJSON(e))
+	default:
+		expiresAt := "never"
+		if !e.ExpiresAt.IsZero() {
+			expiresAt = e.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		_, err := renderer.NewTableRenderer(w).RenderTables(renderer.Table{
+			Header: []string{"Field", "Value"},
+			Data: [][]string{
+				{"Entry ID", e.ID},
+				{"SPIFFE ID", e.SPIFFEID},
+				{"Parent ID", e.ParentID},
+				{"Selectors", strings.Join(e.Selectors, "\n")},
+				{"DNS Names", strings.Join(e.DNSNames, "\n")},
+				{"Federates With", strings.Join(e.FederatesWith, "\n")},
+				{"X509-SVID TTL", e.X509SVIDTTL.String()},
+				{"JWT-SVID TTL", e.JWTSVIDTTL.String()},
+				{"Admin", strconv.FormatBool(e.Admin)},
+				{"Downstream", strconv.FormatBool(e.Downstream)},
+				{"Hint", e.Hint},
+				{"Expires", expiresAt},
+				{"Policies", strings.Join(e.Policies, ", ")},
+			},
+		})
+		return err
+	}
+}
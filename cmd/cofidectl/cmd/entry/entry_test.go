@@ -0,0 +1,180 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package entry
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testPodNamespaces = map[string]string{"1234": "ns1", "5678": "ns2"}
+
+var testEntries = []*spire.Entry{
+	{
+		ID:        "entry1",
+		SPIFFEID:  "spiffe://td1/ns/ns1/sa/sa1",
+		ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+		Selectors: []string{"k8s:pod-uid:1234"},
+	},
+	{
+		ID:        "entry2",
+		SPIFFEID:  "spiffe://td1/ns/ns2/sa/sa2",
+		ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+		Selectors: []string{"k8s:pod-uid:5678"},
+	},
+	{
+		ID:        "entry3",
+		SPIFFEID:  "spiffe://td1/vm1",
+		ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+		Selectors: []string{"unix:uid:1000"},
+	},
+}
+
+func Test_filterEntries(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter entryFilter
+		want   []string
+	}{
+		{name: "no filter", filter: entryFilter{}, want: []string{"entry1", "entry2", "entry3"}},
+		{name: "SPIFFE ID prefix", filter: entryFilter{spiffeIDPrefix: "spiffe://td1/ns/"}, want: []string{"entry1", "entry2"}},
+		{name: "namespace", filter: entryFilter{namespace: "ns2"}, want: []string{"entry2"}},
+		{name: "selector", filter: entryFilter{selectors: []string{"unix:uid:1000"}}, want: []string{"entry3"}},
+		{name: "all selectors", filter: entryFilter{selectors: []string{"k8s:pod-uid:1234", "k8s:pod-uid:5678"}}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := []string{}
+			for _, e := range filterEntries(testEntries, tt.filter, testPodNamespaces) {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func Test_getPodNamespaces(t *testing.T) {
+	clientSet := fake.NewClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", UID: "1234"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "pod2", UID: "5678"}},
+	)
+	client := &kubeutil.Client{Clientset: clientSet}
+
+	got, err := getPodNamespaces(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, testPodNamespaces, got)
+}
+
+func Test_newEntry(t *testing.T) {
+	ds := newFakeDataSource(t, defaultConfig())
+	trustZone, err := ds.GetTrustZoneByName("tz1")
+	require.NoError(t, err)
+	resources, err := getResources(context.Background(), trustZone, datasource.FromV1(ds))
+	require.NoError(t, err)
+
+	// ap1 selects namespace ns1 and uses the default SPIFFE ID template.
+	assert.Equal(t, []string{"ap1"}, newEntry(testEntries[0], trustZone, resources, testPodNamespaces).Policies)
+	assert.Equal(t, []string{}, newEntry(testEntries[1], trustZone, resources, testPodNamespaces).Policies)
+	assert.Equal(t, []string{}, newEntry(testEntries[2], trustZone, resources, testPodNamespaces).Policies)
+	// The namespace of a pod that no longer exists cannot be resolved.
+	assert.Equal(t, []string{}, newEntry(testEntries[0], trustZone, resources, map[string]string{}).Policies)
+}
+
+func Test_renderEntry(t *testing.T) {
+	e := &entry{
+		Entry: &spire.Entry{
+			ID:            "entry1",
+			SPIFFEID:      "spiffe://td1/ns/ns1/sa/sa1",
+			ParentID:      "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			Selectors:     []string{"k8s:pod-uid:1234"},
+			FederatesWith: []string{"td2"},
+			X509SVIDTTL:   time.Hour,
+			ExpiresAt:     time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		Policies: []string{"ap1"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderEntry(&buf, e, outputJSON))
+	assert.Equal(t, `{
+  "id": "entry1",
+  "spiffe_id": "spiffe://td1/ns/ns1/sa/sa1",
+  "parent_id": "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+  "selectors": [
+    "k8s:pod-uid:1234"
+  ],
+  "federates_with": [
+    "td2"
+  ],
+  "x509_svid_ttl": 3600,
+  "jwt_svid_ttl": 0,
+  "admin": false,
+  "downstream": false,
+  "expires_at": "2026-01-01T12:00:00Z",
+  "policies": [
+    "ap1"
+  ]
+}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, renderEntry(&buf, e, outputText))
+	assert.Contains(t, buf.String(), "spiffe://td1/ns/ns1/sa/sa1")
+	assert.Contains(t, buf.String(), "1h0m0s")
+
+	buf.Reset()
+	require.NoError(t, renderEntries(&buf, []*entry{e}, outputText))
+	assert.Contains(t, buf.String(), "entry1")
+	assert.Contains(t, buf.String(), "ap1")
+}
+
+func newFakeDataSource(t *testing.T, cfg *config.Config) datasource.DataSource {
+	configLoader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	lds, err := local.NewLocalDataSource(configLoader)
+	require.NoError(t, err)
+	return lds
+}
+
+func defaultConfig() *config.Config {
+	return &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+			fixtures.TrustZone("tz2"),
+		},
+		Clusters: []*clusterpb.Cluster{
+			fixtures.Cluster("local1"),
+			fixtures.Cluster("local2"),
+		},
+		AttestationPolicies: []*attestation_policy_proto.AttestationPolicy{
+			fixtures.AttestationPolicy("ap1"),
+		},
+		APBindings: []*ap_binding_proto.APBinding{
+			fixtures.APBinding("apb1"),
+		},
+		Federations: []*federation_proto.Federation{
+			fixtures.Federation("fed1"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+}
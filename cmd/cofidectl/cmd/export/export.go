@@ -0,0 +1,162 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cofide/cofidectl/internal/pkg/spirecrd"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
+)
+
+type ExportCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewExportCommand(cmdCtx *cmdcontext.CommandContext) *ExportCommand {
+	return &ExportCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var exportRootCmdDesc = `
+This command consists of multiple sub-commands to export resources generated from the Cofide configuration state.
+`
+
+func (c *ExportCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export crds [ARGS]",
+		Short: "Export resources generated from the Cofide configuration state",
+		Long:  exportRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.GetCRDsCommand(),
+	)
+
+	return cmd
+}
+
+var exportCRDsCmdDesc = `
+This command will export the SPIRE controller manager custom resources for a trust zone:
+a ClusterSPIFFEID or ClusterStaticEntry for each bound attestation policy, and a
+ClusterFederatedTrustDomain for each federated trust zone with a bundle endpoint.
+
+The resources are written to stdout as YAML, or applied to each cluster in the trust zone
+using server-side apply with --apply. This allows SPIRE installations that are not managed by
+cofidectl to consume the configuration.
+`
+
+type crdsOpts struct {
+	trustZone string
+	className string
+	apply     bool
+}
+
+func (c *ExportCommand) GetCRDsCommand() *cobra.Command {
+	opts := crdsOpts{}
+	cmd := &cobra.Command{
+		Use:   "crds [ARGS]",
+		Short: "Export SPIRE controller manager custom resources for a trust zone",
+		Long:  exportCRDsCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+			if err != nil {
+				return err
+			}
+
+			resources, err := getResources(ctx, ds, opts)
+			if err != nil {
+				return err
+			}
+
+			if !opts.apply {
+				return writeResources(resources, os.Stdout)
+			}
+
+			kubeConfig, err := cmd.Flags().GetString("kube-config")
+			if err != nil {
+				return fmt.Errorf("failed to retrieve the kubeconfig file location")
+			}
+			return applyResources(ctx, ds, kubeConfig, opts.trustZone, resources)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone to export resources for")
+	f.StringVar(&opts.className, "class-name", "", "SPIRE controller manager class name to set on the resources")
+	f.BoolVar(&opts.apply, "apply", false, "Apply the resources to each cluster in the trust zone using server-side apply instead of writing them to stdout")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+// getResources returns the SPIRE controller manager custom resources for a trust zone, with the
+// defaults that the SPIRE Helm chart would otherwise apply.
+func getResources(ctx context.Context, ds datasource.DataSourceV2, opts crdsOpts) ([]spirecrd.Object, error) {
+	trustZone, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := trustzone.GetSPIRECRDs(trustZone, datasource.ToV1(ctx, ds))
+	if err != nil {
+		return nil, err
+	}
+	resources.SetDefaults(opts.className)
+	return resources.Objects(), nil
+}
+
+// writeResources writes resources in YAML format to the specified writer.
+func writeResources(resources []spirecrd.Object, writer io.Writer) error {
+	data, err := spirecrd.MarshalYAML(resources)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
+}
+
+// applyResources applies resources to each cluster in a trust zone using server-side apply.
+func applyResources(ctx context.Context, ds datasource.DataSourceV2, kubeConfig, tzName string, resources []spirecrd.Object) error {
+	trustZone, err := ds.GetTrustZoneByName(ctx, tzName)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := trustzone.GetClustersByTrustZone(trustZone, datasource.ToV1(ctx, ds))
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusters {
+		client, err := kubeutil.NewKubeClientFromSpecifiedContext(kubeConfig, cluster.GetKubernetesContext())
+		if err != nil {
+			return err
+		}
+
+		dynamicClient, err := dynamic.NewForConfig(client.RestConfig)
+		if err != nil {
+			return err
+		}
+
+		if err := spirecrd.Apply(ctx, dynamicClient, resources); err != nil {
+			return fmt.Errorf("failed to apply resources to cluster %s: %w", cluster.GetName(), err)
+		}
+		fmt.Printf("Applied %d resources to cluster %s\n", len(resources), cluster.GetName())
+	}
+	return nil
+}
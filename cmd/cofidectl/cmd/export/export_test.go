@@ -0,0 +1,131 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getResources(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    crdsOpts
+		want    string
+		wantErr string
+	}{
+		{
+			name: "kubernetes policy and federation",
+			opts: crdsOpts{trustZone: "tz1"},
+			want: `apiVersion: spire.spiffe.io/v1alpha1
+kind: ClusterSPIFFEID
+metadata:
+  name: ap1
+spec:
+  federatesWith:
+    - td2
+  namespaceSelector:
+    matchLabels:
+      kubernetes.io/metadata.name: ns1
+  spiffeIDTemplate: spiffe://{{ .TrustDomain }}/ns/{{ .PodMeta.Namespace }}/sa/{{ .PodSpec.ServiceAccountName }}
+---
+apiVersion: spire.spiffe.io/v1alpha1
+kind: ClusterFederatedTrustDomain
+metadata:
+  name: tz2
+spec:
+  bundleEndpointProfile:
+    type: https_web
+  bundleEndpointURL: 127.0.0.2
+  trustDomain: td2
+`,
+		},
+		{
+			name: "static policy with class name",
+			opts: crdsOpts{trustZone: "tz6", className: "spire-system-spire"},
+			want: `apiVersion: spire.spiffe.io/v1alpha1
+kind: ClusterStaticEntry
+metadata:
+  name: ap4
+spec:
+  className: spire-system-spire
+  dnsNames:
+    - fake.example.org
+  parentID: spiffe://td6/spire/agent/bar
+  selectors:
+    - k8s:ns:foo
+  spiffeID: spiffe://td6/foo
+`,
+		},
+		{
+			name:    "unknown trust zone",
+			opts:    crdsOpts{trustZone: "invalid"},
+			wantErr: "failed to find trust zone invalid in local config",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := newFakeDataSource(t, defaultConfig())
+
+			resources, err := getResources(context.Background(), datasource.FromV1(ds), tt.opts)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, writeResources(resources, &buf))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func newFakeDataSource(t *testing.T, cfg *config.Config) datasource.DataSource {
+	configLoader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	lds, err := local.NewLocalDataSource(configLoader)
+	require.NoError(t, err)
+	return lds
+}
+
+func defaultConfig() *config.Config {
+	return &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+			fixtures.TrustZone("tz2"),
+			fixtures.TrustZone("tz6"),
+		},
+		Clusters: []*clusterpb.Cluster{
+			fixtures.Cluster("local1"),
+			fixtures.Cluster("local2"),
+			fixtures.Cluster("local6"),
+		},
+		AttestationPolicies: []*attestation_policy_proto.AttestationPolicy{
+			fixtures.AttestationPolicy("ap1"),
+			fixtures.AttestationPolicy("ap4"),
+		},
+		APBindings: []*ap_binding_proto.APBinding{
+			fixtures.APBinding("apb1"),
+			fixtures.APBinding("apb3"),
+		},
+		Federations: []*federation_proto.Federation{
+			fixtures.Federation("fed1"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+}
@@ -7,20 +7,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 
-	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
 	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
 	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
 	"github.com/cofide/cofidectl/internal/pkg/trustzone"
 	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
 
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
-	kubeutil "github.com/cofide/cofidectl/pkg/kube"
-	"github.com/cofide/cofidectl/pkg/provider/helm"
-	"github.com/cofide/cofidectl/pkg/spire"
 	"github.com/spf13/cobra"
 )
 
@@ -74,7 +73,8 @@ func (c *FederationCommand) GetListCommand() *cobra.Command {
 		Long:  federationListCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
@@ -84,26 +84,35 @@ func (c *FederationCommand) GetListCommand() *cobra.Command {
 				return err
 			}
 
-			federations, err := ds.ListFederations(&datasourcepb.ListFederationsRequest_Filter{})
+			// Federation status cannot be checked if the provision plugin does not support status
+			// queries, but federations are still listed.
+			reporter, reporterErr := c.cmdCtx.PluginManager.GetStatusReporter(ctx)
+			if reporterErr != nil && !errors.Is(reporterErr, plugin.ErrNotSupported) {
+				return reporterErr
+			}
+
+			federations, err := ds.ListFederations(ctx, &datasourcepb.ListFederationsRequest_Filter{})
 			if err != nil {
 				return err
 			}
 
 			data := make([][]string, len(federations))
 			for i, federation := range federations {
-				trustZone, err := ds.GetTrustZone(federation.GetTrustZoneId())
+				trustZone, err := ds.GetTrustZone(ctx, federation.GetTrustZoneId())
 				if err != nil {
 					return err
 				}
 
-				remoteTrustZone, err := ds.GetTrustZone(federation.GetRemoteTrustZoneId())
+				remoteTrustZone, err := ds.GetTrustZone(ctx, federation.GetRemoteTrustZoneId())
 				if err != nil {
 					return err
 				}
 
-				status, reason, err := checkFederationStatus(cmd.Context(), ds, kubeConfig, trustZone, remoteTrustZone)
-				if err != nil {
-					return err
+				status, reason := "Unknown", ""
+				if reporter != nil {
+					status, reason = checkFederationStatus(ctx, ds, reporter, kubeConfig, trustZone, remoteTrustZone)
+				} else {
+					reason = reporterErr.Error()
 				}
 
 				data[i] = []string{
@@ -127,76 +136,58 @@ func (c *FederationCommand) GetListCommand() *cobra.Command {
 	return cmd
 }
 
-type bundles struct {
-	serverCABundle   string
-	federatedBundles map[string]string
-}
-
-// selectUsableCluster returns the first reachable cluster in a trust zone, or an error if none is found.
-func selectUsableCluster(ctx context.Context, tz *trust_zone_proto.TrustZone, ds datasource.DataSource, kubeConfig string) (*clusterpb.Cluster, error) {
-	clusters, err := trustzone.GetClustersByTrustZone(tz, ds)
+// getClusterStatus returns the status of the first cluster in a trust zone whose status can be
+// retrieved, or an error if there is none.
+func getClusterStatus(ctx context.Context, tz *trust_zone_proto.TrustZone, ds datasource.DataSourceV2, reporter provision.StatusReporter, kubeConfig string) (*provision.ClusterStatus, error) {
+	clusters, err := trustzone.GetClustersByTrustZone(tz, datasource.ToV1(ctx, ds))
 	if err != nil {
 		return nil, err
 	}
 	for _, c := range clusters {
-		if helm.IsClusterReachable(ctx, c, kubeConfig) == nil {
-			return c, nil
+		clusterStatus, err := reporter.GetStatus(ctx, datasource.ToV1(ctx, ds), &provision.GetStatusOpts{KubeCfgFile: kubeConfig, ClusterID: c.GetId()})
+		if err == nil {
+			return clusterStatus, nil
 		}
+		slog.Debug("Failed to get cluster status", "cluster", c.GetName(), "trust_zone", tz.GetName(), "error", err)
 	}
 	return nil, fmt.Errorf("no reachable cluster in trust zone %s", tz.GetName())
 }
 
-// checkFederationStatus builds a comparison map between two trust domains, retrieves there server CA bundle and any federated bundles available
-// locally from the SPIRE server, and then compares the bundles on each to verify SPIRE has the correct bundles on each side of the federation
-func checkFederationStatus(ctx context.Context, ds datasource.DataSource, kubeConfig string, from *trust_zone_proto.TrustZone, to *trust_zone_proto.TrustZone) (string, string, error) {
-	compare := make(map[*trust_zone_proto.TrustZone]bundles)
+// checkFederationStatus retrieves the server CA bundle and any federated bundles held by the SPIRE
+// servers in each trust zone from the provision plugin, and then compares the bundles on each to
+// verify SPIRE has the correct bundles on each side of the federation
+func checkFederationStatus(ctx context.Context, ds datasource.DataSourceV2, reporter provision.StatusReporter, kubeConfig string, from *trust_zone_proto.TrustZone, to *trust_zone_proto.TrustZone) (string, string) {
+	compare := make(map[*trust_zone_proto.TrustZone]*provision.BundleStatus)
 
 	for _, tz := range []*trust_zone_proto.TrustZone{from, to} {
-		cluster, err := selectUsableCluster(ctx, tz, ds, kubeConfig)
+		clusterStatus, err := getClusterStatus(ctx, tz, ds, reporter, kubeConfig)
 		if err != nil {
 			if errors.Is(err, trustzone.ErrNoClustersInTrustZone) {
-				return "No cluster", "N/A", nil
+				return "No cluster", "N/A"
 			}
-			return "Unknown", err.Error(), nil
+			return "Unknown", err.Error()
 		}
-		if cluster.GetKubernetesContext() == "" {
-			return "Unknown", fmt.Sprintf("no kubernetes context for cluster %q in trust zone %q", cluster.GetName(), tz.GetName()), nil
-		}
-
-		if deployed, err := helm.IsClusterDeployed(ctx, cluster, kubeConfig); err != nil {
-			return "", "", err
-		} else if !deployed {
-			return "Inactive", "", nil
+		if !clusterStatus.Deployed {
+			return "Inactive", ""
 		}
-
-		client, err := kubeutil.NewKubeClientFromSpecifiedContext(kubeConfig, cluster.GetKubernetesContext())
-		if err != nil {
-			return "", "", err
-		}
-
-		serverCABundle, federatedBundles, err := spire.GetServerCABundleAndFederatedBundles(ctx, client)
-		if err != nil {
-			return "", "", err
-		}
-
-		compare[tz] = bundles{
-			serverCABundle:   serverCABundle,
-			federatedBundles: federatedBundles,
+		if clusterStatus.Bundles == nil {
+			return "Unknown", fmt.Sprintf("no bundle status for trust zone %s", tz.GetName())
 		}
+		compare[tz] = clusterStatus.Bundles
 	}
 
 	// Bundle does not exist at all on opposite trust domain
-	_, ok := compare[from].federatedBundles[to.TrustDomain]
+	_, ok := compare[from].FederatedBundles[to.TrustDomain]
 	if !ok {
-		return FederationStatusUnhealthy, FederationStatusReasonNoBundleFound, nil
+		return FederationStatusUnhealthy, FederationStatusReasonNoBundleFound
 	}
 
 	// Bundle does not match entry on opposite trust domain
-	if compare[from].federatedBundles[to.TrustDomain] != compare[to].serverCABundle {
-		return FederationStatusUnhealthy, FederationStatusReasonBundlesDoNotMatch, nil
+	if compare[from].FederatedBundles[to.TrustDomain] != compare[to].ServerCABundle {
+		return FederationStatusUnhealthy, FederationStatusReasonBundlesDoNotMatch
 	}
 
-	return FederationStatusHealthy, "", nil
+	return FederationStatusHealthy, ""
 }
 
 var federationAddCmdDesc = `
@@ -216,18 +207,19 @@ func (c *FederationCommand) GetAddCommand() *cobra.Command {
 		Long:  federationAddCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			tz, err := ds.GetTrustZoneByName(opts.trustZone)
+			tz, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 			if err != nil {
 				return fmt.Errorf("failed to get trust zone %s: %w", opts.trustZone, err)
 			}
 			trustZoneID := tz.GetId()
 
-			tz, err = ds.GetTrustZoneByName(opts.remoteTrustZone)
+			tz, err = ds.GetTrustZoneByName(ctx, opts.remoteTrustZone)
 			if err != nil {
 				return fmt.Errorf("failed to get remote trust zone %s: %w", opts.remoteTrustZone, err)
 			}
@@ -237,7 +229,7 @@ func (c *FederationCommand) GetAddCommand() *cobra.Command {
 				TrustZoneId:       &trustZoneID,
 				RemoteTrustZoneId: &remoteTrustZoneID,
 			}
-			_, err = ds.AddFederation(newFederation)
+			_, err = ds.AddFederation(ctx, newFederation)
 			return err
 		},
 	}
@@ -287,25 +279,25 @@ func (c *FederationCommand) getDelCommand() *cobra.Command {
 }
 
 func (c *FederationCommand) deleteFederation(ctx context.Context, opts Opts) error {
-	ds, err := c.cmdCtx.PluginManager.GetDataSource(ctx)
+	ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 	if err != nil {
 		return err
 	}
 
-	tz, err := ds.GetTrustZoneByName(opts.trustZone)
+	tz, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 	if err != nil {
 		return fmt.Errorf("failed to get trust zone %s: %w", opts.trustZone, err)
 	}
 	trustZoneID := tz.GetId()
 
-	tz, err = ds.GetTrustZoneByName(opts.remoteTrustZone)
+	tz, err = ds.GetTrustZoneByName(ctx, opts.remoteTrustZone)
 	if err != nil {
 		return fmt.Errorf("failed to get remote trust zone %s: %w", opts.remoteTrustZone, err)
 	}
 	remoteTrustZoneID := tz.GetId()
 
 	// TODO: filter by remote trust zone
-	federations, err := ds.ListFederations(&datasourcepb.ListFederationsRequest_Filter{
+	federations, err := ds.ListFederations(ctx, &datasourcepb.ListFederationsRequest_Filter{
 		TrustZoneId: &trustZoneID,
 	})
 	if err != nil {
@@ -316,7 +308,7 @@ func (c *FederationCommand) deleteFederation(ctx context.Context, opts Opts) err
 	}
 	for _, federation := range federations {
 		if federation.GetRemoteTrustZoneId() == remoteTrustZoneID {
-			return ds.DestroyFederation(federation.GetId())
+			return ds.DestroyFederation(ctx, federation.GetId())
 		}
 	}
 	return errors.New("no federation found")
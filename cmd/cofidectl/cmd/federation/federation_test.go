@@ -0,0 +1,136 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package federation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkFederationStatus(t *testing.T) {
+	deployed := func(serverCABundle string, federatedBundles map[string]string) *provision.ClusterStatus {
+		return &provision.ClusterStatus{
+			Deployed: true,
+			Bundles:  &provision.BundleStatus{ServerCABundle: serverCABundle, FederatedBundles: federatedBundles},
+		}
+	}
+	tests := []struct {
+		name       string
+		clusters   []*clusterpb.Cluster
+		statuses   map[string]*provision.ClusterStatus
+		wantStatus string
+		wantReason string
+	}{
+		{
+			name:     "healthy",
+			clusters: []*clusterpb.Cluster{fixtures.Cluster("local1"), fixtures.Cluster("local2")},
+			statuses: map[string]*provision.ClusterStatus{
+				"local1-id": deployed("bundle1", map[string]string{"td2": "bundle2"}),
+				"local2-id": deployed("bundle2", map[string]string{"td1": "bundle1"}),
+			},
+			wantStatus: FederationStatusHealthy,
+		},
+		{
+			name:     "no bundle",
+			clusters: []*clusterpb.Cluster{fixtures.Cluster("local1"), fixtures.Cluster("local2")},
+			statuses: map[string]*provision.ClusterStatus{
+				"local1-id": deployed("bundle1", map[string]string{}),
+				"local2-id": deployed("bundle2", map[string]string{"td1": "bundle1"}),
+			},
+			wantStatus: FederationStatusUnhealthy,
+			wantReason: FederationStatusReasonNoBundleFound,
+		},
+		{
+			name:     "bundles do not match",
+			clusters: []*clusterpb.Cluster{fixtures.Cluster("local1"), fixtures.Cluster("local2")},
+			statuses: map[string]*provision.ClusterStatus{
+				"local1-id": deployed("bundle1", map[string]string{"td2": "old-bundle2"}),
+				"local2-id": deployed("bundle2", map[string]string{"td1": "bundle1"}),
+			},
+			wantStatus: FederationStatusUnhealthy,
+			wantReason: FederationStatusReasonBundlesDoNotMatch,
+		},
+		{
+			name:     "inactive",
+			clusters: []*clusterpb.Cluster{fixtures.Cluster("local1"), fixtures.Cluster("local2")},
+			statuses: map[string]*provision.ClusterStatus{
+				"local1-id": {Deployed: false},
+				"local2-id": deployed("bundle2", map[string]string{"td1": "bundle1"}),
+			},
+			wantStatus: "Inactive",
+		},
+		{
+			name:       "no cluster",
+			clusters:   []*clusterpb.Cluster{fixtures.Cluster("local1")},
+			statuses:   map[string]*provision.ClusterStatus{"local1-id": deployed("bundle1", nil)},
+			wantStatus: "No cluster",
+			wantReason: "N/A",
+		},
+		{
+			name:     "unreachable",
+			clusters: []*clusterpb.Cluster{fixtures.Cluster("local1"), fixtures.Cluster("local2")},
+			statuses: map[string]*provision.ClusterStatus{
+				"local1-id": deployed("bundle1", map[string]string{"td2": "bundle2"}),
+			},
+			wantStatus: "Unknown",
+			wantReason: "no reachable cluster in trust zone tz2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := newFakeDataSource(t, &config.Config{
+				TrustZones: []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1"), fixtures.TrustZone("tz2")},
+				Clusters:   tt.clusters,
+				Plugins:    fixtures.Plugins("plugins1"),
+			})
+			reporter := &fakeStatusReporter{statuses: tt.statuses}
+			from, to := fixtures.TrustZone("tz1"), fixtures.TrustZone("tz2")
+
+			status, reason := checkFederationStatus(context.Background(), datasource.FromV1(ds), reporter, "kube.cfg", from, to)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+// fakeStatusReporter returns the status of clusters by cluster ID, or an error for clusters
+// without a status.
+type fakeStatusReporter struct {
+	statuses map[string]*provision.ClusterStatus
+}
+
+func (f *fakeStatusReporter) IsDeployed(ctx context.Context, ds datasource.DataSource, opts *provision.IsDeployedOpts) (bool, error) {
+	clusterStatus, err := f.GetStatus(ctx, ds, &provision.GetStatusOpts{KubeCfgFile: opts.KubeCfgFile, ClusterID: opts.ClusterID})
+	if err != nil {
+		return false, err
+	}
+	return clusterStatus.Deployed, nil
+}
+
+func (f *fakeStatusReporter) GetStatus(_ context.Context, _ datasource.DataSource, opts *provision.GetStatusOpts) (*provision.ClusterStatus, error) {
+	clusterStatus, ok := f.statuses[opts.ClusterID]
+	if !ok {
+		return nil, errors.New("cluster unreachable")
+	}
+	return clusterStatus, nil
+}
+
+func newFakeDataSource(t *testing.T, cfg *config.Config) datasource.DataSource {
+	configLoader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	lds, err := local.NewLocalDataSource(configLoader)
+	require.NoError(t, err)
+	return lds
+}
@@ -9,7 +9,6 @@ import (
 
 	pluginspb "github.com/cofide/cofidectl-sdk/gen/go/proto/plugins/v1alpha1"
 	"github.com/cofide/cofidectl/pkg/cmd/context"
-	"github.com/cofide/cofidectl/pkg/plugin"
 	"github.com/cofide/cofidectl/pkg/plugin/manager"
 	"github.com/spf13/cobra"
 )
@@ -48,7 +47,7 @@ func (i *InitCommand) GetRootCommand() *cobra.Command {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.enableConnect {
-				if ok, _ := plugin.PluginExists(connectPluginName); ok {
+				if _, err := i.cmdCtx.PluginManager.FindPlugin(connectPluginName); err == nil {
 					fmt.Println(`Please run "cofidectl connect init"`)
 				} else {
 					fmt.Println("👀 get in touch with us at hello@cofide.io to find out more")
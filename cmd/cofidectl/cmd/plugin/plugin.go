@@ -0,0 +1,524 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/manager"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// checksumLength is the number of characters of a checksum shown in the plugin list.
+	checksumLength = 12
+)
+
+type PluginCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewPluginCommand(cmdCtx *cmdcontext.CommandContext) *PluginCommand {
+	return &PluginCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var pluginRootCmdDesc = `
+This command consists of multiple sub-commands to manage the installed cofidectl plugins.
+`
+
+func (c *PluginCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin list|install|remove|info|config [ARGS]",
+		Short: "Manage cofidectl plugins",
+		Long:  pluginRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.getListCommand(),
+		c.getInstallCommand(),
+		c.getRemoveCommand(),
+		c.getInfoCommand(),
+		c.getConfigCommand(),
+	)
+
+	return cmd
+}
+
+var pluginListCmdDesc = `
+This command will list the plugins in each directory of the plugin search path, in order.
+
+The search path consists of the directories in COFIDECTL_PLUGIN_PATH, then those in the plugins.path
+setting of the config file, then ~/.cofide/plugins. A plugin is shadowed by a plugin of the same
+name in an earlier directory.
+
+The status of each plugin is one of:
+  verified: the plugin matches the checksum recorded when it was installed
+  modified: the plugin does not match the checksum recorded when it was installed, and will not be loaded
+  unlocked: the plugin was not installed using cofidectl plugin install, so has no recorded checksum
+  missing: the plugin was installed but has since been deleted
+
+The signature of each plugin is one of:
+  trusted: the plugin has a signature from a key in COFIDECTL_TRUSTED_KEYS_DIR, or ~/.cofide/trusted-keys if not set
+  untrusted: the plugin has a signature that does not match any trusted key
+  unsigned: the plugin has no signature
+
+Plugins without a trusted signature will not be executed unless --allow-unsigned-plugins is given, or
+COFIDECTL_ALLOW_UNSIGNED_PLUGINS is set to true.
+`
+
+func (c *PluginCommand) getListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List installed plugins",
+		Long:  pluginListCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dirs, err := c.cmdCtx.PluginManager.GetPluginDirs()
+			if err != nil {
+				return err
+			}
+			plugins, err := listPlugins(dirs)
+			if err != nil {
+				return err
+			}
+			keys, err := loadTrustedKeys()
+			if err != nil {
+				return err
+			}
+			return renderPlugins(os.Stdout, plugins, keys)
+		},
+	}
+	return cmd
+}
+
+var pluginInstallCmdDesc = `
+This command will install a plugin from a local path into a plugin directory.
+
+SOURCE may be a plugin binary or a tarball (.tar, .tar.gz or .tgz) containing one.
+The SHA-256 checksum of the plugin is recorded in the plugin lockfile, and is verified each time
+the plugin is loaded. Installing a plugin that already exists replaces it.
+
+A detached ed25519 signature of the plugin, named after the plugin binary with a .sig suffix,
+is installed with it if present next to SOURCE or in the tarball.
+`
+
+type installOpts struct {
+	name string
+	dir  string
+}
+
+func (c *PluginCommand) getInstallCommand() *cobra.Command {
+	opts := installOpts{}
+	cmd := &cobra.Command{
+		Use:   "install SOURCE",
+		Short: "Install a plugin",
+		Long:  pluginInstallCmdDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pluginDir, err := getPluginDir(opts.dir)
+			if err != nil {
+				return err
+			}
+			installed, err := plugin.InstallPlugin(pluginDir, args[0], opts.name)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Installed plugin %s to %s with SHA-256 checksum %s\n", installed.Name, installed.Path, installed.Locked.SHA256)
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.dir, "dir", "", "Plugin directory to install into, defaulting to ~/.cofide/plugins")
+	f.StringVar(&opts.name, "name", "", "Name of the installed plugin binary, defaulting to the name of the source binary. For tarballs, the name of the binary to install from the tarball")
+
+	return cmd
+}
+
+var pluginRemoveCmdDesc = `
+This command will remove a plugin from a plugin directory, along with its signature and lockfile entry.
+`
+
+type removeOpts struct {
+	dir string
+}
+
+func (c *PluginCommand) getRemoveCommand() *cobra.Command {
+	opts := removeOpts{}
+	cmd := &cobra.Command{
+		Use:   "remove NAME",
+		Short: "Remove a plugin",
+		Long:  pluginRemoveCmdDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pluginDir, err := getPluginDir(opts.dir)
+			if err != nil {
+				return err
+			}
+			if err := plugin.RemovePlugin(pluginDir, args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("Removed plugin %s from %s\n", args[0], pluginDir)
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.dir, "dir", "", "Plugin directory to remove from, defaulting to ~/.cofide/plugins")
+
+	return cmd
+}
+
+var pluginInfoCmdDesc = `
+This command will show information about the plugin NAME found first in the plugin search path.
+
+gRPC plugins are launched to determine which cofidectl plugin types they provide. CLI plugins, whose
+names start with cofidectl-, are not launched, and the description from their manifest is shown.
+`
+
+func (c *PluginCommand) getInfoCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info NAME",
+		Short: "Show information about a plugin",
+		Long:  pluginInfoCmdDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resolved, err := c.cmdCtx.PluginManager.FindPlugin(args[0])
+			if err != nil {
+				return err
+			}
+			plugins, err := listPlugins([]plugin.PluginDir{resolved.Dir})
+			if err != nil {
+				return err
+			}
+			i := slices.IndexFunc(plugins, func(p *listedPlugin) bool { return p.Name == resolved.Name })
+			if i < 0 {
+				return fmt.Errorf("plugin %s is not installed", args[0])
+			}
+			installed := plugins[i]
+			if installed.Status == plugin.PluginStatusModified {
+				return fmt.Errorf("%w: %s", plugin.ErrPluginModified, installed.Path)
+			}
+			keys, err := loadTrustedKeys()
+			if err != nil {
+				return err
+			}
+
+			if strings.HasPrefix(installed.Name, plugin.CliPluginPrefix) {
+				manifest, err := plugin.ReadCliPluginManifest(installed.Path)
+				if err != nil {
+					return err
+				}
+				return renderCliPluginInfo(os.Stdout, installed, keys, manifest)
+			}
+
+			info, err := c.cmdCtx.PluginManager.InspectGRPCPlugin(cmd.Context(), installed.Path)
+			if err != nil {
+				return err
+			}
+			return renderPluginInfo(os.Stdout, installed, keys, info)
+		},
+	}
+	return cmd
+}
+
+var pluginConfigCmdDesc = `
+This command consists of multiple sub-commands to manage the configuration of cofidectl plugins.
+`
+
+func (c *PluginCommand) getConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config set [ARGS]",
+		Short: "Manage plugin configuration",
+		Long:  pluginConfigCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.getConfigSetCommand(),
+	)
+
+	return cmd
+}
+
+var pluginConfigSetCmdDesc = `
+This command will set one or more values in the plugin_config section of the config file for a
+plugin, which defaults to the data source plugin.
+
+KEY may be a dot-separated path to a nested value. VALUE is parsed as JSON if it is valid JSON,
+and is otherwise used as a string.
+
+The resulting configuration is validated against the configuration schema declared by the plugin,
+if it is the data source or provision plugin, before it is written.
+`
+
+type configSetOpts struct {
+	plugin string
+}
+
+func (c *PluginCommand) getConfigSetCommand() *cobra.Command {
+	opts := configSetOpts{}
+	cmd := &cobra.Command{
+		Use:   "set KEY=VALUE...",
+		Short: "Set plugin configuration values",
+		Long:  pluginConfigSetCmdDesc,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := c.cmdCtx.PluginManager.GetConfigLoader().Read()
+			if err != nil {
+				return err
+			}
+			pluginName := opts.plugin
+			if pluginName == "" {
+				pluginName = cfg.Plugins.GetDataSource()
+			}
+			if pluginName == "" {
+				return errors.New("no data source plugin is configured, specify a plugin using --plugin")
+			}
+
+			pluginConfig, ok := cfg.PluginConfig[pluginName]
+			if ok {
+				if pluginConfig, err = c.cmdCtx.PluginManager.GetPluginConfig(pluginName); err != nil {
+					return err
+				}
+			} else {
+				pluginConfig = &structpb.Struct{}
+			}
+			for _, arg := range args {
+				key, value, ok := strings.Cut(arg, "=")
+				if !ok || key == "" {
+					return fmt.Errorf("invalid argument %q, expected KEY=VALUE", arg)
+				}
+				if err := setPluginConfigValue(pluginConfig, key, value); err != nil {
+					return err
+				}
+			}
+
+			if err := c.cmdCtx.PluginManager.ValidatePluginConfig(cmd.Context(), pluginName, pluginConfig); err != nil {
+				return err
+			}
+			if err := c.cmdCtx.PluginManager.SetPluginConfig(pluginName, pluginConfig); err != nil {
+				return err
+			}
+			fmt.Printf("Updated configuration for plugin %s\n", pluginName)
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.plugin, "plugin", "", "Name of the plugin to configure (default: the data source plugin)")
+
+	return cmd
+}
+
+// setPluginConfigValue sets the value at a dot-separated key path in pluginConfig, creating any
+// missing intermediate structs. The value is parsed as JSON, or used as a string if it is not
+// valid JSON.
+func setPluginConfigValue(pluginConfig *structpb.Struct, key, value string) error {
+	var parsed any
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		parsed = value
+	}
+	v, err := structpb.NewValue(parsed)
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	path := strings.Split(key, ".")
+	current := pluginConfig
+	for i, field := range path[:len(path)-1] {
+		if field == "" {
+			return fmt.Errorf("invalid key %q", key)
+		}
+		if current.Fields == nil {
+			current.Fields = map[string]*structpb.Value{}
+		}
+		next, ok := current.Fields[field]
+		if !ok {
+			next = structpb.NewStructValue(&structpb.Struct{})
+			current.Fields[field] = next
+		}
+		nested := next.GetStructValue()
+		if nested == nil {
+			return fmt.Errorf("cannot set %s: %s is not an object", key, strings.Join(path[:i+1], "."))
+		}
+		current = nested
+	}
+
+	field := path[len(path)-1]
+	if field == "" {
+		return fmt.Errorf("invalid key %q", key)
+	}
+	if current.Fields == nil {
+		current.Fields = map[string]*structpb.Value{}
+	}
+	current.Fields[field] = v
+	return nil
+}
+
+// listedPlugin is a plugin in a directory of the plugin search path.
+type listedPlugin struct {
+	*plugin.InstalledPlugin
+	Dir plugin.PluginDir
+	// Shadowed is true if a plugin with the same name exists in an earlier directory.
+	Shadowed bool
+}
+
+// listPlugins returns the plugins in each of the plugin directories, in search path order.
+func listPlugins(dirs []plugin.PluginDir) ([]*listedPlugin, error) {
+	listed := []*listedPlugin{}
+	found := map[string]bool{}
+	for _, dir := range dirs {
+		plugins, err := plugin.ListPlugins(dir.Path)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range plugins {
+			listed = append(listed, &listedPlugin{InstalledPlugin: p, Dir: dir, Shadowed: found[p.Name]})
+			if p.Status != plugin.PluginStatusMissing {
+				found[p.Name] = true
+			}
+		}
+	}
+	return listed, nil
+}
+
+// getPluginDir returns dir if set, or the default plugin directory.
+func getPluginDir(dir string) (string, error) {
+	if dir != "" {
+		return dir, nil
+	}
+	return plugin.GetPluginDir()
+}
+
+// loadTrustedKeys returns the public keys trusted to sign plugins.
+func loadTrustedKeys() ([]ed25519.PublicKey, error) {
+	policy, err := plugin.NewSignaturePolicy(false)
+	if err != nil {
+		return nil, err
+	}
+	return plugin.LoadTrustedKeys(policy.TrustedKeysDir)
+}
+
+// signatureStatus describes the signature of an installed plugin.
+func signatureStatus(installed *plugin.InstalledPlugin, keys []ed25519.PublicKey) string {
+	if installed.Status == plugin.PluginStatusMissing {
+		return ""
+	}
+	err := plugin.VerifySignature(installed.Path, keys)
+	switch {
+	case err == nil:
+		return "trusted"
+	case errors.Is(err, plugin.ErrPluginUnsigned):
+		return "unsigned"
+	default:
+		return "untrusted"
+	}
+}
+
+func renderPlugins(w io.Writer, plugins []*listedPlugin, keys []ed25519.PublicKey) error {
+	data := make([][]string, 0, len(plugins))
+	for _, p := range plugins {
+		checksum, installedAt := "", ""
+		if p.Locked != nil {
+			checksum = p.Locked.SHA256
+			if len(checksum) > checksumLength {
+				checksum = checksum[:checksumLength]
+			}
+			installedAt = p.Locked.InstalledAt.Local().Format(time.DateTime)
+		}
+		location := fmt.Sprintf("%s (%s)", p.Dir.Path, p.Dir.Source)
+		if p.Shadowed {
+			location += ", shadowed"
+		}
+		data = append(data, []string{p.Name, location, p.Status, signatureStatus(p.InstalledPlugin, keys), checksum, installedAt})
+	}
+
+	tr := renderer.NewTableRenderer(w)
+	table := renderer.Table{
+		Header: []string{"Name", "Location", "Status", "Signature", "SHA-256", "Installed"},
+		Data:   data,
+	}
+	_, err := tr.RenderTables(table)
+	return err
+}
+
+// renderPluginInfo renders information about an installed gRPC plugin. info is the result of
+// inspecting the plugin.
+func renderPluginInfo(w io.Writer, installed *listedPlugin, keys []ed25519.PublicKey, info *manager.PluginInfo) error {
+	data := pluginInfoData(installed, keys)
+	data = append(data, []string{"Plugin Types", strings.Join(info.PluginTypes, ", ")})
+	for _, pluginType := range info.PluginTypes {
+		if capabilities, ok := info.Capabilities[pluginType]; ok {
+			data = append(data, []string{fmt.Sprintf("Capabilities (%s)", pluginType), formatCapabilities(capabilities)})
+		}
+	}
+	return renderPluginInfoTable(w, data)
+}
+
+// renderCliPluginInfo renders information about an installed CLI plugin. manifest is the
+// plugin's manifest, or nil if it has none.
+func renderCliPluginInfo(w io.Writer, installed *listedPlugin, keys []ed25519.PublicKey, manifest *plugin.CliPluginManifest) error {
+	data := pluginInfoData(installed, keys)
+	data = append(data, []string{"Plugin Types", "cli"})
+	if manifest != nil {
+		data = append(data, []string{"Description", manifest.Short})
+		if manifest.Long != "" {
+			data = append(data, []string{"Long Description", manifest.Long})
+		}
+	}
+	return renderPluginInfoTable(w, data)
+}
+
+// pluginInfoData returns the fields common to all types of installed plugin.
+func pluginInfoData(installed *listedPlugin, keys []ed25519.PublicKey) [][]string {
+	checksum, source := "", ""
+	if installed.Locked != nil {
+		checksum = installed.Locked.SHA256
+		source = installed.Locked.Source
+	}
+	return [][]string{
+		{"Name", installed.Name},
+		{"Path", installed.Path},
+		{"Resolved From", installed.Dir.Source},
+		{"Status", installed.Status},
+		{"Signature", signatureStatus(installed.InstalledPlugin, keys)},
+		{"SHA-256", checksum},
+		{"Source", source},
+	}
+}
+
+func renderPluginInfoTable(w io.Writer, data [][]string) error {
+	tr := renderer.NewTableRenderer(w)
+	table := renderer.Table{
+		Header: []string{"Field", "Value"},
+		Data:   data,
+	}
+	_, err := tr.RenderTables(table)
+	return err
+}
+
+// formatCapabilities returns a summary of a plugin's API versions and optional features.
+func formatCapabilities(capabilities *plugin.Capabilities) string {
+	features := "none"
+	if len(capabilities.Features) > 0 {
+		features = strings.Join(capabilities.Features, ", ")
+	}
+	return fmt.Sprintf("API versions: %s; features: %s", strings.Join(capabilities.APIVersions, ", "), features)
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_setPluginConfigValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial map[string]any
+		key     string
+		value   string
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name:  "string",
+			key:   "endpoint",
+			value: "https://example.com",
+			want:  map[string]any{"endpoint": "https://example.com"},
+		},
+		{
+			name:  "json",
+			key:   "ports",
+			value: "[80, 443]",
+			want:  map[string]any{"ports": []any{80.0, 443.0}},
+		},
+		{
+			name:    "nested",
+			initial: map[string]any{"server": map[string]any{"port": 80.0}},
+			key:     "server.tls.enabled",
+			value:   "true",
+			want:    map[string]any{"server": map[string]any{"port": 80.0, "tls": map[string]any{"enabled": true}}},
+		},
+		{
+			name:    "overwrite",
+			initial: map[string]any{"port": 80.0},
+			key:     "port",
+			value:   "443",
+			want:    map[string]any{"port": 443.0},
+		},
+		{
+			name:    "not an object",
+			initial: map[string]any{"server": "example.com"},
+			key:     "server.port",
+			value:   "443",
+			wantErr: "cannot set server.port: server is not an object",
+		},
+		{
+			name:    "empty field",
+			key:     "server..port",
+			value:   "443",
+			wantErr: `invalid key "server..port"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pluginConfig, err := structpb.NewStruct(tt.initial)
+			require.NoError(t, err)
+			err = setPluginConfigValue(pluginConfig, tt.key, tt.value)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, pluginConfig.AsMap())
+		})
+	}
+}
+
+func Test_renderCliPluginInfo(t *testing.T) {
+	pluginPath := filepath.Join(t.TempDir(), "cofidectl-foo")
+	require.NoError(t, os.WriteFile(pluginPath, []byte("#!/bin/sh\n"), 0o700))
+	installed := &listedPlugin{
+		InstalledPlugin: &plugin.InstalledPlugin{Name: "cofidectl-foo", Path: pluginPath, Status: plugin.PluginStatusUnlocked},
+		Dir:             plugin.PluginDir{Path: filepath.Dir(pluginPath), Source: plugin.PluginDirSourceDefault},
+	}
+	manifest := &plugin.CliPluginManifest{Short: "Foo things", Long: "Foo does things"}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderCliPluginInfo(&buf, installed, nil, manifest))
+	output := buf.String()
+	assert.Regexp(t, `Plugin Types +\| cli `, output)
+	assert.Contains(t, output, "Foo things")
+	assert.Contains(t, output, "Foo does things")
+	assert.Contains(t, output, "unsigned")
+}
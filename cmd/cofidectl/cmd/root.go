@@ -11,13 +11,23 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/agent"
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/apbinding"
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/attestationpolicy"
+	auditcmd "github.com/cofide/cofidectl/cmd/cofidectl/cmd/audit"
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/cluster"
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/config"
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/entry"
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/export"
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/federation"
+	plugincmd "github.com/cofide/cofidectl/cmd/cofidectl/cmd/plugin"
+	svidcmd "github.com/cofide/cofidectl/cmd/cofidectl/cmd/svid"
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/trustzone"
+	verifycmd "github.com/cofide/cofidectl/cmd/cofidectl/cmd/verify"
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/workload"
+	"github.com/cofide/cofidectl/internal/pkg/audit"
 	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin"
 
 	"github.com/spf13/cobra"
 )
@@ -42,7 +52,11 @@ var rootCmdDesc = `cofidectl - Workload identity for hybrid and multi-cloud secu
 
 func (r *RootCommand) GetRootCommand() (*cobra.Command, error) {
 	var logLevel string
+	var logFile string
+	var logFormat string
 	var configFile string
+	var auditLogFile string
+	var allowUnsignedPlugins bool
 
 	cmd := &cobra.Command{
 		Use:          "cofidectl",
@@ -51,7 +65,23 @@ func (r *RootCommand) GetRootCommand() (*cobra.Command, error) {
 		SilenceUsage: true,
 		// This runs before any subcommand.
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			r.cmdCtx.UpdateConfigFile(configFile)
+			if err := r.cmdCtx.SetLogOutput(logFile, logFormat); err != nil {
+				return err
+			}
+
+			if err := r.cmdCtx.UpdateConfigFile(configFile, kubeCfgFile); err != nil {
+				return err
+			}
+
+			policy, err := plugin.NewSignaturePolicy(allowUnsignedPlugins)
+			if err != nil {
+				return err
+			}
+			r.cmdCtx.PluginManager.SetSignaturePolicy(policy)
+
+			if auditLogFile != "" {
+				r.cmdCtx.PluginManager.SetAuditLog(audit.NewLog(auditLogFile, os.Args))
+			}
 
 			slogLevel, err := slogLevelFromString(logLevel)
 			if err != nil {
@@ -70,9 +100,13 @@ func (r *RootCommand) GetRootCommand() (*cobra.Command, error) {
 	}
 
 	pf := cmd.PersistentFlags()
-	pf.StringVar(&configFile, "config", "cofide.yaml", "cofidectl config file")
+	pf.StringVar(&configFile, "config", "cofide.yaml", "cofidectl config file, git:PATH to record changes in a Git repository, or k8s://context/namespace/name[?kind=configmap|secret] to use a Kubernetes ConfigMap or Secret")
 	pf.StringVar(&kubeCfgFile, "kube-config", path.Join(home, ".kube/config"), "kubeconfig file location")
 	pf.StringVar(&logLevel, "log-level", "ERROR", "log level")
+	pf.StringVar(&logFile, "log-file", "", "file to append logs from cofidectl and plugins to, or an empty string to write logs to stderr")
+	pf.StringVar(&logFormat, "log-format", cmdcontext.LogFormatText, "log format, one of text or json")
+	pf.BoolVar(&allowUnsignedPlugins, "allow-unsigned-plugins", plugin.AllowUnsignedFromEnv(), "allow plugins without a signature from a trusted key to be executed, also enabled by setting "+plugin.AllowUnsignedPluginsEnvVar+"=true")
+	pf.StringVar(&auditLogFile, "audit-log", path.Join(home, ".cofide/audit.log"), "audit log file location, or an empty string to disable auditing")
 
 	versionCmd := NewVersionCommand(r.name, r.version, r.cmdCtx)
 	initCmd := NewInitCommand(r.cmdCtx)
@@ -84,6 +118,14 @@ func (r *RootCommand) GetRootCommand() (*cobra.Command, error) {
 	fedCmd := federation.NewFederationCommand(r.cmdCtx)
 	wlCmd := workload.NewWorkloadCommand(r.cmdCtx)
 	clusterCmd := cluster.NewClusterCommand(r.cmdCtx)
+	configCmd := config.NewConfigCommand(r.cmdCtx)
+	auditCmd := auditcmd.NewAuditCommand(r.cmdCtx)
+	pluginCmd := plugincmd.NewPluginCommand(r.cmdCtx)
+	exportCmd := export.NewExportCommand(r.cmdCtx)
+	agentCmd := agent.NewAgentCommand(r.cmdCtx)
+	entryCmd := entry.NewEntryCommand(r.cmdCtx)
+	verifyCmd := verifycmd.NewVerifyCommand(r.cmdCtx)
+	svidCmd := svidcmd.NewSVIDCommand(r.cmdCtx)
 
 	cmd.AddCommand(
 		versionCmd.VersionCmd(),
@@ -96,7 +138,16 @@ func (r *RootCommand) GetRootCommand() (*cobra.Command, error) {
 		upCmd.UpCmd(),
 		downCmd.DownCmd(),
 		clusterCmd.GetRootCommand(),
+		configCmd.GetRootCommand(),
+		auditCmd.GetRootCommand(),
+		pluginCmd.GetRootCommand(),
+		exportCmd.GetRootCommand(),
+		agentCmd.GetRootCommand(),
+		entryCmd.GetRootCommand(),
+		verifyCmd.GetRootCommand(),
+		svidCmd.GetRootCommand(),
 	)
+	addCliPluginCommands(cmd, r.cmdCtx, os.Args[1:])
 
 	return cmd, nil
 }
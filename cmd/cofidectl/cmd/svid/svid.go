@@ -0,0 +1,241 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package svid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	"github.com/cofide/cofidectl/internal/pkg/svid"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// ErrInvalidSVID is returned when an SVID fails verification against the trust bundles.
+var ErrInvalidSVID = errors.New("SVID verification failed")
+
+type SVIDCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewSVIDCommand(cmdCtx *cmdcontext.CommandContext) *SVIDCommand {
+	return &SVIDCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var svidRootCmdDesc = `
+This command consists of multiple sub-commands to work with SPIFFE Verifiable Identity Documents
+(SVIDs).
+`
+
+func (c *SVIDCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "svid inspect [ARGS]",
+		Short: "Inspect SVIDs",
+		Long:  svidRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.GetInspectCommand(),
+	)
+
+	return cmd
+}
+
+var svidInspectCmdDesc = `
+This command will decode an X.509-SVID or JWT-SVID and verify it against the trust bundles stored
+in the Cofide configuration, without contacting a SPIRE server.
+
+FILE may contain a PEM-encoded X.509-SVID certificate chain, with the leaf certificate first, or a
+JWT-SVID token. Use - to read from stdin.
+
+By default the SVID is verified against the bundles of all trust zones. If --trust-zone is
+specified, only the bundles of that trust zone and the trust zones it federates with are used.
+Trust bundles are stored in the configuration when a trust zone is brought up. For JWT-SVIDs,
+--audience may be specified to require that the token contains one of the given audiences.
+
+The command exits with an error if verification fails.
+`
+
+type inspectOpts struct {
+	trustZone string
+	audience  []string
+	output    string
+}
+
+func (c *SVIDCommand) GetInspectCommand() *cobra.Command {
+	opts := inspectOpts{}
+	cmd := &cobra.Command{
+		Use:   "inspect FILE",
+		Short: "Decode an SVID and verify it against the stored trust bundles",
+		Long:  svidInspectCmdDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.output != outputText && opts.output != outputJSON {
+				return fmt.Errorf("unsupported output format %q, expected one of %s, %s", opts.output, outputText, outputJSON)
+			}
+
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+			if err != nil {
+				return err
+			}
+
+			data, err := readInput(args[0])
+			if err != nil {
+				return err
+			}
+
+			bundles, err := trustzone.GetTrustBundles(datasource.ToV1(ctx, ds), opts.trustZone)
+			if err != nil {
+				return err
+			}
+
+			inspection, err := svid.Inspect(data, bundles, opts.audience)
+			if err != nil {
+				return err
+			}
+
+			if err := renderInspection(os.Stdout, inspection, opts.output); err != nil {
+				return err
+			}
+			if !inspection.Valid() {
+				return ErrInvalidSVID
+			}
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone whose bundles, and those of its federated trust zones, are used for verification")
+	f.StringSliceVar(&opts.audience, "audience", nil, "Audiences of which a JWT-SVID must contain at least one")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text or json")
+
+	return cmd
+}
+
+func readInput(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+type inspectionJSON struct {
+	Type            string   `json:"type"`
+	SPIFFEID        string   `json:"spiffe_id"`
+	TrustDomain     string   `json:"trust_domain"`
+	NotBefore       string   `json:"not_before,omitempty"`
+	NotAfter        string   `json:"not_after,omitempty"`
+	SerialNumber    string   `json:"serial_number,omitempty"`
+	KeyUsage        []string `json:"key_usage,omitempty"`
+	ExtKeyUsage     []string `json:"ext_key_usage,omitempty"`
+	DNSNames        []string `json:"dns_names,omitempty"`
+	ChainLength     int      `json:"chain_length,omitempty"`
+	Algorithm       string   `json:"algorithm,omitempty"`
+	KeyID           string   `json:"key_id,omitempty"`
+	Audience        []string `json:"audience,omitempty"`
+	AudienceChecked bool     `json:"audience_checked,omitempty"`
+	Valid           bool     `json:"valid"`
+	Error           string   `json:"error,omitempty"`
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func newInspectionJSON(inspection *svid.Inspection) inspectionJSON {
+	result := inspectionJSON{
+		Type:            inspection.Type,
+		SPIFFEID:        inspection.SPIFFEID,
+		TrustDomain:     inspection.TrustDomain,
+		NotBefore:       formatTime(inspection.NotBefore),
+		NotAfter:        formatTime(inspection.NotAfter),
+		SerialNumber:    inspection.SerialNumber,
+		KeyUsage:        inspection.KeyUsage,
+		ExtKeyUsage:     inspection.ExtKeyUsage,
+		DNSNames:        inspection.DNSNames,
+		ChainLength:     inspection.ChainLength,
+		Algorithm:       inspection.Algorithm,
+		KeyID:           inspection.KeyID,
+		Audience:        inspection.Audience,
+		AudienceChecked: inspection.AudienceChecked,
+		Valid:           inspection.Valid(),
+	}
+	if inspection.VerifyErr != nil {
+		result.Error = inspection.VerifyErr.Error()
+	}
+	return result
+}
+
+// renderInspection writes the decoded SVID and its verification result to the writer in the
+// specified format.
+func renderInspection(w io.Writer, inspection *svid.Inspection, output string) error {
+	if output == outputJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(newInspectionJSON(inspection))
+	}
+
+	data := [][]string{
+		{"Type", inspection.Type},
+		{"SPIFFE ID", inspection.SPIFFEID},
+		{"Trust Domain", inspection.TrustDomain},
+	}
+	switch inspection.Type {
+	case svid.TypeX509:
+		data = append(data,
+			[]string{"Not Before", formatTime(inspection.NotBefore)},
+			[]string{"Not After", formatTime(inspection.NotAfter)},
+			[]string{"Serial", inspection.SerialNumber},
+			[]string{"Key Usage", strings.Join(inspection.KeyUsage, "\n")},
+			[]string{"Extended Key Usage", strings.Join(inspection.ExtKeyUsage, "\n")},
+			[]string{"DNS Names", strings.Join(inspection.DNSNames, "\n")},
+			[]string{"Chain Length", strconv.Itoa(inspection.ChainLength)},
+		)
+	case svid.TypeJWT:
+		audience := strings.Join(inspection.Audience, "\n")
+		if !inspection.AudienceChecked {
+			audience += "\n(not checked)"
+		}
+		data = append(data,
+			[]string{"Issued At", formatTime(inspection.NotBefore)},
+			[]string{"Expires At", formatTime(inspection.NotAfter)},
+			[]string{"Algorithm", inspection.Algorithm},
+			[]string{"Key ID", inspection.KeyID},
+			[]string{"Audience", strings.TrimPrefix(audience, "\n")},
+		)
+	}
+
+	verification := "valid"
+	if !inspection.Valid() {
+		verification = fmt.Sprintf("invalid: %s", inspection.VerifyErr)
+	}
+	data = append(data, []string{"Verification", verification})
+
+	_, err := renderer.NewTableRenderer(w).RenderTables(renderer.Table{
+		Header: []string{"Field", "Value"},
+		Data:   data,
+	})
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package svid
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cofide/cofidectl/internal/pkg/svid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderInspection(t *testing.T) {
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	x509Inspection := &svid.Inspection{
+		Type:         svid.TypeX509,
+		SPIFFEID:     "spiffe://td1/ns/ns1/sa/sa1",
+		TrustDomain:  "td1",
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(time.Hour),
+		SerialNumber: "1234",
+		KeyUsage:     []string{"digitalSignature"},
+		ExtKeyUsage:  []string{"serverAuth", "clientAuth"},
+		ChainLength:  1,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderInspection(&buf, x509Inspection, outputJSON))
+	assert.Equal(t, `{
+  "type": "x509",
+  "spiffe_id": "spiffe://td1/ns/ns1/sa/sa1",
+  "trust_domain": "td1",
+  "not_before": "2026-01-01T00:00:00Z",
+  "not_after": "2026-01-01T01:00:00Z",
+  "serial_number": "1234",
+  "key_usage": [
+    "digitalSignature"
+  ],
+  "ext_key_usage": [
+    "serverAuth",
+    "clientAuth"
+  ],
+  "chain_length": 1,
+  "valid": true
+}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, renderInspection(&buf, x509Inspection, outputText))
+	assert.Contains(t, buf.String(), "spiffe://td1/ns/ns1/sa/sa1")
+	assert.Contains(t, buf.String(), "2026-01-01T01:00:00Z")
+	assert.Contains(t, buf.String(), "clientAuth")
+
+	jwtInspection := &svid.Inspection{
+		Type:        svid.TypeJWT,
+		SPIFFEID:    "spiffe://td1/ns/ns1/sa/sa1",
+		TrustDomain: "td1",
+		NotAfter:    notBefore.Add(time.Hour),
+		Algorithm:   "ES256",
+		KeyID:       "key1",
+		Audience:    []string{"aud1"},
+		VerifyErr:   errors.New("jwtsvid: token has expired"),
+	}
+
+	buf.Reset()
+	require.NoError(t, renderInspection(&buf, jwtInspection, outputJSON))
+	assert.Equal(t, `{
+  "type": "jwt",
+  "spiffe_id": "spiffe://td1/ns/ns1/sa/sa1",
+  "trust_domain": "td1",
+  "not_after": "2026-01-01T01:00:00Z",
+  "algorithm": "ES256",
+  "key_id": "key1",
+  "audience": [
+    "aud1"
+  ],
+  "valid": false,
+  "error": "jwtsvid: token has expired"
+}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, renderInspection(&buf, jwtInspection, outputText))
+	assert.Contains(t, buf.String(), "(not checked)")
+	assert.Contains(t, buf.String(), "invalid: jwtsvid: token has expired")
+}
@@ -69,7 +69,8 @@ func (c *HelmCommand) GetOverrideCommand() *cobra.Command {
 		Long:  helmOverrideCmdDesc,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
@@ -92,7 +93,7 @@ func (c *HelmCommand) GetOverrideCommand() *cobra.Command {
 				return err
 			}
 
-			return c.overrideValues(cmd.Context(), ds, args[0], opts.clusterName, values)
+			return c.overrideValues(ctx, ds, args[0], opts.clusterName, values)
 		},
 	}
 
@@ -104,18 +105,18 @@ func (c *HelmCommand) GetOverrideCommand() *cobra.Command {
 }
 
 // overrideValues overrides Helm values for a trust zone.
-func (c *HelmCommand) overrideValues(ctx context.Context, ds datasource.DataSource, tzName, clusterName string, values map[string]any) error {
+func (c *HelmCommand) overrideValues(ctx context.Context, ds datasource.DataSourceV2, tzName, clusterName string, values map[string]any) error {
 	provisionPlugin, err := c.cmdCtx.PluginManager.GetProvision(ctx)
 	if err != nil {
 		return err
 	}
 
-	trustZone, err := ds.GetTrustZoneByName(tzName)
+	trustZone, err := ds.GetTrustZoneByName(ctx, tzName)
 	if err != nil {
 		return err
 	}
 
-	cluster, err := trustzone.ResolveCluster(trustZone, clusterName, ds)
+	cluster, err := trustzone.ResolveCluster(trustZone, clusterName, datasource.ToV1(ctx, ds))
 	if err != nil {
 		return err
 	}
@@ -128,19 +129,19 @@ func (c *HelmCommand) overrideValues(ctx context.Context, ds datasource.DataSour
 		return err
 	}
 
-	_, err = ds.UpdateCluster(cluster)
+	_, err = ds.UpdateCluster(ctx, cluster)
 	if err != nil {
 		return err
 	}
 
 	// Check that the values are acceptable.
-	_, err = provisionPlugin.GetHelmValues(ctx, ds, &provision.GetHelmValuesOpts{
+	_, err = provisionPlugin.GetHelmValues(ctx, datasource.ToV1(ctx, ds), &provision.GetHelmValuesOpts{
 		ClusterID: cluster.GetId(),
 	})
 	if err != nil {
 		slog.Error("Failed to generate Helm values, rolling back", "error", err)
 		// Rollback the cluster to the old state.
-		_, rollbackErr := ds.UpdateCluster(oldCluster)
+		_, rollbackErr := ds.UpdateCluster(ctx, oldCluster)
 		if rollbackErr != nil {
 			return fmt.Errorf("failed to rollback cluster: %w", rollbackErr)
 		}
@@ -174,12 +175,13 @@ func (c *HelmCommand) GetValuesCommand() *cobra.Command {
 		Long:  helmValuesCmdDesc,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			values, err := c.getValues(cmd.Context(), ds, args[0], opts.clusterName)
+			values, err := c.getValues(ctx, ds, args[0], opts.clusterName)
 			if err != nil {
 				return err
 			}
@@ -222,13 +224,13 @@ func (c *HelmCommand) GetValuesCommand() *cobra.Command {
 }
 
 // getValues returns the Helm values for a trust zone.
-func (c *HelmCommand) getValues(ctx context.Context, ds datasource.DataSource, tzName, clusterName string) (map[string]any, error) {
-	trustZone, err := ds.GetTrustZoneByName(tzName)
+func (c *HelmCommand) getValues(ctx context.Context, ds datasource.DataSourceV2, tzName, clusterName string) (map[string]any, error) {
+	trustZone, err := ds.GetTrustZoneByName(ctx, tzName)
 	if err != nil {
 		return nil, err
 	}
 
-	cluster, err := trustzone.ResolveCluster(trustZone, clusterName, ds)
+	cluster, err := trustzone.ResolveCluster(trustZone, clusterName, datasource.ToV1(ctx, ds))
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +240,7 @@ func (c *HelmCommand) getValues(ctx context.Context, ds datasource.DataSource, t
 		return nil, err
 	}
 
-	values, err := provisionPlugin.GetHelmValues(ctx, ds, &provision.GetHelmValuesOpts{
+	values, err := provisionPlugin.GetHelmValues(ctx, datasource.ToV1(ctx, ds), &provision.GetHelmValuesOpts{
 		ClusterID: cluster.GetId(),
 	})
 	if err != nil {
@@ -7,7 +7,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
 	"os"
 	"strconv"
 	"strings"
@@ -19,10 +18,9 @@ import (
 
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
-	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/plugin"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
-	helmprovider "github.com/cofide/cofidectl/pkg/provider/helm"
-	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/spf13/cobra"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 )
@@ -73,19 +71,20 @@ func (c *TrustZoneCommand) GetListCommand() *cobra.Command {
 		Long:  trustZoneListCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			trustZones, err := ds.ListTrustZones()
+			trustZones, err := ds.ListTrustZones(ctx)
 			if err != nil {
 				return err
 			}
 
 			data := make([][]string, 0, len(trustZones))
 			for _, trustZone := range trustZones {
-				clusters, err := trustzone.GetClustersByTrustZone(trustZone, ds)
+				clusters, err := trustzone.GetClustersByTrustZone(trustZone, datasource.ToV1(ctx, ds))
 				clusterNames := "N/A"
 				if err == nil {
 					names := make([]string, 0, len(clusters))
@@ -138,11 +137,12 @@ func (c *TrustZoneCommand) GetAddCommand() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
-			return c.addTrustZone(cmd.Context(), opts, ds)
+			return c.addTrustZone(ctx, opts, ds)
 		},
 	}
 
@@ -156,7 +156,7 @@ func (c *TrustZoneCommand) GetAddCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *TrustZoneCommand) addTrustZone(ctx context.Context, opts addOpts, ds datasource.DataSource) error {
+func (c *TrustZoneCommand) addTrustZone(ctx context.Context, opts addOpts, ds datasource.DataSourceV2) error {
 	bundleEndpointProfile := trust_zone_proto.BundleEndpointProfile_BUNDLE_ENDPOINT_PROFILE_HTTPS_SPIFFE
 
 	newTrustZone := &trust_zone_proto.TrustZone{
@@ -166,7 +166,7 @@ func (c *TrustZoneCommand) addTrustZone(ctx context.Context, opts addOpts, ds da
 		BundleEndpointProfile: &bundleEndpointProfile,
 	}
 
-	_, err := ds.AddTrustZone(newTrustZone)
+	_, err := ds.AddTrustZone(ctx, newTrustZone)
 	if err != nil {
 		return fmt.Errorf("failed to create trust zone %s: %w", newTrustZone.GetName(), err)
 	}
@@ -190,7 +190,8 @@ func (c *TrustZoneCommand) GetDelCommand() *cobra.Command {
 		Long:  trustZoneDelCmdDesc,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
@@ -200,7 +201,17 @@ func (c *TrustZoneCommand) GetDelCommand() *cobra.Command {
 				return err
 			}
 
-			return deleteTrustZone(cmd.Context(), args[0], ds, kubeConfig, opts.force)
+			var reporter provision.StatusReporter
+			if !opts.force {
+				reporter, err = c.cmdCtx.PluginManager.GetStatusReporter(ctx)
+				if errors.Is(err, plugin.ErrNotSupported) {
+					return fmt.Errorf("%w: use --force to skip pre-delete checks", err)
+				} else if err != nil {
+					return err
+				}
+			}
+
+			return deleteTrustZone(ctx, args[0], ds, reporter, kubeConfig, opts.force)
 		},
 	}
 
@@ -210,25 +221,27 @@ func (c *TrustZoneCommand) GetDelCommand() *cobra.Command {
 	return cmd
 }
 
-func deleteTrustZone(ctx context.Context, name string, ds datasource.DataSource, kubeConfig string, force bool) error {
-	tz, err := ds.GetTrustZoneByName(name)
+// deleteTrustZone deletes a trust zone and its clusters. Unless force is true, reporter is used to
+// check that none of the clusters are deployed.
+func deleteTrustZone(ctx context.Context, name string, ds datasource.DataSourceV2, reporter provision.StatusReporter, kubeConfig string, force bool) error {
+	tz, err := ds.GetTrustZoneByName(ctx, name)
 	if err != nil {
 		return err
 	}
 	id := tz.GetId()
 
-	clusters, err := ds.ListClusters(&datasourcepb.ListClustersRequest_Filter{
+	clusters, err := ds.ListClusters(ctx, &datasourcepb.ListClustersRequest_Filter{
 		TrustZoneId: &id,
 	})
 	if err != nil {
 		return err
 	}
 
-	// TODO: Add IsClusterDeployed to ProvisionPlugin interface and mock in tests.
 	if !force {
-		// Fail if any clusters in the trust zone are reachable and SPIRE is deployed.
+		// Fail if any clusters in the trust zone are deployed.
 		for _, cluster := range clusters {
-			if deployed, err := helmprovider.IsClusterDeployed(ctx, cluster, kubeConfig); err != nil {
+			opts := &provision.IsDeployedOpts{KubeCfgFile: kubeConfig, ClusterID: cluster.GetId()}
+			if deployed, err := reporter.IsDeployed(ctx, datasource.ToV1(ctx, ds), opts); err != nil {
 				return err
 			} else if deployed {
 				return fmt.Errorf("cluster %s in trust zone %s cannot be deleted while it is up", cluster.GetName(), name)
@@ -236,24 +249,20 @@ func deleteTrustZone(ctx context.Context, name string, ds datasource.DataSource,
 		}
 	}
 
-	for i, cluster := range clusters {
-		err = ds.DestroyCluster(cluster.GetId())
-		if err != nil {
-			for _, rollbackCluster := range clusters[:i] {
-				if _, err := ds.AddCluster(rollbackCluster); err != nil {
-					slog.Error("Failed recreating cluster during rollback", "error", err)
-				}
+	// Destroy the clusters and trust zone in a transaction, so that a failure does not leave the
+	// trust zone partially deleted.
+	return datasource.WithTransaction(ctx, ds, func(ds datasource.DataSourceV2) error {
+		for _, cluster := range clusters {
+			if err := ds.DestroyCluster(ctx, cluster.GetId()); err != nil {
+				return fmt.Errorf("failed to destroy cluster %s: %w", cluster.GetName(), err)
 			}
-			return fmt.Errorf("failed to destroy cluster %s: %w", cluster.GetName(), err)
 		}
-	}
-
-	err = ds.DestroyTrustZone(id)
-	if err != nil {
-		return fmt.Errorf("failed to destroy trust zone %s: %w", name, err)
-	}
 
-	return nil
+		if err := ds.DestroyTrustZone(ctx, id); err != nil {
+			return fmt.Errorf("failed to destroy trust zone %s: %w", name, err)
+		}
+		return nil
+	})
 }
 
 var trustZoneStatusCmdDesc = `
@@ -274,7 +283,13 @@ func (c *TrustZoneCommand) GetStatusCommand() *cobra.Command {
 		Long:  trustZoneStatusCmdDesc,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := c.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+			if err != nil {
+				return err
+			}
+
+			reporter, err := c.cmdCtx.PluginManager.GetStatusReporter(ctx)
 			if err != nil {
 				return err
 			}
@@ -283,7 +298,7 @@ func (c *TrustZoneCommand) GetStatusCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to retrieve the kubeconfig file location")
 			}
-			return c.status(cmd.Context(), ds, kubeConfig, args[0], opts.clusterName)
+			return c.status(ctx, ds, reporter, kubeConfig, args[0], opts.clusterName)
 		},
 	}
 
@@ -293,48 +308,30 @@ func (c *TrustZoneCommand) GetStatusCommand() *cobra.Command {
 	return cmd
 }
 
-func (c *TrustZoneCommand) status(ctx context.Context, source datasource.DataSource, kubeConfig, tzName, clusterName string) error {
-	trustZone, err := source.GetTrustZoneByName(tzName)
-	if err != nil {
-		return err
-	}
-
-	cluster, err := trustzone.ResolveCluster(trustZone, clusterName, source)
+func (c *TrustZoneCommand) status(ctx context.Context, source datasource.DataSourceV2, reporter provision.StatusReporter, kubeConfig, tzName, clusterName string) error {
+	trustZone, err := source.GetTrustZoneByName(ctx, tzName)
 	if err != nil {
 		return err
 	}
 
-	client, err := kubeutil.NewKubeClientFromSpecifiedContext(kubeConfig, cluster.GetKubernetesContext())
+	cluster, err := trustzone.ResolveCluster(trustZone, clusterName, datasource.ToV1(ctx, source))
 	if err != nil {
 		return err
 	}
 
-	prov, err := helmprovider.NewHelmSPIREProvider(ctx, trustZone.GetName(), cluster, nil, nil, helmprovider.WithKubeConfig(kubeConfig))
+	clusterStatus, err := reporter.GetStatus(ctx, datasource.ToV1(ctx, source), &provision.GetStatusOpts{KubeCfgFile: kubeConfig, ClusterID: cluster.GetId()})
 	if err != nil {
 		return err
 	}
-
-	if installed, err := prov.CheckIfAlreadyInstalled(); err != nil {
-		return err
-	} else if !installed {
+	if !clusterStatus.Deployed {
 		//nolint:staticcheck // ST1005: error strings should not be capitalized
 		return errors.New("Cofide configuration has not been installed. Have you run cofidectl up?")
 	}
 
-	server, err := spire.GetServerStatus(ctx, client)
-	if err != nil {
-		return err
-	}
-
-	agents, err := spire.GetAgentStatus(ctx, client)
-	if err != nil {
-		return err
-	}
-
-	return renderStatus(trustZone, server, agents)
+	return renderStatus(trustZone, clusterStatus.Server, clusterStatus.Agents)
 }
 
-func renderStatus(trustZone *trust_zone_proto.TrustZone, server *spire.ServerStatus, agents *spire.AgentStatus) error {
+func renderStatus(trustZone *trust_zone_proto.TrustZone, server *provision.ServerStatus, agents *provision.AgentStatus) error {
 	trustZoneData := [][]string{
 		{
 			"Trust Zone",
@@ -357,15 +354,15 @@ func renderStatus(trustZone *trust_zone_proto.TrustZone, server *spire.ServerSta
 	serverData := make([][]string, 0)
 	for _, container := range server.Containers {
 		serverData = append(serverData, []string{
-			container.Name,
+			container.Pod,
 			strconv.FormatBool(container.Ready),
 		})
 	}
 
 	scmData := make([][]string, 0)
-	for _, scm := range server.SCMs {
+	for _, scm := range server.ControllerManagers {
 		scmData = append(scmData, []string{
-			scm.Name,
+			scm.Pod,
 			strconv.FormatBool(scm.Ready),
 		})
 	}
@@ -373,7 +370,7 @@ func renderStatus(trustZone *trust_zone_proto.TrustZone, server *spire.ServerSta
 	agentData := make([][]string, 0)
 	for _, agent := range agents.Agents {
 		agentData = append(agentData, []string{
-			agent.Name,
+			agent.Pod,
 			agent.Status,
 			agent.AttestationType,
 			agent.ExpirationTime.String(),
@@ -384,8 +381,8 @@ func renderStatus(trustZone *trust_zone_proto.TrustZone, server *spire.ServerSta
 	agentIdData := make([][]string, 0)
 	for _, agent := range agents.Agents {
 		agentIdData = append(agentIdData, []string{
-			agent.Name,
-			agent.Id,
+			agent.Pod,
+			agent.ID,
 		})
 	}
 
@@ -6,13 +6,16 @@ package trustzone
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
 	"github.com/cofide/cofidectl/internal/pkg/config"
 	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -81,7 +84,7 @@ func TestTrustZoneCommand_addTrustZone(t *testing.T) {
 			}
 
 			c := TrustZoneCommand{}
-			err := c.addTrustZone(context.Background(), opts, ds)
+			err := c.addTrustZone(context.Background(), opts, datasource.FromV1(ds))
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErrMessage)
@@ -125,7 +128,7 @@ func TestTrustZoneCommand_deleteTrustZone(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ds := newFakeDataSource(t, defaultConfig())
-			err := deleteTrustZone(context.Background(), tt.trustZoneName, ds, "", true)
+			err := deleteTrustZone(context.Background(), tt.trustZoneName, datasource.FromV1(ds), nil, "", true)
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErrMessage)
@@ -148,6 +151,69 @@ func TestTrustZoneCommand_deleteTrustZone(t *testing.T) {
 	}
 }
 
+func TestTrustZoneCommand_deleteTrustZone_rollback(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Clusters = []*clusterpb.Cluster{fixtures.Cluster("local1")}
+	ds := &failingDestroyDS{LocalDataSource: newFakeDataSource(t, cfg).(*local.LocalDataSource)}
+
+	err := deleteTrustZone(context.Background(), "tz1", datasource.FromV1(ds), nil, "", true)
+	require.EqualError(t, err, "failed to destroy trust zone tz1: fake destroy failure")
+
+	// Check that the cluster deletion was rolled back.
+	_, err = ds.GetCluster("local1-id")
+	require.NoError(t, err)
+	_, err = ds.GetTrustZone("tz1-id")
+	require.NoError(t, err)
+}
+
+func TestTrustZoneCommand_deleteTrustZone_deployed(t *testing.T) {
+	tests := []struct {
+		name     string
+		deployed []string
+		wantErr  string
+	}{
+		{
+			name: "not deployed",
+		},
+		{
+			name:     "deployed",
+			deployed: []string{"local1-id"},
+			wantErr:  "cluster local1 in trust zone tz1 cannot be deleted while it is up",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaultConfig()
+			cfg.Clusters = []*clusterpb.Cluster{fixtures.Cluster("local1")}
+			ds := newFakeDataSource(t, cfg)
+			reporter := &fakeStatusReporter{deployed: tt.deployed}
+			err := deleteTrustZone(context.Background(), "tz1", datasource.FromV1(ds), reporter, "kube.cfg", false)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				_, err := ds.GetTrustZone("tz1-id")
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				_, err := ds.GetTrustZone("tz1-id")
+				require.ErrorIs(t, err, datasource.ErrNotFound)
+			}
+		})
+	}
+}
+
+// fakeStatusReporter reports the clusters with the specified IDs as deployed.
+type fakeStatusReporter struct {
+	deployed []string
+}
+
+func (f *fakeStatusReporter) IsDeployed(_ context.Context, _ datasource.DataSource, opts *provision.IsDeployedOpts) (bool, error) {
+	return slices.Contains(f.deployed, opts.ClusterID), nil
+}
+
+func (f *fakeStatusReporter) GetStatus(_ context.Context, _ datasource.DataSource, opts *provision.GetStatusOpts) (*provision.ClusterStatus, error) {
+	return &provision.ClusterStatus{Deployed: slices.Contains(f.deployed, opts.ClusterID)}, nil
+}
+
 type failingDS struct {
 	*local.LocalDataSource
 }
@@ -157,6 +223,27 @@ func (f *failingDS) AddTrustZone(trustZone *trust_zone_proto.TrustZone) (*trust_
 	return nil, errors.New("fake add failure")
 }
 
+// failingDestroyDS begins transactions in which DestroyTrustZone fails unconditionally.
+type failingDestroyDS struct {
+	*local.LocalDataSource
+}
+
+func (f *failingDestroyDS) Begin(ctx context.Context) (datasource.Transaction, error) {
+	tx, err := f.LocalDataSource.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &failingDestroyTx{Transaction: tx}, nil
+}
+
+type failingDestroyTx struct {
+	datasource.Transaction
+}
+
+func (f *failingDestroyTx) DestroyTrustZone(id string) error {
+	return errors.New("fake destroy failure")
+}
+
 func newFakeDataSource(t *testing.T, cfg *config.Config) datasource.DataSource {
 	configLoader, err := config.NewMemoryLoader(cfg)
 	require.Nil(t, err)
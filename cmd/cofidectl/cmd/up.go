@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/statusspinner"
+	"github.com/cofide/cofidectl/internal/pkg/audit"
 	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 	provisionplugin "github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/spf13/cobra"
 )
@@ -40,17 +42,18 @@ func (u *UpCommand) UpCmd() *cobra.Command {
 		Long:  upCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := u.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := u.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
-			provision, err := u.cmdCtx.PluginManager.GetProvision(cmd.Context())
+			provision, err := u.cmdCtx.PluginManager.GetProvision(ctx)
 			if err != nil {
 				return err
 			}
 
-			tzs, err := ds.ListTrustZones()
+			tzs, err := ds.ListTrustZones(ctx)
 			if err != nil {
 				return err
 			}
@@ -75,16 +78,20 @@ func (u *UpCommand) UpCmd() *cobra.Command {
 				TrustZoneIDs: trustZoneIDs,
 				SkipWait:     opts.skipWait,
 			}
-			statusCh, err := provision.Deploy(cmd.Context(), ds, &deployOpts)
+			recorder := audit.NewProvisionRecorder(u.cmdCtx.PluginManager.GetAuditLog(), audit.OperationUp, opts.trustZones)
+			statusCh, err := provision.Deploy(ctx, datasource.ToV1(ctx, ds), &deployOpts)
 			if err != nil {
+				recorder.Finish(err)
 				return err
 			}
 
-			return statusspinner.WatchProvisionStatus(
-				cmd.Context(),
-				statusCh,
+			err = statusspinner.WatchProvisionStatus(
+				ctx,
+				recorder.Watch(statusCh),
 				opts.quiet,
 			)
+			recorder.Finish(err)
+			return err
 		},
 	}
 
@@ -0,0 +1,321 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd/renderer"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
+	"github.com/cofide/cofidectl/internal/pkg/verify"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// ErrDrift is returned when the state of a trust zone differs from the expected state.
+var ErrDrift = errors.New("drift detected")
+
+type VerifyCommand struct {
+	cmdCtx *cmdcontext.CommandContext
+}
+
+func NewVerifyCommand(cmdCtx *cmdcontext.CommandContext) *VerifyCommand {
+	return &VerifyCommand{
+		cmdCtx: cmdCtx,
+	}
+}
+
+var verifyRootCmdDesc = `
+This command consists of multiple sub-commands to verify that the state of a trust zone matches
+the Cofide configuration.
+`
+
+func (c *VerifyCommand) GetRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify entries [ARGS]",
+		Short: "Verify the state of a trust zone against the Cofide configuration",
+		Long:  verifyRootCmdDesc,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		c.GetEntriesCommand(),
+	)
+
+	return cmd
+}
+
+var verifyEntriesCmdDesc = `
+This command will verify that the registration entries in the SPIRE server of a trust zone match
+the attestation policies bound to the trust zone.
+
+The expected entries are computed from the ClusterSPIFFEID and ClusterStaticEntry resources
+generated for the trust zone (see 'cofidectl export crds'), together with the namespaces, pods and
+nodes in the cluster running the SPIRE server. These are compared with the live registration
+entries, and any missing, unexpected or mismatched entries are reported. Mismatched entries differ
+in their SPIFFE ID, parent ID, selectors, DNS names or federated trust domains.
+
+Entries that are not managed by the SPIRE controller manager, such as those created by
+'cofidectl agent token create --spiffe-id' to alias agents, are reported as unmanaged and are not
+considered drift.
+
+The command exits with an error if drift is detected.
+`
+
+type entriesOpts struct {
+	trustZone         string
+	clusterName       string
+	ignoredNamespaces []string
+	output            string
+}
+
+func (c *VerifyCommand) GetEntriesCommand() *cobra.Command {
+	opts := entriesOpts{}
+	cmd := &cobra.Command{
+		Use:   "entries [ARGS]",
+		Short: "Verify registration entries in a trust zone",
+		Long:  verifyEntriesCmdDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.output != outputText && opts.output != outputJSON {
+				return fmt.Errorf("unsupported output format %q, expected one of %s, %s", opts.output, outputText, outputJSON)
+			}
+
+			ctx := cmd.Context()
+			ds, err := c.cmdCtx.PluginManager.GetDataSourceV2(ctx)
+			if err != nil {
+				return err
+			}
+
+			kubeConfig, err := cmd.Flags().GetString("kube-config")
+			if err != nil {
+				return fmt.Errorf("failed to retrieve the kubeconfig file location")
+			}
+
+			result, err := verifyEntries(ctx, ds, kubeConfig, opts)
+			if err != nil {
+				return err
+			}
+
+			if err := renderEntriesResult(os.Stdout, result, opts.output); err != nil {
+				return err
+			}
+			if result.HasDrift() {
+				return ErrDrift
+			}
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone to verify")
+	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster running the SPIRE server (required if trust zone has multiple clusters)")
+	f.StringSliceVar(&opts.ignoredNamespaces, "ignore-namespace", verify.DefaultIgnoredNamespaces, "Namespaces ignored by the SPIRE controller manager, as regular expressions")
+	f.StringVar(&opts.output, "output", outputText, "Output format, one of text or json")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("trust-zone"))
+
+	return cmd
+}
+
+func verifyEntries(ctx context.Context, ds datasource.DataSourceV2, kubeConfig string, opts entriesOpts) (*verify.EntriesResult, error) {
+	trustZone, client, err := trustzone.GetServerClient(datasource.ToV1(ctx, ds), kubeConfig, opts.trustZone, opts.clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := trustzone.ResolveCluster(trustZone, opts.clusterName, datasource.ToV1(ctx, ds))
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := trustzone.GetSPIRECRDs(trustZone, datasource.ToV1(ctx, ds))
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := verify.GetClusterState(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := verify.ExpectedEntries(resources, trustZone.GetTrustDomain(), cluster.GetName(), state, opts.ignoredNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := spire.ListEntries(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registration entries: %w", err)
+	}
+
+	return verify.CompareEntries(expected, actual, state, opts.ignoredNamespaces)
+}
+
+type expectedEntryJSON struct {
+	Policy        string   `json:"policy"`
+	SPIFFEID      string   `json:"spiffe_id"`
+	ParentID      string   `json:"parent_id,omitempty"`
+	Selectors     []string `json:"selectors"`
+	DNSNames      []string `json:"dns_names,omitempty"`
+	FederatesWith []string `json:"federates_with,omitempty"`
+}
+
+type actualEntryJSON struct {
+	ID            string   `json:"id"`
+	SPIFFEID      string   `json:"spiffe_id"`
+	ParentID      string   `json:"parent_id"`
+	Selectors     []string `json:"selectors"`
+	DNSNames      []string `json:"dns_names,omitempty"`
+	FederatesWith []string `json:"federates_with,omitempty"`
+}
+
+type mismatchJSON struct {
+	Expected    expectedEntryJSON `json:"expected"`
+	Actual      actualEntryJSON   `json:"actual"`
+	Differences []string          `json:"differences"`
+}
+
+type entriesResultJSON struct {
+	Missing    []expectedEntryJSON `json:"missing"`
+	Unexpected []actualEntryJSON   `json:"unexpected"`
+	Mismatched []mismatchJSON      `json:"mismatched"`
+	Unmanaged  []actualEntryJSON   `json:"unmanaged"`
+}
+
+func newExpectedEntryJSON(entry *verify.ExpectedEntry) expectedEntryJSON {
+	return expectedEntryJSON{
+		Policy:        entry.Owner,
+		SPIFFEID:      entry.SPIFFEID,
+		ParentID:      entry.ParentID,
+		Selectors:     entry.Selectors,
+		DNSNames:      entry.DNSNames,
+		FederatesWith: entry.FederatesWith,
+	}
+}
+
+func newActualEntryJSON(entry *spire.Entry) actualEntryJSON {
+	return actualEntryJSON{
+		ID:            entry.ID,
+		SPIFFEID:      entry.SPIFFEID,
+		ParentID:      entry.ParentID,
+		Selectors:     entry.Selectors,
+		DNSNames:      entry.DNSNames,
+		FederatesWith: entry.FederatesWith,
+	}
+}
+
+// renderEntriesResult writes the result of verifying registration entries to the writer in the
+// specified format.
+func renderEntriesResult(w io.Writer, result *verify.EntriesResult, output string) error {
+	if output == outputJSON {
+		resultJSON := entriesResultJSON{
+			Missing:    []expectedEntryJSON{},
+			Unexpected: []actualEntryJSON{},
+			Mismatched: []mismatchJSON{},
+			Unmanaged:  []actualEntryJSON{},
+		}
+		for _, entry := range result.Missing {
+			resultJSON.Missing = append(resultJSON.Missing, newExpectedEntryJSON(entry))
+		}
+		for _, entry := range result.Unexpected {
+			resultJSON.Unexpected = append(resultJSON.Unexpected, newActualEntryJSON(entry))
+		}
+		for _, mismatch := range result.Mismatched {
+			resultJSON.Mismatched = append(resultJSON.Mismatched, mismatchJSON{
+				Expected:    newExpectedEntryJSON(mismatch.Expected),
+				Actual:      newActualEntryJSON(mismatch.Actual),
+				Differences: mismatch.Differences,
+			})
+		}
+		for _, entry := range result.Unmanaged {
+			resultJSON.Unmanaged = append(resultJSON.Unmanaged, newActualEntryJSON(entry))
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resultJSON)
+	}
+
+	if !result.HasDrift() {
+		if _, err := fmt.Fprintln(w, "Registration entries match the attestation policies"); err != nil {
+			return err
+		}
+		return renderUnmanaged(w, result)
+	}
+
+	missingData := make([][]string, 0, len(result.Missing))
+	for _, entry := range result.Missing {
+		missingData = append(missingData, []string{
+			entry.Owner,
+			entry.SPIFFEID,
+			entry.ParentID,
+			strings.Join(entry.Selectors, "\n"),
+		})
+	}
+
+	unexpectedData := make([][]string, 0, len(result.Unexpected))
+	for _, entry := range result.Unexpected {
+		unexpectedData = append(unexpectedData, []string{
+			entry.ID,
+			entry.SPIFFEID,
+			entry.ParentID,
+			strings.Join(entry.Selectors, "\n"),
+		})
+	}
+
+	mismatchedData := make([][]string, 0, len(result.Mismatched))
+	for _, mismatch := range result.Mismatched {
+		mismatchedData = append(mismatchedData, []string{
+			mismatch.Expected.Owner,
+			mismatch.Actual.ID,
+			mismatch.Actual.SPIFFEID,
+			strings.Join(mismatch.Differences, "\n"),
+		})
+	}
+
+	_, err := renderer.NewTableRenderer(w).RenderTables(
+		renderer.Table{
+			Title:  "Missing entries",
+			Header: []string{"Policy", "SPIFFE ID", "Parent ID", "Selectors"},
+			Data:   missingData,
+		},
+		renderer.Table{
+			Title:  "Unexpected entries",
+			Header: []string{"Entry ID", "SPIFFE ID", "Parent ID", "Selectors"},
+			Data:   unexpectedData,
+		},
+		renderer.Table{
+			Title:  "Mismatched entries",
+			Header: []string{"Policy", "Entry ID", "SPIFFE ID", "Differences"},
+			Data:   mismatchedData,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return renderUnmanaged(w, result)
+}
+
+// renderUnmanaged writes the number of registration entries that were not verified because they
+// are not managed by the SPIRE controller manager, if any.
+func renderUnmanaged(w io.Writer, result *verify.EntriesResult) error {
+	if len(result.Unmanaged) == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(w, "Ignored %d registration entries not managed by the SPIRE controller manager\n", len(result.Unmanaged))
+	return err
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cofide/cofidectl/internal/pkg/verify"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderEntriesResult(t *testing.T) {
+	result := &verify.EntriesResult{
+		Missing: []*verify.ExpectedEntry{
+			{
+				Owner:     "ap1",
+				SPIFFEID:  "spiffe://td1/ns/ns1/sa/sa1",
+				Selectors: []string{"k8s:pod-uid:1234"},
+			},
+		},
+		Unexpected: []*spire.Entry{},
+		Mismatched: []*verify.Mismatch{
+			{
+				Expected: &verify.ExpectedEntry{
+					Owner:         "ap2",
+					SPIFFEID:      "spiffe://td1/vm1",
+					ParentID:      "spiffe://td1/spire/agent/join_token/token1",
+					Selectors:     []string{"unix:uid:1000"},
+					FederatesWith: []string{"td2"},
+				},
+				Actual: &spire.Entry{
+					ID:        "entry1",
+					SPIFFEID:  "spiffe://td1/vm1",
+					ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+					Selectors: []string{"unix:uid:1000"},
+				},
+				Differences: []string{"federatesWith: expected [td2], got []"},
+			},
+		},
+		Unmanaged: []*spire.Entry{
+			{
+				ID:        "entry2",
+				SPIFFEID:  "spiffe://td1/vm2",
+				ParentID:  "spiffe://td1/spire/agent/join_token/token2",
+				Selectors: []string{"spiffe_id:spiffe://td1/spire/agent/join_token/token2"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderEntriesResult(&buf, result, outputJSON))
+	assert.Equal(t, `{
+  "missing": [
+    {
+      "policy": "ap1",
+      "spiffe_id": "spiffe://td1/ns/ns1/sa/sa1",
+      "selectors": [
+        "k8s:pod-uid:1234"
+      ]
+    }
+  ],
+  "unexpected": [],
+  "mismatched": [
+    {
+      "expected": {
+        "policy": "ap2",
+        "spiffe_id": "spiffe://td1/vm1",
+        "parent_id": "spiffe://td1/spire/agent/join_token/token1",
+        "selectors": [
+          "unix:uid:1000"
+        ],
+        "federates_with": [
+          "td2"
+        ]
+      },
+      "actual": {
+        "id": "entry1",
+        "spiffe_id": "spiffe://td1/vm1",
+        "parent_id": "spiffe://td1/spire/agent/join_token/token1",
+        "selectors": [
+          "unix:uid:1000"
+        ]
+      },
+      "differences": [
+        "federatesWith: expected [td2], got []"
+      ]
+    }
+  ],
+  "unmanaged": [
+    {
+      "id": "entry2",
+      "spiffe_id": "spiffe://td1/vm2",
+      "parent_id": "spiffe://td1/spire/agent/join_token/token2",
+      "selectors": [
+        "spiffe_id:spiffe://td1/spire/agent/join_token/token2"
+      ]
+    }
+  ]
+}
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, renderEntriesResult(&buf, result, outputText))
+	assert.Contains(t, buf.String(), "Missing entries")
+	assert.Contains(t, buf.String(), "spiffe://td1/ns/ns1/sa/sa1")
+	assert.Contains(t, buf.String(), "federatesWith: expected [td2], got []")
+	assert.Contains(t, buf.String(), "Ignored 1 registration entries not managed by the SPIRE controller manager")
+
+	buf.Reset()
+	require.NoError(t, renderEntriesResult(&buf, &verify.EntriesResult{}, outputText))
+	assert.Equal(t, "Registration entries match the attestation policies\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, renderEntriesResult(&buf, &verify.EntriesResult{Unmanaged: result.Unmanaged}, outputText))
+	assert.Equal(t, "Registration entries match the attestation policies\nIgnored 1 registration entries not managed by the SPIRE controller manager\n", buf.String())
+}
@@ -7,8 +7,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"maps"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
@@ -18,7 +24,9 @@ import (
 	"github.com/cofide/cofidectl/internal/pkg/workload"
 	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
 	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/plugin"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/cofide/cofidectl/pkg/provider/helm"
 	"github.com/spf13/cobra"
 )
@@ -71,20 +79,21 @@ func (w *WorkloadCommand) GetListCommand() *cobra.Command {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
-			ds, err := w.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := w.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
 			var trustZones []*trust_zone_proto.TrustZone
 			if opts.trustZone != "" {
-				trustZone, err := ds.GetTrustZoneByName(opts.trustZone)
+				trustZone, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 				if err != nil {
 					return fmt.Errorf("trust zone %s not found", opts.trustZone)
 				}
 				trustZones = []*trust_zone_proto.TrustZone{trustZone}
 			} else {
-				trustZones, err = ds.ListTrustZones()
+				trustZones, err = ds.ListTrustZones(ctx)
 				if err != nil {
 					return err
 				}
@@ -99,7 +108,14 @@ func (w *WorkloadCommand) GetListCommand() *cobra.Command {
 				return fmt.Errorf("failed to retrieve the kubeconfig file location")
 			}
 
-			err = renderRegisteredWorkloads(cmd.Context(), ds, kubeConfig, trustZones)
+			// Deployment of the trust zones cannot be checked if the provision plugin does not
+			// support status queries, but workloads are still listed.
+			reporter, err := w.cmdCtx.PluginManager.GetStatusReporter(ctx)
+			if err != nil && !errors.Is(err, plugin.ErrNotSupported) {
+				return err
+			}
+
+			err = renderRegisteredWorkloads(ctx, ds, reporter, kubeConfig, trustZones)
 			if err != nil {
 				return err
 			}
@@ -116,13 +132,18 @@ func (w *WorkloadCommand) GetListCommand() *cobra.Command {
 
 var workloadStatusCmdDesc = `
 This command will display the status of workloads in the Cofide configuration state.
+
+By default the workload's registration entries, SPIRE agent and federated bundles are resolved
+by querying the SPIRE server, without modifying the workload's pod. With --debug-container, an
+ephemeral debug container is instead injected into the pod to query the SPIFFE Workload API.
 `
 
 type StatusOpts struct {
-	podName     string
-	namespace   string
-	trustZone   string
-	clusterName string
+	podName        string
+	namespace      string
+	trustZone      string
+	clusterName    string
+	debugContainer bool
 }
 
 func (w *WorkloadCommand) GetStatusCommand() *cobra.Command {
@@ -133,7 +154,8 @@ func (w *WorkloadCommand) GetStatusCommand() *cobra.Command {
 		Long:  workloadStatusCmdDesc,
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ds, err := w.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := w.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
@@ -143,7 +165,7 @@ func (w *WorkloadCommand) GetStatusCommand() *cobra.Command {
 				return fmt.Errorf("failed to retrieve the kubeconfig file location")
 			}
 
-			return w.status(cmd.Context(), ds, kubeConfig, opts)
+			return w.status(ctx, ds, kubeConfig, opts)
 		},
 	}
 
@@ -152,6 +174,7 @@ func (w *WorkloadCommand) GetStatusCommand() *cobra.Command {
 	f.StringVar(&opts.namespace, "namespace", "", "Namespace for the workload")
 	f.StringVar(&opts.trustZone, "trust-zone", "", "Trust zone for the workload")
 	f.StringVar(&opts.clusterName, "cluster", "", "Name of the cluster for the workload (required if trust zone has multiple clusters)")
+	f.BoolVar(&opts.debugContainer, "debug-container", false, "Inject an ephemeral debug container into the pod to query the SPIFFE Workload API")
 
 	cobra.CheckErr(cmd.MarkFlagRequired("pod-name"))
 	cobra.CheckErr(cmd.MarkFlagRequired("namespace"))
@@ -160,13 +183,30 @@ func (w *WorkloadCommand) GetStatusCommand() *cobra.Command {
 	return cmd
 }
 
-func (w *WorkloadCommand) status(ctx context.Context, ds datasource.DataSource, kubeConfig string, opts StatusOpts) error {
-	trustZone, err := ds.GetTrustZoneByName(opts.trustZone)
+func (w *WorkloadCommand) status(ctx context.Context, ds datasource.DataSourceV2, kubeConfig string, opts StatusOpts) error {
+	if opts.debugContainer {
+		return w.debugContainerStatus(ctx, ds, kubeConfig, opts)
+	}
+
+	_, client, err := trustzone.GetServerClient(datasource.ToV1(ctx, ds), kubeConfig, opts.trustZone, opts.clusterName)
 	if err != nil {
 		return err
 	}
 
-	cluster, err := trustzone.ResolveCluster(trustZone, opts.clusterName, ds)
+	identity, err := workload.GetIdentity(ctx, client, opts.podName, opts.namespace)
+	if err != nil {
+		return fmt.Errorf("retrieving workload status failed: %w", err)
+	}
+	return renderIdentity(os.Stdout, identity)
+}
+
+func (w *WorkloadCommand) debugContainerStatus(ctx context.Context, ds datasource.DataSourceV2, kubeConfig string, opts StatusOpts) error {
+	trustZone, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := trustzone.ResolveCluster(trustZone, opts.clusterName, datasource.ToV1(ctx, ds))
 	if err != nil {
 		return err
 	}
@@ -192,11 +232,72 @@ func (w *WorkloadCommand) status(ctx context.Context, ds datasource.DataSource,
 	return nil
 }
 
-func renderRegisteredWorkloads(ctx context.Context, ds datasource.DataSource, kubeConfig string, trustZones []*trust_zone_proto.TrustZone) error {
+// renderIdentity writes the SPIRE identity of a workload to the writer.
+func renderIdentity(w io.Writer, identity *workload.Identity) error {
+	agentID, agentExpires := "none", "none"
+	if identity.Agent != nil {
+		agentID = identity.Agent.Id
+		agentExpires = identity.Agent.ExpirationTime.UTC().Format(time.RFC3339)
+	}
+	workloadData := [][]string{
+		{"Pod", identity.Pod.Name},
+		{"Namespace", identity.Pod.Namespace},
+		{"Service Account", identity.Pod.Spec.ServiceAccountName},
+		{"Node", identity.Pod.Spec.NodeName},
+		{"Agent ID", agentID},
+		{"Agent SVID Expires", agentExpires},
+	}
+
+	entryData := make([][]string, 0, len(identity.Entries))
+	for _, entry := range identity.Entries {
+		ttl := "default"
+		if entry.X509SVIDTTL > 0 {
+			ttl = entry.X509SVIDTTL.String()
+		}
+		entryData = append(entryData, []string{
+			entry.SPIFFEID,
+			entry.ID,
+			strings.Join(entry.Selectors, "\n"),
+			ttl,
+			strings.Join(entry.FederatesWith, "\n"),
+		})
+	}
+
+	bundleData := make([][]string, 0, len(identity.FederatedBundles))
+	for _, trustDomain := range slices.Sorted(maps.Keys(identity.FederatedBundles)) {
+		bundleData = append(bundleData, []string{
+			trustDomain,
+			strconv.FormatBool(identity.FederatedBundles[trustDomain]),
+		})
+	}
+
+	_, err := renderer.NewTableRenderer(w).RenderTables(
+		renderer.Table{
+			Title:  "Workload",
+			Header: []string{"Item", "Value"},
+			Data:   workloadData,
+		},
+		renderer.Table{
+			Title:  "Registration Entries",
+			Header: []string{"SPIFFE ID", "Entry ID", "Selectors", "X509-SVID TTL", "Federates With"},
+			Data:   entryData,
+		},
+		renderer.Table{
+			Title:  "Federated Bundles",
+			Header: []string{"Trust Domain", "Bundle Present"},
+			Data:   bundleData,
+		},
+	)
+	return err
+}
+
+// renderRegisteredWorkloads lists the registered workloads in the clusters of the trust zones. If
+// reporter is not nil, it is used to check that the clusters have been deployed.
+func renderRegisteredWorkloads(ctx context.Context, ds datasource.DataSourceV2, reporter provision.StatusReporter, kubeConfig string, trustZones []*trust_zone_proto.TrustZone) error {
 	data := make([][]string, 0, len(trustZones))
 
 	for _, trustZone := range trustZones {
-		clusters, err := trustzone.GetClustersByTrustZone(trustZone, ds)
+		clusters, err := trustzone.GetClustersByTrustZone(trustZone, datasource.ToV1(ctx, ds))
 		if err != nil {
 			if errors.Is(err, trustzone.ErrNoClustersInTrustZone) {
 				continue
@@ -210,10 +311,13 @@ func renderRegisteredWorkloads(ctx context.Context, ds datasource.DataSource, ku
 				continue
 			}
 
-			if deployed, err := helm.IsClusterDeployed(ctx, cluster, kubeConfig); err != nil {
-				return err
-			} else if !deployed {
-				return fmt.Errorf("trust zone %s has not been deployed", trustZone.Name)
+			if reporter != nil {
+				opts := &provision.IsDeployedOpts{KubeCfgFile: kubeConfig, ClusterID: cluster.GetId()}
+				if deployed, err := reporter.IsDeployed(ctx, datasource.ToV1(ctx, ds), opts); err != nil {
+					return err
+				} else if !deployed {
+					return fmt.Errorf("trust zone %s has not been deployed", trustZone.Name)
+				}
 			}
 
 			registeredWorkloads, err := workload.GetRegisteredWorkloads(ctx, kubeConfig, cluster.GetKubernetesContext())
@@ -274,20 +378,21 @@ func (w *WorkloadCommand) GetDiscoverCommand() *cobra.Command {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
-			ds, err := w.cmdCtx.PluginManager.GetDataSource(cmd.Context())
+			ctx := cmd.Context()
+			ds, err := w.cmdCtx.PluginManager.GetDataSourceV2(ctx)
 			if err != nil {
 				return err
 			}
 
 			var trustZones []*trust_zone_proto.TrustZone
 			if opts.trustZone != "" {
-				trustZone, err := ds.GetTrustZoneByName(opts.trustZone)
+				trustZone, err := ds.GetTrustZoneByName(ctx, opts.trustZone)
 				if err != nil {
 					return fmt.Errorf("trust zone %s not found", opts.trustZone)
 				}
 				trustZones = []*trust_zone_proto.TrustZone{trustZone}
 			} else {
-				trustZones, err = ds.ListTrustZones()
+				trustZones, err = ds.ListTrustZones(ctx)
 				if err != nil {
 					return err
 				}
@@ -302,7 +407,16 @@ func (w *WorkloadCommand) GetDiscoverCommand() *cobra.Command {
 				return fmt.Errorf("failed to retrieve the kubeconfig file location")
 			}
 
-			err = renderUnregisteredWorkloads(cmd.Context(), ds, kubeConfig, trustZones, opts.includeSecrets)
+			// Without status queries, the clusters are assumed not to have been deployed, so
+			// registration entries are not checked.
+			reporter, err := w.cmdCtx.PluginManager.GetStatusReporter(ctx)
+			if errors.Is(err, plugin.ErrNotSupported) {
+				slog.Warn("Provision plugin does not support status, registered workloads will be included", "error", err)
+			} else if err != nil {
+				return err
+			}
+
+			err = renderUnregisteredWorkloads(ctx, ds, reporter, kubeConfig, trustZones, opts.includeSecrets)
 			if err != nil {
 				return err
 			}
@@ -318,11 +432,13 @@ func (w *WorkloadCommand) GetDiscoverCommand() *cobra.Command {
 	return cmd
 }
 
-func renderUnregisteredWorkloads(ctx context.Context, ds datasource.DataSource, kubeConfig string, trustZones []*trust_zone_proto.TrustZone, includeSecrets bool) error {
+// renderUnregisteredWorkloads lists the unregistered workloads in the clusters of the trust zones.
+// If reporter is nil, the clusters are assumed not to have been deployed.
+func renderUnregisteredWorkloads(ctx context.Context, ds datasource.DataSourceV2, reporter provision.StatusReporter, kubeConfig string, trustZones []*trust_zone_proto.TrustZone, includeSecrets bool) error {
 	data := make([][]string, 0, len(trustZones))
 
 	for _, trustZone := range trustZones {
-		clusters, err := trustzone.GetClustersByTrustZone(trustZone, ds)
+		clusters, err := trustzone.GetClustersByTrustZone(trustZone, datasource.ToV1(ctx, ds))
 		if err != nil {
 			if errors.Is(err, trustzone.ErrNoClustersInTrustZone) {
 				continue
@@ -336,9 +452,12 @@ func renderUnregisteredWorkloads(ctx context.Context, ds datasource.DataSource,
 				continue
 			}
 
-			deployed, err := helm.IsClusterDeployed(ctx, cluster, kubeConfig)
-			if err != nil {
-				return err
+			deployed := false
+			if reporter != nil {
+				opts := &provision.IsDeployedOpts{KubeCfgFile: kubeConfig, ClusterID: cluster.GetId()}
+				if deployed, err = reporter.IsDeployed(ctx, datasource.ToV1(ctx, ds), opts); err != nil {
+					return err
+				}
 			}
 
 			registeredWorkloads, err := workload.GetUnregisteredWorkloads(ctx, kubeConfig, cluster.GetKubernetesContext(), includeSecrets, deployed)
@@ -4,10 +4,17 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"log/slog"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cofide/cofidectl/cmd/cofidectl/cmd"
 	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
@@ -15,11 +22,23 @@ import (
 )
 
 const (
-	cofidectlPluginPrefix = "cofidectl-"
-	cofideConfigFile      = "cofide.yaml"
-	shutdownTimeoutSec    = 10
+	allowUnsignedPluginsFlag = "allow-unsigned-plugins"
+	cofideConfigFile         = "cofide.yaml"
+	shutdownTimeoutSec       = 10
 )
 
+// cliPluginFlagEnvVars maps global flags to the environment variables used to pass them to CLI
+// plugins.
+var cliPluginFlagEnvVars = map[string]string{
+	"config":                 plugin.CliPluginConfigEnvVar,
+	"kube-config":            plugin.CliPluginKubeConfigEnvVar,
+	"log-level":              plugin.CliPluginLogLevelEnvVar,
+	"log-file":               plugin.CliPluginLogFileEnvVar,
+	"log-format":             plugin.CliPluginLogFormatEnvVar,
+	"audit-log":              plugin.CliPluginAuditLogEnvVar,
+	allowUnsignedPluginsFlag: plugin.AllowUnsignedPluginsEnvVar,
+}
+
 var (
 	name    = "cofidectl"
 	version = "unreleased"
@@ -37,6 +56,9 @@ func main() {
 func run() error {
 	cmdCtx := cmdcontext.NewCommandContext(cofideConfigFile, nil)
 	defer cmdCtx.Shutdown()
+	// The command context makes slog the default logger, which redirects the log package to
+	// slog at INFO level. Errors logged here must not be filtered by the log level.
+	log.SetOutput(os.Stderr)
 	go cmdCtx.HandleSignals()
 
 	rootCmd, err := cmd.NewRootCommand(name, version, cmdCtx).GetRootCommand()
@@ -45,8 +67,11 @@ func run() error {
 		return err
 	}
 
+	// Match the default --log-level until the flags are parsed by cobra.
+	cmdCtx.SetLogLevel(slog.LevelError)
+
 	// Check if there is a CLI plugin to execute.
-	cliPlugin, ok, err := getCliPlugin(rootCmd, os.Args)
+	cliPlugin, ok, err := getCliPlugin(rootCmd, cmdCtx, os.Args)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -64,19 +89,90 @@ func run() error {
 }
 
 // getCliPlugin returns a `plugin.CliPlugin` for a CLI plugin if:
-// 1. the first CLI argument does not match a registered subcommand
-// 2. a cofidectl plugin exists with a name of cofidectl- followed by the first CLI argument
-func getCliPlugin(rootCmd *cobra.Command, args []string) (*plugin.CliPlugin, bool, error) {
-	if len(args) > 1 {
-		if _, _, err := rootCmd.Find(args[0:2]); err != nil {
-			pluginName := cofidectlPluginPrefix + args[1]
-			if exists, err := plugin.PluginExists(pluginName); err != nil {
-				return nil, false, err
-			} else if exists {
-				cliPlugin := plugin.NewCliPlugin(pluginName, args[2:])
-				return cliPlugin, true, nil
+// 1. the first positional CLI argument does not match a registered or reserved subcommand, or
+// matches a CLI plugin placeholder subcommand
+// 2. a cofidectl plugin exists in the plugin search path with a name of cofidectl- followed by
+// the first positional CLI argument
+// Global flags preceding the plugin name are removed from the plugin's arguments, and passed to
+// the plugin as environment variables. The plugin search path includes the plugins.path setting
+// of the config file specified by the --config flag.
+func getCliPlugin(rootCmd *cobra.Command, cmdCtx *cmdcontext.CommandContext, args []string) (*plugin.CliPlugin, bool, error) {
+	flagValues, args, ok := parseGlobalFlags(rootCmd, args)
+	if !ok || len(args) < 2 {
+		return nil, false, nil
+	}
+	if cmd.IsReservedCommand(args[1]) {
+		return nil, false, nil
+	}
+	if found, _, err := rootCmd.Find(args[1:]); err == nil && !cmd.IsCliPluginCommand(found) {
+		return nil, false, nil
+	}
+
+	if err := cmdCtx.UpdateConfigFile(flagValues["config"], flagValues["kube-config"]); err != nil {
+		return nil, false, err
+	}
+
+	pluginName := plugin.CliPluginPrefix + args[1]
+	resolved, err := cmdCtx.PluginManager.FindPlugin(pluginName)
+	if errors.Is(err, plugin.ErrPluginNotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	allowUnsigned, err := strconv.ParseBool(flagValues[allowUnsignedPluginsFlag])
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid argument %q for --%s flag: %w", flagValues[allowUnsignedPluginsFlag], allowUnsignedPluginsFlag, err)
+	}
+	policy, err := plugin.NewSignaturePolicy(allowUnsigned)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cliPlugin := plugin.NewCliPlugin(pluginName, args[2:])
+	cliPlugin.PluginDir = resolved.Dir.Path
+	cliPlugin.SignaturePolicy = policy
+	cliPlugin.Env = []string{plugin.CliPluginVersionEnvVar + "=" + version}
+	for flagName, envVar := range cliPluginFlagEnvVars {
+		cliPlugin.Env = append(cliPlugin.Env, envVar+"="+flagValues[flagName])
+	}
+	slices.Sort(cliPlugin.Env)
+	return cliPlugin, true, nil
+}
+
+// parseGlobalFlags parses the root command's persistent flags preceding the first positional
+// argument, which may name a CLI plugin. It returns the value of each persistent flag, or its
+// default value if not specified, and the remaining arguments. ok is false if an argument
+// preceding the first positional argument is not a valid persistent flag, in which case cobra
+// should handle the arguments.
+func parseGlobalFlags(rootCmd *cobra.Command, args []string) (values map[string]string, rest []string, ok bool) {
+	flags := rootCmd.PersistentFlags()
+	values = map[string]string{}
+	flags.VisitAll(func(flag *pflag.Flag) {
+		values[flag.Name] = flag.DefValue
+	})
+	if len(args) == 0 {
+		return values, args, true
+	}
+
+	rest = args[1:]
+	for len(rest) > 0 && strings.HasPrefix(rest[0], "-") {
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(rest[0], "--"), "=")
+		flag := flags.Lookup(name)
+		if !strings.HasPrefix(rest[0], "--") || flag == nil {
+			return values, args, false
+		}
+		rest = rest[1:]
+		if !hasValue {
+			if flag.NoOptDefVal != "" {
+				value = flag.NoOptDefVal
+			} else if len(rest) > 0 {
+				value, rest = rest[0], rest[1:]
+			} else {
+				return values, args, false
 			}
 		}
+		values[name] = value
 	}
-	return nil, false, nil
+	return values, append([]string{args[0]}, rest...), true
 }
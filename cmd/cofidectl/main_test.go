@@ -0,0 +1,148 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cofide/cofidectl/cmd/cofidectl/cmd"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	cmdcontext "github.com/cofide/cofidectl/pkg/cmd/context"
+	"github.com/cofide/cofidectl/pkg/plugin"
+)
+
+func Test_parseGlobalFlags(t *testing.T) {
+	newRootCmd := func() *cobra.Command {
+		rootCmd := &cobra.Command{Use: "cofidectl"}
+		pf := rootCmd.PersistentFlags()
+		pf.String("config", "cofide.yaml", "")
+		pf.String("log-level", "ERROR", "")
+		pf.Bool(allowUnsignedPluginsFlag, false, "")
+		return rootCmd
+	}
+	defaults := map[string]string{"config": "cofide.yaml", "log-level": "ERROR", allowUnsignedPluginsFlag: "false"}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantValues map[string]string
+		wantArgs   []string
+		wantOk     bool
+	}{
+		{
+			name:       "no flags",
+			args:       []string{"cofidectl", "foo", "--config", "plugin.yaml"},
+			wantValues: defaults,
+			wantArgs:   []string{"cofidectl", "foo", "--config", "plugin.yaml"},
+			wantOk:     true,
+		},
+		{
+			name:       "flags",
+			args:       []string{"cofidectl", "--config", "other.yaml", "--log-level=DEBUG", "--allow-unsigned-plugins", "foo", "--bar"},
+			wantValues: map[string]string{"config": "other.yaml", "log-level": "DEBUG", allowUnsignedPluginsFlag: "true"},
+			wantArgs:   []string{"cofidectl", "foo", "--bar"},
+			wantOk:     true,
+		},
+		{
+			name:       "bool flag value",
+			args:       []string{"cofidectl", "--allow-unsigned-plugins=false", "foo"},
+			wantValues: defaults,
+			wantArgs:   []string{"cofidectl", "foo"},
+			wantOk:     true,
+		},
+		{
+			name:     "unknown flag",
+			args:     []string{"cofidectl", "--unknown", "foo"},
+			wantArgs: []string{"cofidectl", "--unknown", "foo"},
+			wantOk:   false,
+		},
+		{
+			name:     "shorthand flag",
+			args:     []string{"cofidectl", "-h"},
+			wantArgs: []string{"cofidectl", "-h"},
+			wantOk:   false,
+		},
+		{
+			name:     "missing value",
+			args:     []string{"cofidectl", "--config"},
+			wantArgs: []string{"cofidectl", "--config"},
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, args, ok := parseGlobalFlags(newRootCmd(), tt.args)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantArgs, args)
+			if tt.wantOk {
+				assert.Equal(t, tt.wantValues, values)
+			}
+		})
+	}
+}
+
+func Test_getCliPlugin_configPluginPath(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(plugin.PluginPathEnvVar, "")
+
+	pluginDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(pluginDir, plugin.CliPluginPrefix+"foo"), []byte("#!/bin/sh\n"), 0o700)
+	require.NoError(t, err)
+	configFile := filepath.Join(t.TempDir(), "other.yaml")
+	err = os.WriteFile(configFile, []byte("plugins:\n  path:\n    - "+pluginDir+"\n"), 0o600)
+	require.NoError(t, err)
+
+	cmdCtx := cmdcontext.NewCommandContext(cofideConfigFile, nil)
+	defer cmdCtx.Shutdown()
+	rootCmd, err := cmd.NewRootCommand(name, version, cmdCtx).GetRootCommand()
+	require.NoError(t, err)
+
+	_, ok, err := getCliPlugin(rootCmd, cmdCtx, []string{"cofidectl", "foo"})
+	require.NoError(t, err)
+	assert.False(t, ok, "plugin should not be found without --config")
+
+	cliPlugin, ok, err := getCliPlugin(rootCmd, cmdCtx, []string{"cofidectl", "--config", configFile, "foo", "bar"})
+	require.NoError(t, err)
+	require.True(t, ok, "plugin should be found in plugins.path of --config")
+	assert.Equal(t, pluginDir, cliPlugin.PluginDir)
+	assert.Contains(t, cliPlugin.Env, plugin.CliPluginConfigEnvVar+"="+configFile)
+}
+
+func Test_getCliPlugin_builtinCommand(t *testing.T) {
+	configDir := t.TempDir()
+	configFile := filepath.Join(configDir, "cofide.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("plugins: 123"), 0o600))
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "command", args: []string{"cofidectl", "--config", configFile, "version"}},
+		{name: "subcommand", args: []string{"cofidectl", "--config", configFile, "config", "validate"}},
+		{name: "help", args: []string{"cofidectl", "--config", configFile, "help"}},
+		{name: "completion", args: []string{"cofidectl", "--config", configFile, "__complete", "con"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdCtx := cmdcontext.NewCommandContext(cofideConfigFile, nil)
+			defer cmdCtx.Shutdown()
+			rootCmd, err := cmd.NewRootCommand(name, version, cmdCtx).GetRootCommand()
+			require.NoError(t, err)
+
+			_, ok, err := getCliPlugin(rootCmd, cmdCtx, tt.args)
+			require.NoError(t, err)
+			assert.False(t, ok)
+			// The config file is not loaded for builtin commands.
+			loader, isDirLoader := cmdCtx.PluginManager.GetConfigLoader().(config.DirLoader)
+			require.True(t, isDirLoader)
+			assert.NotEqual(t, configDir, loader.Dir())
+		})
+	}
+}
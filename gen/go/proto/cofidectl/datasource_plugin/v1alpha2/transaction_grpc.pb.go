@@ -0,0 +1,210 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/cofidectl/datasource_plugin/v1alpha2/transaction.proto
+
+package v1alpha2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DataSourceTransactionService_Begin_FullMethodName  = "/proto.cofidectl.datasource_plugin.v1alpha2.DataSourceTransactionService/Begin"
+	DataSourceTransactionService_Commit_FullMethodName = "/proto.cofidectl.datasource_plugin.v1alpha2.DataSourceTransactionService/Commit"
+	DataSourceTransactionService_Abort_FullMethodName  = "/proto.cofidectl.datasource_plugin.v1alpha2.DataSourceTransactionService/Abort"
+)
+
+// DataSourceTransactionServiceClient is the client API for DataSourceTransactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DataSourceTransactionService extends DataSourcePluginService with transactions. Begin returns a
+// transaction ID, which the client sends as the cofidectl-transaction-id gRPC metadata with
+// subsequent DataSourcePluginService calls to perform them within the transaction, before calling
+// Commit or Abort. Plugins that do not support transactions return an Unimplemented status for
+// Begin.
+type DataSourceTransactionServiceClient interface {
+	Begin(ctx context.Context, in *BeginRequest, opts ...grpc.CallOption) (*BeginResponse, error)
+	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
+	Abort(ctx context.Context, in *AbortRequest, opts ...grpc.CallOption) (*AbortResponse, error)
+}
+
+type dataSourceTransactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDataSourceTransactionServiceClient(cc grpc.ClientConnInterface) DataSourceTransactionServiceClient {
+	return &dataSourceTransactionServiceClient{cc}
+}
+
+func (c *dataSourceTransactionServiceClient) Begin(ctx context.Context, in *BeginRequest, opts ...grpc.CallOption) (*BeginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginResponse)
+	err := c.cc.Invoke(ctx, DataSourceTransactionService_Begin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceTransactionServiceClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitResponse)
+	err := c.cc.Invoke(ctx, DataSourceTransactionService_Commit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceTransactionServiceClient) Abort(ctx context.Context, in *AbortRequest, opts ...grpc.CallOption) (*AbortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortResponse)
+	err := c.cc.Invoke(ctx, DataSourceTransactionService_Abort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataSourceTransactionServiceServer is the server API for DataSourceTransactionService service.
+// All implementations should embed UnimplementedDataSourceTransactionServiceServer
+// for forward compatibility.
+//
+// DataSourceTransactionService extends DataSourcePluginService with transactions. Begin returns a
+// transaction ID, which the client sends as the cofidectl-transaction-id gRPC metadata with
+// subsequent DataSourcePluginService calls to perform them within the transaction, before calling
+// Commit or Abort. Plugins that do not support transactions return an Unimplemented status for
+// Begin.
+type DataSourceTransactionServiceServer interface {
+	Begin(context.Context, *BeginRequest) (*BeginResponse, error)
+	Commit(context.Context, *CommitRequest) (*CommitResponse, error)
+	Abort(context.Context, *AbortRequest) (*AbortResponse, error)
+}
+
+// UnimplementedDataSourceTransactionServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDataSourceTransactionServiceServer struct{}
+
+func (UnimplementedDataSourceTransactionServiceServer) Begin(context.Context, *BeginRequest) (*BeginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Begin not implemented")
+}
+func (UnimplementedDataSourceTransactionServiceServer) Commit(context.Context, *CommitRequest) (*CommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (UnimplementedDataSourceTransactionServiceServer) Abort(context.Context, *AbortRequest) (*AbortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Abort not implemented")
+}
+func (UnimplementedDataSourceTransactionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDataSourceTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataSourceTransactionServiceServer will
+// result in compilation errors.
+type UnsafeDataSourceTransactionServiceServer interface {
+	mustEmbedUnimplementedDataSourceTransactionServiceServer()
+}
+
+func RegisterDataSourceTransactionServiceServer(s grpc.ServiceRegistrar, srv DataSourceTransactionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDataSourceTransactionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DataSourceTransactionService_ServiceDesc, srv)
+}
+
+func _DataSourceTransactionService_Begin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceTransactionServiceServer).Begin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceTransactionService_Begin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceTransactionServiceServer).Begin(ctx, req.(*BeginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceTransactionService_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceTransactionServiceServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceTransactionService_Commit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceTransactionServiceServer).Commit(ctx, req.(*CommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceTransactionService_Abort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceTransactionServiceServer).Abort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceTransactionService_Abort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceTransactionServiceServer).Abort(ctx, req.(*AbortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataSourceTransactionService_ServiceDesc is the grpc.ServiceDesc for DataSourceTransactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataSourceTransactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.cofidectl.datasource_plugin.v1alpha2.DataSourceTransactionService",
+	HandlerType: (*DataSourceTransactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Begin",
+			Handler:    _DataSourceTransactionService_Begin_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _DataSourceTransactionService_Commit_Handler,
+		},
+		{
+			MethodName: "Abort",
+			Handler:    _DataSourceTransactionService_Abort_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/cofidectl/datasource_plugin/v1alpha2/transaction.proto",
+}
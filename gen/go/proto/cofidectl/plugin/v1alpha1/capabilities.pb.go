@@ -0,0 +1,161 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: proto/cofidectl/plugin/v1alpha1/capabilities.proto
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Capabilities describes the API versions and optional features supported by a plugin.
+type Capabilities struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersions []string               `protobuf:"bytes,1,rep,name=api_versions,json=apiVersions,proto3" json:"api_versions,omitempty"`
+	Features    []string               `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	// A CUE schema or JSON Schema document for the plugin's configuration, if the plugin declares
+	// one.
+	ConfigSchema  *string `protobuf:"bytes,3,opt,name=config_schema,json=configSchema,proto3,oneof" json:"config_schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Capabilities) GetApiVersions() []string {
+	if x != nil {
+		return x.ApiVersions
+	}
+	return nil
+}
+
+func (x *Capabilities) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *Capabilities) GetConfigSchema() string {
+	if x != nil && x.ConfigSchema != nil {
+		return *x.ConfigSchema
+	}
+	return ""
+}
+
+var File_proto_cofidectl_plugin_v1alpha1_capabilities_proto protoreflect.FileDescriptor
+
+var file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDesc = string([]byte{
+	0x0a, 0x32, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x66, 0x69, 0x64, 0x65, 0x63, 0x74,
+	0x6c, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x66, 0x69,
+	0x64, 0x65, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x89, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x88, 0x01, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x66, 0x69, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x66, 0x69, 0x64, 0x65, 0x63, 0x74, 0x6c,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x66, 0x69, 0x64, 0x65, 0x63, 0x74, 0x6c, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDescOnce sync.Once
+	file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDescData []byte
+)
+
+func file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDescGZIP() []byte {
+	file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDescOnce.Do(func() {
+		file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDesc), len(file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDesc)))
+	})
+	return file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDescData
+}
+
+var file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_goTypes = []any{
+	(*Capabilities)(nil), // 0: proto.cofidectl.plugin.v1alpha1.Capabilities
+}
+var file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_init() }
+func file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_init() {
+	if File_proto_cofidectl_plugin_v1alpha1_capabilities_proto != nil {
+		return
+	}
+	file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDesc), len(file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_goTypes,
+		DependencyIndexes: file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_depIdxs,
+		MessageInfos:      file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_msgTypes,
+	}.Build()
+	File_proto_cofidectl_plugin_v1alpha1_capabilities_proto = out.File
+	file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_goTypes = nil
+	file_proto_cofidectl_plugin_v1alpha1_capabilities_proto_depIdxs = nil
+}
@@ -8,57 +8,44 @@ import (
 
 	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
 	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/spirecrd"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	types "github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // spiffeTDIDTemplate is a Go template for a SPIFFE trust domain.
 const spiffeTDIDTemplate = "spiffe://{{ .TrustDomain }}/"
 
-// MakeClusterSPIFFEID returns a Helm value for a Kubernetes
+// MakeClusterSPIFFEID returns a ClusterSPIFFEID spec for a Kubernetes attestation policy binding.
 func MakeClusterSPIFFEID(
 	kubernetes *attestation_policy_proto.APKubernetes,
 	source datasource.DataSource,
 	binding *ap_binding_proto.APBinding,
-) (map[string]any, error) {
-	var clusterSPIFFEID = make(map[string]any)
-	if kubernetes.NamespaceSelector != nil {
-		selector := getAPLabelSelectorHelmConfig(kubernetes.NamespaceSelector)
-		if selector != nil {
-			clusterSPIFFEID["namespaceSelector"] = selector
-		}
-	}
-	if kubernetes.PodSelector != nil {
-		selector := getAPLabelSelectorHelmConfig(kubernetes.PodSelector)
-		if selector != nil {
-			clusterSPIFFEID["podSelector"] = selector
-		}
+) (*spirecrd.ClusterSPIFFEIDSpec, error) {
+	clusterSPIFFEID := &spirecrd.ClusterSPIFFEIDSpec{
+		NamespaceSelector: makeLabelSelector(kubernetes.GetNamespaceSelector()),
+		PodSelector:       makeLabelSelector(kubernetes.GetPodSelector()),
+		DNSNameTemplates:  getAPDNSNameTemplatesHelmConfig(kubernetes.GetDnsNameTemplates()),
 	}
 	if kubernetes.GetSpiffeIdPathTemplate() != "" {
-		clusterSPIFFEID["spiffeIDTemplate"] = spiffeTDIDTemplate + kubernetes.GetSpiffeIdPathTemplate()
-	}
-	if kubernetes.DnsNameTemplates != nil {
-		dnsNameTemplates := getAPDNSNameTemplatesHelmConfig(kubernetes.DnsNameTemplates)
-		if dnsNameTemplates != nil {
-			clusterSPIFFEID["dnsNameTemplates"] = dnsNameTemplates
-		}
+		clusterSPIFFEID.SPIFFEIDTemplate = spiffeTDIDTemplate + kubernetes.GetSpiffeIdPathTemplate()
 	}
 	federatesWith, err := makeFederatesWith(binding, source)
 	if err != nil {
 		return nil, err
 	}
-	if len(federatesWith) > 0 {
-		clusterSPIFFEID["federatesWith"] = federatesWith
-	}
+	clusterSPIFFEID.FederatesWith = federatesWith
 	return clusterSPIFFEID, nil
 }
 
+// MakeClusterStaticEntry returns a ClusterStaticEntry spec for a static attestation policy binding.
 func MakeClusterStaticEntry(
 	static *attestation_policy_proto.APStatic,
 	source datasource.DataSource,
 	binding *ap_binding_proto.APBinding,
-) (map[string]any, error) {
+) (*spirecrd.ClusterStaticEntrySpec, error) {
 	selectors, err := formatSelectors(static.Selectors)
 	if err != nil {
 		return nil, err
@@ -75,20 +62,18 @@ func MakeClusterStaticEntry(
 	if err != nil {
 		return nil, err
 	}
-	clusterStaticEntry := map[string]any{
-		"spiffeID":  spiffeID,
-		"parentID":  parentID,
-		"selectors": selectors,
-	}
-	if len(static.GetDnsNames()) > 0 {
-		clusterStaticEntry["dnsNames"] = static.GetDnsNames()
-	}
 	federatesWith, err := makeFederatesWith(binding, source)
 	if err != nil {
 		return nil, err
 	}
-	if len(federatesWith) > 0 {
-		clusterStaticEntry["federatesWith"] = federatesWith
+	clusterStaticEntry := &spirecrd.ClusterStaticEntrySpec{
+		SPIFFEID:      spiffeID,
+		ParentID:      parentID,
+		Selectors:     selectors,
+		FederatesWith: federatesWith,
+	}
+	if len(static.GetDnsNames()) > 0 {
+		clusterStaticEntry.DNSNames = static.GetDnsNames()
 	}
 	return clusterStaticEntry, nil
 }
@@ -106,29 +91,28 @@ func makeFederatesWith(binding *ap_binding_proto.APBinding, source datasource.Da
 	return federatesWith, nil
 }
 
-func getAPLabelSelectorHelmConfig(selector *attestation_policy_proto.APLabelSelector) map[string]any {
-	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+// makeLabelSelector returns a Kubernetes label selector for an attestation policy label selector,
+// or nil if the selector is empty.
+func makeLabelSelector(selector *attestation_policy_proto.APLabelSelector) *metav1.LabelSelector {
+	if len(selector.GetMatchLabels()) == 0 && len(selector.GetMatchExpressions()) == 0 {
 		return nil
 	}
 
-	matchLabels := map[string]any{}
-	for k, v := range selector.MatchLabels {
-		matchLabels[k] = v
+	labelSelector := &metav1.LabelSelector{}
+	if len(selector.MatchLabels) > 0 {
+		labelSelector.MatchLabels = map[string]string{}
+		for k, v := range selector.MatchLabels {
+			labelSelector.MatchLabels[k] = v
+		}
 	}
-
-	matchExpressions := []map[string]any{}
 	for _, me := range selector.MatchExpressions {
-		matchExpressions = append(matchExpressions, map[string]any{
-			"key":      me.GetKey(),
-			"operator": me.GetOperator(),
-			"values":   me.GetValues(),
+		labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      me.GetKey(),
+			Operator: metav1.LabelSelectorOperator(me.GetOperator()),
+			Values:   me.GetValues(),
 		})
 	}
-
-	return map[string]any{
-		"matchLabels":      matchLabels,
-		"matchExpressions": matchExpressions,
-	}
+	return labelSelector
 }
 
 func formatSelectors(selectors []*types.Selector) ([]string, error) {
@@ -0,0 +1,193 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records mutations of the Cofide configuration and provisioning operations to a
+// local log file, in JSON lines format.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Operations recorded for provisioning, in addition to the config.Operation* constants used for
+// data source mutations.
+const (
+	OperationUp   = "up"
+	OperationDown = "down"
+)
+
+// Record is a single entry in the audit log.
+type Record struct {
+	Time    time.Time `json:"time"`
+	User    string    `json:"user"`
+	Command string    `json:"command"`
+	// Operation is one of config.OperationAdd, config.OperationUpdate, config.OperationDelete,
+	// OperationUp or OperationDown.
+	Operation string `json:"operation"`
+	// EntityType is one of the config.Entity* constants. It is empty for up and down.
+	EntityType string `json:"entity_type,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	// Before and After are the JSON representations of the entity before and after a mutation.
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+	// ChangedFields lists the fields of the entity that differ between Before and After.
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	// TrustZones lists the trust zones targeted by up or down, or is empty if all were targeted.
+	TrustZones []string `json:"trust_zones,omitempty"`
+	// Statuses are the outcomes reported by the provision plugin for up or down.
+	Statuses []*Status `json:"statuses,omitempty"`
+	// Error is set if the operation failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Status is a provision status outcome.
+type Status struct {
+	Stage   string `json:"stage"`
+	Message string `json:"message"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Log appends records to an audit log file.
+type Log struct {
+	path    string
+	user    string
+	command string
+	now     func() time.Time
+}
+
+// NewLog returns a Log that appends records to the file at path, creating it and its directory
+// if necessary. Records are attributed to the current OS user and the command line in args.
+func NewLog(path string, args []string) *Log {
+	return &Log{
+		path:    path,
+		user:    currentUser(),
+		command: commandLine(args),
+		now:     time.Now,
+	}
+}
+
+// Path returns the path of the audit log file.
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Append fills in the time, user and command of the record and appends it to the log.
+func (l *Log) Append(record *Record) error {
+	record.Time = l.now().UTC()
+	record.User = l.user
+	record.Command = l.command
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	// Write the record and its newline in a single call, so that concurrent appends do not interleave.
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// Filter selects records from an audit log. Empty fields match all records.
+type Filter struct {
+	Operation  string
+	EntityType string
+	// ID matches records with this entity ID or name.
+	ID    string
+	User  string
+	Since time.Time
+}
+
+func (f *Filter) matches(record *Record) bool {
+	switch {
+	case f.Operation != "" && record.Operation != f.Operation:
+		return false
+	case f.EntityType != "" && record.EntityType != f.EntityType:
+		return false
+	case f.ID != "" && record.ID != f.ID && record.Name != f.ID:
+		return false
+	case f.User != "" && record.User != f.User:
+		return false
+	case !f.Since.IsZero() && record.Time.Before(f.Since):
+		return false
+	}
+	return true
+}
+
+// Read returns the records in the audit log file at path that match the filter, oldest first.
+// A missing file is treated as an empty log.
+func Read(path string, filter *Filter) ([]*Record, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []*Record{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	records := []*Record{}
+	scanner := bufio.NewScanner(f)
+	// Records include whole entities, so allow for long lines.
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, fmt.Errorf("invalid audit record at %s:%d: %w", path, line, err)
+		}
+		if filter == nil || filter.matches(record) {
+			records = append(records, record)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return records, nil
+}
+
+// DefaultPath returns the default audit log path, ~/.cofide/audit.log.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cofide", "audit.log"), nil
+}
+
+func currentUser() string {
+	if usr, err := user.Current(); err == nil && usr.Username != "" {
+		return usr.Username
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	return "unknown"
+}
+
+func commandLine(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return strings.Join(append([]string{filepath.Base(args[0])}, args[1:]...), " ")
+}
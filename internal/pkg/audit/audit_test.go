@@ -0,0 +1,94 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLog returns a Log in a temporary directory with a fixed clock that advances by an hour
+// on each record.
+func newTestLog(t *testing.T) *Log {
+	log := NewLog(filepath.Join(t.TempDir(), "audit", "audit.log"), []string{"/usr/bin/cofidectl", "trust-zone", "add", "tz1"})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	log.now = func() time.Time {
+		now = now.Add(time.Hour)
+		return now
+	}
+	return log
+}
+
+func TestLog_Append(t *testing.T) {
+	log := newTestLog(t)
+
+	require.NoError(t, log.Append(&Record{Operation: "add", EntityType: "trust zone", ID: "tz1-id", Name: "tz1"}))
+	require.NoError(t, log.Append(&Record{Operation: "up", Statuses: []*Status{{Stage: "Deploying", Message: "Done"}}}))
+
+	info, err := os.Stat(log.Path())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	records, err := Read(log.Path(), nil)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "cofidectl trust-zone add tz1", records[0].Command)
+	assert.NotEmpty(t, records[0].User)
+	assert.Equal(t, time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC), records[0].Time)
+	assert.Equal(t, "tz1", records[0].Name)
+	assert.Equal(t, []*Status{{Stage: "Deploying", Message: "Done"}}, records[1].Statuses)
+}
+
+func TestRead(t *testing.T) {
+	log := newTestLog(t)
+	for _, record := range []*Record{
+		{Operation: "add", EntityType: "trust zone", ID: "tz1-id", Name: "tz1"},
+		{Operation: "add", EntityType: "cluster", ID: "local1-id", Name: "local1"},
+		{Operation: "delete", EntityType: "trust zone", ID: "tz1-id", Name: "tz1"},
+		{Operation: "up"},
+	} {
+		require.NoError(t, log.Append(record))
+	}
+
+	tests := []struct {
+		name    string
+		filter  *Filter
+		wantOps []string
+	}{
+		{name: "no filter", filter: &Filter{}, wantOps: []string{"add", "add", "delete", "up"}},
+		{name: "operation", filter: &Filter{Operation: "add"}, wantOps: []string{"add", "add"}},
+		{name: "entity type", filter: &Filter{EntityType: "trust zone"}, wantOps: []string{"add", "delete"}},
+		{name: "name", filter: &Filter{ID: "local1"}, wantOps: []string{"add"}},
+		{name: "id", filter: &Filter{ID: "tz1-id", Operation: "delete"}, wantOps: []string{"delete"}},
+		{name: "since", filter: &Filter{Since: time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC)}, wantOps: []string{"delete", "up"}},
+		{name: "user", filter: &Filter{User: "nobody-at-all"}, wantOps: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := Read(log.Path(), tt.filter)
+			require.NoError(t, err)
+			ops := []string{}
+			for _, record := range records {
+				ops = append(ops, record.Operation)
+			}
+			assert.Equal(t, tt.wantOps, ops)
+		})
+	}
+}
+
+func TestRead_errors(t *testing.T) {
+	records, err := Read(filepath.Join(t.TempDir(), "missing.log"), nil)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte("{}\nnot json\n"), 0600))
+	_, err = Read(path, nil)
+	assert.ErrorContains(t, err, "invalid audit record at "+path+":2")
+}
@@ -0,0 +1,318 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DataSource is a datasource.DataSourceV2 decorator that records each mutating call in an audit
+// log. Calls that read data are passed through unchanged.
+type DataSource struct {
+	datasource.DataSourceV2
+	log *Log
+	// pending collects records within a transaction, which are appended to the log on commit.
+	pending *[]*Record
+}
+
+var _ datasource.DataSourceV2 = (*DataSource)(nil)
+var _ datasource.TransactorV2 = (*DataSource)(nil)
+var _ plugin.CapabilityReporter = (*DataSource)(nil)
+
+// NewDataSource returns a DataSource that records mutations of ds in log.
+func NewDataSource(ds datasource.DataSourceV2, log *Log) *DataSource {
+	return &DataSource{DataSourceV2: ds, log: log}
+}
+
+// record appends a record of a mutation to the log, or to the pending records of a transaction.
+// Failure to write the log is reported but does not fail the mutation, which has already been
+// applied.
+func (a *DataSource) record(operation, entityType, id, name string, before, after proto.Message, err error) {
+	record := &Record{
+		Operation:     operation,
+		EntityType:    entityType,
+		ID:            id,
+		Name:          name,
+		Before:        marshalEntity(before),
+		After:         marshalEntity(after),
+		ChangedFields: changedFields(before, after),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if a.pending != nil {
+		*a.pending = append(*a.pending, record)
+		return
+	}
+	if err := a.log.Append(record); err != nil {
+		slog.Error("Failed to write audit record", "error", err)
+	}
+}
+
+func (a *DataSource) AddTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	created, err := a.DataSourceV2.AddTrustZone(ctx, trustZone)
+	a.record(config.OperationAdd, config.EntityTrustZone, created.GetId(), trustZone.GetName(), nil, created, err)
+	return created, err
+}
+
+// DestroyTrustZone destroys a trust zone. The data source also removes the attestation policy
+// bindings and federations that reference the trust zone, so their deletion is recorded too.
+func (a *DataSource) DestroyTrustZone(ctx context.Context, id string) error {
+	before, _ := a.GetTrustZone(ctx, id)
+	bindings := a.listTrustZoneAPBindings(ctx, id)
+	federations := a.listTrustZoneFederations(ctx, id)
+	err := a.DataSourceV2.DestroyTrustZone(ctx, id)
+	if err == nil {
+		for _, binding := range bindings {
+			a.record(config.OperationDelete, config.EntityAPBinding, binding.GetId(), "", binding, nil, nil)
+		}
+		for _, federation := range federations {
+			a.record(config.OperationDelete, config.EntityFederation, federation.GetId(), "", federation, nil, nil)
+		}
+	}
+	a.record(config.OperationDelete, config.EntityTrustZone, id, before.GetName(), before, nil, err)
+	return err
+}
+
+func (a *DataSource) UpdateTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	before, _ := a.GetTrustZone(ctx, trustZone.GetId())
+	updated, err := a.DataSourceV2.UpdateTrustZone(ctx, trustZone)
+	a.record(config.OperationUpdate, config.EntityTrustZone, trustZone.GetId(), trustZone.GetName(), before, updated, err)
+	return updated, err
+}
+
+func (a *DataSource) AddCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	created, err := a.DataSourceV2.AddCluster(ctx, cluster)
+	a.record(config.OperationAdd, config.EntityCluster, created.GetId(), cluster.GetName(), nil, created, err)
+	return created, err
+}
+
+func (a *DataSource) DestroyCluster(ctx context.Context, id string) error {
+	before, _ := a.GetCluster(ctx, id)
+	err := a.DataSourceV2.DestroyCluster(ctx, id)
+	a.record(config.OperationDelete, config.EntityCluster, id, before.GetName(), before, nil, err)
+	return err
+}
+
+func (a *DataSource) UpdateCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	before, _ := a.GetCluster(ctx, cluster.GetId())
+	updated, err := a.DataSourceV2.UpdateCluster(ctx, cluster)
+	a.record(config.OperationUpdate, config.EntityCluster, cluster.GetId(), cluster.GetName(), before, updated, err)
+	return updated, err
+}
+
+func (a *DataSource) AddAttestationPolicy(ctx context.Context, policy *attestation_policy_proto.AttestationPolicy) (*attestation_policy_proto.AttestationPolicy, error) {
+	created, err := a.DataSourceV2.AddAttestationPolicy(ctx, policy)
+	a.record(config.OperationAdd, config.EntityAttestationPolicy, created.GetId(), policy.GetName(), nil, created, err)
+	return created, err
+}
+
+func (a *DataSource) DestroyAttestationPolicy(ctx context.Context, id string) error {
+	before, _ := a.GetAttestationPolicy(ctx, id)
+	err := a.DataSourceV2.DestroyAttestationPolicy(ctx, id)
+	a.record(config.OperationDelete, config.EntityAttestationPolicy, id, before.GetName(), before, nil, err)
+	return err
+}
+
+func (a *DataSource) AddAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	created, err := a.DataSourceV2.AddAPBinding(ctx, binding)
+	a.record(config.OperationAdd, config.EntityAPBinding, created.GetId(), "", nil, created, err)
+	return created, err
+}
+
+func (a *DataSource) DestroyAPBinding(ctx context.Context, id string) error {
+	before := a.getAPBinding(ctx, id)
+	err := a.DataSourceV2.DestroyAPBinding(ctx, id)
+	a.record(config.OperationDelete, config.EntityAPBinding, id, "", before, nil, err)
+	return err
+}
+
+func (a *DataSource) UpdateAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	before := a.getAPBinding(ctx, binding.GetId())
+	updated, err := a.DataSourceV2.UpdateAPBinding(ctx, binding)
+	a.record(config.OperationUpdate, config.EntityAPBinding, binding.GetId(), "", before, updated, err)
+	return updated, err
+}
+
+func (a *DataSource) AddFederation(ctx context.Context, federation *federation_proto.Federation) (*federation_proto.Federation, error) {
+	created, err := a.DataSourceV2.AddFederation(ctx, federation)
+	a.record(config.OperationAdd, config.EntityFederation, created.GetId(), "", nil, created, err)
+	return created, err
+}
+
+func (a *DataSource) DestroyFederation(ctx context.Context, id string) error {
+	before := a.getFederation(ctx, id)
+	err := a.DataSourceV2.DestroyFederation(ctx, id)
+	a.record(config.OperationDelete, config.EntityFederation, id, "", before, nil, err)
+	return err
+}
+
+// getAPBinding returns the binding with the specified ID, or nil if it cannot be found.
+func (a *DataSource) getAPBinding(ctx context.Context, id string) *ap_binding_proto.APBinding {
+	bindings, err := a.ListAPBindings(ctx, &datasourcepb.ListAPBindingsRequest_Filter{})
+	if err != nil {
+		return nil
+	}
+	i := slices.IndexFunc(bindings, func(b *ap_binding_proto.APBinding) bool { return b.GetId() == id })
+	if i < 0 {
+		return nil
+	}
+	return bindings[i]
+}
+
+// getFederation returns the federation with the specified ID, or nil if it cannot be found.
+func (a *DataSource) getFederation(ctx context.Context, id string) *federation_proto.Federation {
+	federations, err := a.ListFederations(ctx, &datasourcepb.ListFederationsRequest_Filter{})
+	if err != nil {
+		return nil
+	}
+	i := slices.IndexFunc(federations, func(f *federation_proto.Federation) bool { return f.GetId() == id })
+	if i < 0 {
+		return nil
+	}
+	return federations[i]
+}
+
+// listTrustZoneAPBindings returns the bindings in the trust zone with the specified ID, or nil if
+// they cannot be listed.
+func (a *DataSource) listTrustZoneAPBindings(ctx context.Context, trustZoneID string) []*ap_binding_proto.APBinding {
+	bindings, err := a.ListAPBindings(ctx, &datasourcepb.ListAPBindingsRequest_Filter{})
+	if err != nil {
+		return nil
+	}
+	return slices.DeleteFunc(bindings, func(b *ap_binding_proto.APBinding) bool { return b.GetTrustZoneId() != trustZoneID })
+}
+
+// listTrustZoneFederations returns the federations from or to the trust zone with the specified
+// ID, or nil if they cannot be listed.
+func (a *DataSource) listTrustZoneFederations(ctx context.Context, trustZoneID string) []*federation_proto.Federation {
+	federations, err := a.ListFederations(ctx, &datasourcepb.ListFederationsRequest_Filter{})
+	if err != nil {
+		return nil
+	}
+	return slices.DeleteFunc(federations, func(f *federation_proto.Federation) bool {
+		return f.GetTrustZoneId() != trustZoneID && f.GetRemoteTrustZoneId() != trustZoneID
+	})
+}
+
+// Begin starts a transaction on the underlying data source. Mutations in the transaction are
+// recorded when it is committed.
+func (a *DataSource) Begin(ctx context.Context) (datasource.TransactionV2, error) {
+	transactor, ok := a.DataSourceV2.(datasource.TransactorV2)
+	if !ok {
+		return nil, datasource.ErrTransactionsNotSupported
+	}
+	tx, err := transactor.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{
+		DataSource: &DataSource{DataSourceV2: tx, log: a.log, pending: &[]*Record{}},
+		tx:         tx,
+	}, nil
+}
+
+// Capabilities implements plugin.CapabilityReporter, returning the capabilities of the underlying
+// data source.
+func (a *DataSource) Capabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	return datasource.GetCapabilities(ctx, a.DataSourceV2)
+}
+
+// transaction is a datasource.TransactionV2 that records its mutations when committed.
+type transaction struct {
+	*DataSource
+	tx datasource.TransactionV2
+}
+
+func (t *transaction) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	for _, record := range *t.pending {
+		if err := t.log.Append(record); err != nil {
+			slog.Error("Failed to write audit record", "error", err)
+		}
+	}
+	*t.pending = nil
+	return nil
+}
+
+func (t *transaction) Abort(ctx context.Context) error {
+	*t.pending = nil
+	return t.tx.Abort(ctx)
+}
+
+// marshalEntity returns the JSON representation of an entity, or nil if it is unset.
+func marshalEntity(m proto.Message) json.RawMessage {
+	if !isSet(m) {
+		return nil
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	// protojson output is not stable, so normalise it through encoding/json.
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+	normalised, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return normalised
+}
+
+// changedFields returns the names of the top-level fields that differ between before and after.
+// If either is unset, the fields set in the other are returned.
+func changedFields(before, after proto.Message) []string {
+	if !isSet(before) && !isSet(after) {
+		return nil
+	}
+	var beforeReflect, afterReflect protoreflect.Message
+	if isSet(before) {
+		beforeReflect = before.ProtoReflect()
+	}
+	if isSet(after) {
+		afterReflect = after.ProtoReflect()
+	}
+	descriptor := afterReflect
+	if descriptor == nil {
+		descriptor = beforeReflect
+	}
+
+	changed := []string{}
+	fields := descriptor.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		beforeHas := beforeReflect != nil && beforeReflect.Has(fd)
+		afterHas := afterReflect != nil && afterReflect.Has(fd)
+		if beforeHas != afterHas || (beforeHas && !beforeReflect.Get(fd).Equal(afterReflect.Get(fd))) {
+			changed = append(changed, string(fd.Name()))
+		}
+	}
+	return changed
+}
+
+// isSet returns whether m is a non-nil message.
+func isSet(m proto.Message) bool {
+	return m != nil && m.ProtoReflect().IsValid()
+}
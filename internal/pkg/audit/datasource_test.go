@@ -0,0 +1,170 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDataSource(t *testing.T) (*DataSource, *Log) {
+	cfg := &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+			fixtures.TrustZone("tz2"),
+		},
+		Clusters: []*clusterpb.Cluster{
+			fixtures.Cluster("local1"),
+		},
+		AttestationPolicies: []*attestation_policy_proto.AttestationPolicy{
+			fixtures.AttestationPolicy("ap1"),
+		},
+		APBindings: []*ap_binding_proto.APBinding{
+			fixtures.APBinding("apb1"),
+		},
+		Federations: []*federation_proto.Federation{
+			fixtures.Federation("fed1"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+	loader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	lds, err := local.NewLocalDataSource(loader)
+	require.NoError(t, err)
+	log := newTestLog(t)
+	return NewDataSource(datasource.FromV1(lds), log), log
+}
+
+func readRecords(t *testing.T, log *Log) []*Record {
+	records, err := Read(log.Path(), nil)
+	require.NoError(t, err)
+	return records
+}
+
+func TestDataSource_records(t *testing.T) {
+	ds, log := newTestDataSource(t)
+	ctx := context.Background()
+
+	// Reads are not recorded.
+	_, err := ds.ListTrustZones(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, readRecords(t, log))
+
+	tz := fixtures.TrustZone("tz3")
+	tz.Id = nil
+	created, err := ds.AddTrustZone(ctx, tz)
+	require.NoError(t, err)
+
+	cluster := fixtures.Cluster("local1")
+	cluster.ExtraHelmValues = nil
+	_, err = ds.UpdateCluster(ctx, cluster)
+	require.NoError(t, err)
+
+	require.NoError(t, ds.DestroyAPBinding(ctx, "apb1-id"))
+
+	err = ds.DestroyAttestationPolicy(ctx, "invalid-ap")
+	require.Error(t, err)
+
+	records := readRecords(t, log)
+	require.Len(t, records, 4)
+
+	assert.Equal(t, config.OperationAdd, records[0].Operation)
+	assert.Equal(t, config.EntityTrustZone, records[0].EntityType)
+	assert.Equal(t, created.GetId(), records[0].ID)
+	assert.Equal(t, "tz3", records[0].Name)
+	assert.Nil(t, records[0].Before)
+	assert.Contains(t, records[0].ChangedFields, "trust_domain")
+	after := map[string]any{}
+	require.NoError(t, json.Unmarshal(records[0].After, &after))
+	assert.Equal(t, "td3", after["trust_domain"])
+
+	assert.Equal(t, config.OperationUpdate, records[1].Operation)
+	assert.Equal(t, config.EntityCluster, records[1].EntityType)
+	assert.Equal(t, "local1-id", records[1].ID)
+	assert.Equal(t, []string{"extra_helm_values"}, records[1].ChangedFields)
+	assert.NotNil(t, records[1].Before)
+	assert.NotNil(t, records[1].After)
+
+	assert.Equal(t, config.OperationDelete, records[2].Operation)
+	assert.Equal(t, config.EntityAPBinding, records[2].EntityType)
+	assert.Equal(t, "apb1-id", records[2].ID)
+	assert.NotNil(t, records[2].Before)
+	assert.Nil(t, records[2].After)
+
+	assert.Equal(t, config.EntityAttestationPolicy, records[3].EntityType)
+	assert.Equal(t, "failed to find attestation policy invalid-ap in local config", records[3].Error)
+}
+
+func TestDataSource_transaction(t *testing.T) {
+	ds, log := newTestDataSource(t)
+	ctx := context.Background()
+
+	// Mutations in an aborted transaction are not recorded.
+	err := datasource.WithTransaction(ctx, ds, func(tx datasource.DataSourceV2) error {
+		if err := tx.DestroyCluster(ctx, "local1-id"); err != nil {
+			return err
+		}
+		return errors.New("fake error")
+	})
+	require.Error(t, err)
+	assert.Empty(t, readRecords(t, log))
+
+	// Mutations in a committed transaction are recorded on commit.
+	err = datasource.WithTransaction(ctx, ds, func(tx datasource.DataSourceV2) error {
+		if err := tx.DestroyCluster(ctx, "local1-id"); err != nil {
+			return err
+		}
+		assert.Empty(t, readRecords(t, log))
+		return tx.DestroyTrustZone(ctx, "tz1-id")
+	})
+	require.NoError(t, err)
+
+	records := readRecords(t, log)
+	require.Len(t, records, 4)
+	assert.Equal(t, "local1", records[0].Name)
+	assert.Equal(t, "tz1", records[3].Name)
+}
+
+func TestDataSource_DestroyTrustZone(t *testing.T) {
+	ds, log := newTestDataSource(t)
+	ctx := context.Background()
+
+	require.NoError(t, ds.DestroyCluster(ctx, "local1-id"))
+	require.NoError(t, ds.DestroyTrustZone(ctx, "tz1-id"))
+
+	// The bindings and federations removed with the trust zone are recorded.
+	records := readRecords(t, log)
+	require.Len(t, records, 4)
+	assert.Equal(t, config.OperationDelete, records[1].Operation)
+	assert.Equal(t, config.EntityAPBinding, records[1].EntityType)
+	assert.Equal(t, "apb1-id", records[1].ID)
+	assert.NotNil(t, records[1].Before)
+	assert.Equal(t, config.OperationDelete, records[2].Operation)
+	assert.Equal(t, config.EntityFederation, records[2].EntityType)
+	assert.Equal(t, "fed1-id", records[2].ID)
+	assert.NotNil(t, records[2].Before)
+	assert.Equal(t, config.EntityTrustZone, records[3].EntityType)
+	assert.Equal(t, "tz1-id", records[3].ID)
+
+	// Nothing else is recorded if the trust zone cannot be destroyed.
+	require.Error(t, ds.DestroyTrustZone(ctx, "invalid-tz"))
+	records = readRecords(t, log)
+	require.Len(t, records, 5)
+	assert.Equal(t, config.EntityTrustZone, records[4].EntityType)
+	assert.NotEmpty(t, records[4].Error)
+}
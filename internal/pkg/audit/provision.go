@@ -0,0 +1,74 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"log/slog"
+	"sync"
+
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+)
+
+// ProvisionRecorder records the outcome of an up or down operation in an audit log.
+// A nil ProvisionRecorder records nothing.
+type ProvisionRecorder struct {
+	log *Log
+	// mu protects record, which is updated by the Watch goroutine.
+	mu     sync.Mutex
+	record *Record
+}
+
+// NewProvisionRecorder returns a ProvisionRecorder for an up or down operation targeting the
+// specified trust zones, or all trust zones if none are specified.
+// If log is nil, a nil ProvisionRecorder is returned.
+func NewProvisionRecorder(log *Log, operation string, trustZones []string) *ProvisionRecorder {
+	if log == nil {
+		return nil
+	}
+	return &ProvisionRecorder{
+		log:    log,
+		record: &Record{Operation: operation, TrustZones: trustZones, Statuses: []*Status{}},
+	}
+}
+
+// Watch returns a channel that forwards the statuses from statusCh, collecting the outcomes of
+// completed stages for the audit record.
+func (pr *ProvisionRecorder) Watch(statusCh <-chan *provisionpb.Status) <-chan *provisionpb.Status {
+	if pr == nil {
+		return statusCh
+	}
+	forwardCh := make(chan *provisionpb.Status)
+	go func() {
+		defer close(forwardCh)
+		for status := range statusCh {
+			if status.GetDone() || status.GetError() != "" {
+				pr.mu.Lock()
+				pr.record.Statuses = append(pr.record.Statuses, &Status{
+					Stage:   status.GetStage(),
+					Message: status.GetMessage(),
+					Error:   status.GetError(),
+				})
+				pr.mu.Unlock()
+			}
+			forwardCh <- status
+		}
+	}()
+	return forwardCh
+}
+
+// Finish appends the record to the audit log, including err if the operation failed.
+// It should be called once the channel returned by Watch has been consumed.
+func (pr *ProvisionRecorder) Finish(err error) {
+	if pr == nil {
+		return
+	}
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	if err != nil {
+		pr.record.Error = err.Error()
+	}
+	if err := pr.log.Append(pr.record); err != nil {
+		slog.Error("Failed to write audit record", "error", err)
+	}
+}
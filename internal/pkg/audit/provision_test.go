@@ -0,0 +1,51 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"testing"
+
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvisionRecorder(t *testing.T) {
+	log := newTestLog(t)
+	recorder := NewProvisionRecorder(log, OperationUp, []string{"tz1"})
+
+	statusCh := make(chan *provisionpb.Status, 3)
+	statusCh <- provision.StatusOk("Installing", "Installing SPIRE")
+	statusCh <- provision.StatusDone("Installed", "Installation completed")
+	statusCh <- provision.StatusError("Waiting", "Failed waiting for SPIRE", errors.New("timed out"))
+	close(statusCh)
+
+	count := 0
+	for range recorder.Watch(statusCh) {
+		count++
+	}
+	assert.Equal(t, 3, count)
+	recorder.Finish(errors.New("timed out"))
+
+	records := readRecords(t, log)
+	require.Len(t, records, 1)
+	assert.Equal(t, OperationUp, records[0].Operation)
+	assert.Equal(t, []string{"tz1"}, records[0].TrustZones)
+	assert.Equal(t, []*Status{
+		{Stage: "Installed", Message: "Installation completed"},
+		{Stage: "Waiting", Message: "Failed waiting for SPIRE", Error: "timed out"},
+	}, records[0].Statuses)
+	assert.Equal(t, "timed out", records[0].Error)
+}
+
+func TestProvisionRecorder_nil(t *testing.T) {
+	recorder := NewProvisionRecorder(nil, OperationDown, nil)
+	assert.Nil(t, recorder)
+
+	statusCh := make(chan *provisionpb.Status)
+	assert.Equal(t, (<-chan *provisionpb.Status)(statusCh), recorder.Watch(statusCh))
+	recorder.Finish(nil)
+}
@@ -4,6 +4,10 @@
 package config
 
 import (
+	"bytes"
+	"fmt"
+	"slices"
+
 	"buf.build/go/protoyaml"
 	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
 	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
@@ -12,7 +16,9 @@ import (
 	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
 	pluginspb "github.com/cofide/cofidectl-sdk/gen/go/proto/plugins/v1alpha1"
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
 )
 
 // Config describes the cofide.yaml configuration file format.
@@ -24,8 +30,15 @@ type Config struct {
 	Federations         []*federation_proto.Federation
 	PluginConfig        map[string]*structpb.Struct
 	Plugins             *pluginspb.Plugins
+	// PluginPath lists directories to search for plugins, from the plugins.path setting.
+	PluginPath []string
 }
 
+// pluginPathKey is the key of the plugins.path setting within the plugins mapping. The setting is
+// not part of the config proto, so it is extracted from the YAML before unmarshalling and
+// inserted after marshalling.
+const pluginPathKey = "path"
+
 func NewConfig() *Config {
 	return &Config{
 		TrustZones:          []*trust_zone_proto.TrustZone{},
@@ -66,15 +79,33 @@ func (c *Config) toProto() *config_proto.Config {
 	}
 }
 
+// Clone returns a deep copy of the Config.
+func (c *Config) Clone() *Config {
+	clone := newConfigFromProto(proto.Clone(c.toProto()).(*config_proto.Config))
+	if clone.PluginConfig == nil {
+		clone.PluginConfig = map[string]*structpb.Struct{}
+	}
+	clone.PluginPath = slices.Clone(c.PluginPath)
+	return clone
+}
+
 func (c *Config) marshalYAML() ([]byte, error) {
 	// Convert the Config to the config_proto.Config message to allow marshalling with protoyaml.
 	proto := c.toProto()
 	options := protoyaml.MarshalOptions{UseProtoNames: true}
-	return options.Marshal(proto)
-
+	data, err := options.Marshal(proto)
+	if err != nil || len(c.PluginPath) == 0 {
+		return data, err
+	}
+	return insertPluginPath(data, c.PluginPath)
 }
 
 func unmarshalYAML(data []byte) (*Config, error) {
+	data, pluginPath, err := extractPluginPath(data)
+	if err != nil {
+		return nil, err
+	}
+
 	proto := config_proto.Config{
 		TrustZones:          []*trust_zone_proto.TrustZone{},
 		Clusters:            []*clusterpb.Cluster{},
@@ -83,11 +114,91 @@ func unmarshalYAML(data []byte) (*Config, error) {
 		Federations:         []*federation_proto.Federation{},
 		PluginConfig:        map[string]*structpb.Struct{},
 	}
-	err := protoyaml.Unmarshal(data, &proto)
+	err = protoyaml.Unmarshal(data, &proto)
 	if err != nil {
 		return nil, err
 	}
-	return newConfigFromProto(&proto), nil
+	config := newConfigFromProto(&proto)
+	config.PluginPath = pluginPath
+	return config, nil
+}
+
+// extractPluginPath removes the plugins.path setting from YAML-encoded config, returning the
+// remaining YAML and the setting. If the setting is absent, data is returned unchanged.
+func extractPluginPath(data []byte) ([]byte, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	plugins := pluginsNode(&doc)
+	if plugins == nil {
+		return data, nil, nil
+	}
+
+	for i := 0; i+1 < len(plugins.Content); i += 2 {
+		if plugins.Content[i].Value != pluginPathKey {
+			continue
+		}
+		var pluginPath []string
+		if err := plugins.Content[i+1].Decode(&pluginPath); err != nil {
+			return nil, nil, fmt.Errorf("invalid plugins.path setting: %w", err)
+		}
+		plugins.Content = slices.Delete(plugins.Content, i, i+2)
+		data, err := encodeYAML(&doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		return data, pluginPath, nil
+	}
+	return data, nil, nil
+}
+
+// insertPluginPath adds the plugins.path setting to YAML-encoded config.
+func insertPluginPath(data []byte, pluginPath []string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	plugins := pluginsNode(&doc)
+	if plugins == nil {
+		return nil, fmt.Errorf("cannot set plugins.path: config has no plugins")
+	}
+
+	var value yaml.Node
+	if err := value.Encode(pluginPath); err != nil {
+		return nil, err
+	}
+	plugins.Style = 0
+	plugins.Content = append(plugins.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: pluginPathKey}, &value)
+	return encodeYAML(&doc)
+}
+
+// pluginsNode returns the plugins mapping node of a YAML config document, or nil if it has none.
+func pluginsNode(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "plugins" && root.Content[i+1].Kind == yaml.MappingNode {
+			return root.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func encodeYAML(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	// Match the four space indentation of protoyaml.
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(4)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
 func (c *Config) GetTrustZoneByName(name string) (*trust_zone_proto.TrustZone, bool) {
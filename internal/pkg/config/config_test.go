@@ -71,6 +71,17 @@ func TestConfig_YAMLMarshall(t *testing.T) {
 			},
 			wantFile: "full.yaml",
 		},
+		{
+			name: "plugin path",
+			config: &Config{
+				TrustZones: []*trust_zone_proto.TrustZone{
+					fixtures.TrustZone("tz1"),
+				},
+				Plugins:    fixtures.Plugins("plugins1"),
+				PluginPath: []string{"plugins", "/opt/cofidectl/plugins"},
+			},
+			wantFile: "plugin_path.yaml",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -143,6 +154,22 @@ func TestConfig_YAMLUnmarshall(t *testing.T) {
 				Plugins: fixtures.Plugins("plugins1"),
 			},
 		},
+		{
+			name: "plugin path",
+			file: "plugin_path.yaml",
+			want: &Config{
+				TrustZones: []*trust_zone_proto.TrustZone{
+					fixtures.TrustZone("tz1"),
+				},
+				Clusters:            []*clusterpb.Cluster{},
+				AttestationPolicies: []*attestation_policy_proto.AttestationPolicy{},
+				APBindings:          []*ap_binding_proto.APBinding{},
+				Federations:         []*federation_proto.Federation{},
+				PluginConfig:        map[string]*structpb.Struct{},
+				Plugins:             fixtures.Plugins("plugins1"),
+				PluginPath:          []string{"plugins", "/opt/cofidectl/plugins"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
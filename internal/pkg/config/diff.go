@@ -0,0 +1,197 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Change operations.
+const (
+	OperationAdd    = "add"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// Change entity types.
+const (
+	EntityTrustZone         = "trust zone"
+	EntityCluster           = "cluster"
+	EntityAttestationPolicy = "attestation policy"
+	EntityAPBinding         = "attestation policy binding"
+	EntityFederation        = "federation"
+	EntityPlugins           = "plugins"
+	EntityPluginConfig      = "plugin config"
+)
+
+// Change describes a single mutation of an entity between two versions of a Config.
+type Change struct {
+	Operation  string
+	EntityType string
+	ID         string
+	// Description is a human-readable summary of the change, e.g.
+	// "add cluster prod-eu to trust zone prod".
+	Description string
+}
+
+// Diff returns the changes required to transform old into new.
+// Entities are matched by ID. A nil old Config is treated as empty.
+func Diff(old, new *Config) []Change {
+	if old == nil {
+		old = NewConfig()
+	}
+	d := &differ{old: old, new: new}
+
+	d.diffTrustZones()
+	d.diffClusters()
+	d.diffAttestationPolicies()
+	d.diffAPBindings()
+	d.diffFederations()
+	d.diffPlugins()
+	return d.changes
+}
+
+type differ struct {
+	old, new *Config
+	changes  []Change
+}
+
+func (d *differ) add(operation, entityType, id, format string, args ...any) {
+	d.changes = append(d.changes, Change{
+		Operation:   operation,
+		EntityType:  entityType,
+		ID:          id,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// trustZoneName returns the name of a trust zone in either config, or the ID if not found.
+func (d *differ) trustZoneName(id string) string {
+	if tz, ok := d.new.GetTrustZoneByID(id); ok {
+		return tz.GetName()
+	}
+	if tz, ok := d.old.GetTrustZoneByID(id); ok {
+		return tz.GetName()
+	}
+	return id
+}
+
+// policyName returns the name of an attestation policy in either config, or the ID if not found.
+func (d *differ) policyName(id string) string {
+	if ap, ok := d.new.GetAttestationPolicyByID(id); ok {
+		return ap.GetName()
+	}
+	if ap, ok := d.old.GetAttestationPolicyByID(id); ok {
+		return ap.GetName()
+	}
+	return id
+}
+
+func (d *differ) diffTrustZones() {
+	diffEntities(d.old.TrustZones, d.new.TrustZones, (*trust_zone_proto.TrustZone).GetId,
+		func(op string, tz *trust_zone_proto.TrustZone) {
+			d.add(op, EntityTrustZone, tz.GetId(), "%s trust zone %s", op, tz.GetName())
+		})
+}
+
+func (d *differ) diffClusters() {
+	diffEntities(d.old.Clusters, d.new.Clusters, (*clusterpb.Cluster).GetId,
+		func(op string, cluster *clusterpb.Cluster) {
+			preposition := map[string]string{OperationAdd: "to", OperationUpdate: "in", OperationDelete: "from"}[op]
+			d.add(op, EntityCluster, cluster.GetId(), "%s cluster %s %s trust zone %s",
+				op, cluster.GetName(), preposition, d.trustZoneName(cluster.GetTrustZoneId()))
+		})
+}
+
+func (d *differ) diffAttestationPolicies() {
+	diffEntities(d.old.AttestationPolicies, d.new.AttestationPolicies, (*attestation_policy_proto.AttestationPolicy).GetId,
+		func(op string, policy *attestation_policy_proto.AttestationPolicy) {
+			d.add(op, EntityAttestationPolicy, policy.GetId(), "%s attestation policy %s", op, policy.GetName())
+		})
+}
+
+func (d *differ) diffAPBindings() {
+	diffEntities(d.old.APBindings, d.new.APBindings, (*ap_binding_proto.APBinding).GetId,
+		func(op string, binding *ap_binding_proto.APBinding) {
+			policy := d.policyName(binding.GetPolicyId())
+			tz := d.trustZoneName(binding.GetTrustZoneId())
+			switch op {
+			case OperationAdd:
+				d.add(op, EntityAPBinding, binding.GetId(), "bind attestation policy %s to trust zone %s", policy, tz)
+			case OperationUpdate:
+				d.add(op, EntityAPBinding, binding.GetId(), "update binding of attestation policy %s to trust zone %s", policy, tz)
+			case OperationDelete:
+				d.add(op, EntityAPBinding, binding.GetId(), "unbind attestation policy %s from trust zone %s", policy, tz)
+			}
+		})
+}
+
+func (d *differ) diffFederations() {
+	diffEntities(d.old.Federations, d.new.Federations, (*federation_proto.Federation).GetId,
+		func(op string, federation *federation_proto.Federation) {
+			from := d.trustZoneName(federation.GetTrustZoneId())
+			to := d.trustZoneName(federation.GetRemoteTrustZoneId())
+			switch op {
+			case OperationAdd:
+				d.add(op, EntityFederation, federation.GetId(), "federate trust zone %s with %s", from, to)
+			case OperationUpdate:
+				d.add(op, EntityFederation, federation.GetId(), "update federation of trust zone %s with %s", from, to)
+			case OperationDelete:
+				d.add(op, EntityFederation, federation.GetId(), "delete federation of trust zone %s with %s", from, to)
+			}
+		})
+}
+
+func (d *differ) diffPlugins() {
+	if !proto.Equal(d.old.Plugins, d.new.Plugins) || !slices.Equal(d.old.PluginPath, d.new.PluginPath) {
+		d.add(OperationUpdate, EntityPlugins, "", "update plugins")
+	}
+
+	names := slices.Sorted(maps.Keys(d.new.PluginConfig))
+	for _, name := range names {
+		if oldConfig, ok := d.old.PluginConfig[name]; !ok {
+			d.add(OperationAdd, EntityPluginConfig, name, "add plugin config for %s", name)
+		} else if !proto.Equal(oldConfig, d.new.PluginConfig[name]) {
+			d.add(OperationUpdate, EntityPluginConfig, name, "update plugin config for %s", name)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(d.old.PluginConfig)) {
+		if _, ok := d.new.PluginConfig[name]; !ok {
+			d.add(OperationDelete, EntityPluginConfig, name, "delete plugin config for %s", name)
+		}
+	}
+}
+
+// diffEntities calls fn for each entity that has been added, updated or deleted between old and
+// new, matching entities by ID. Added and updated entities are visited in the order of new,
+// followed by deleted entities in the order of old.
+func diffEntities[T proto.Message](old, new []T, id func(T) string, fn func(op string, entity T)) {
+	oldByID := make(map[string]T, len(old))
+	for _, entity := range old {
+		oldByID[id(entity)] = entity
+	}
+	newIDs := make(map[string]bool, len(new))
+	for _, entity := range new {
+		newIDs[id(entity)] = true
+		if oldEntity, ok := oldByID[id(entity)]; !ok {
+			fn(OperationAdd, entity)
+		} else if !proto.Equal(oldEntity, entity) {
+			fn(OperationUpdate, entity)
+		}
+	}
+	for _, entity := range old {
+		if !newIDs[id(entity)] {
+			fn(OperationDelete, entity)
+		}
+	}
+}
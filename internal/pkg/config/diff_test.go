@@ -0,0 +1,122 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDiff(t *testing.T) {
+	base := func() *Config {
+		cfg := NewConfig()
+		cfg.TrustZones = []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1"), fixtures.TrustZone("tz2")}
+		cfg.Clusters = []*clusterpb.Cluster{fixtures.Cluster("local1")}
+		cfg.AttestationPolicies = []*attestation_policy_proto.AttestationPolicy{fixtures.AttestationPolicy("ap1")}
+		cfg.APBindings = []*ap_binding_proto.APBinding{fixtures.APBinding("apb1")}
+		cfg.Federations = []*federation_proto.Federation{fixtures.Federation("fed1")}
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		old    *Config
+		mutate func(*Config)
+		want   []string
+	}{
+		{
+			name:   "no changes",
+			old:    base(),
+			mutate: func(c *Config) {},
+			want:   nil,
+		},
+		{
+			name: "nil old",
+			old:  nil,
+			mutate: func(c *Config) {
+				c.Clusters = nil
+				c.APBindings = nil
+				c.Federations = nil
+				c.AttestationPolicies = nil
+			},
+			want: []string{"add trust zone tz1", "add trust zone tz2"},
+		},
+		{
+			name: "add cluster",
+			old:  base(),
+			mutate: func(c *Config) {
+				c.Clusters = append(c.Clusters, fixtures.Cluster("local2"))
+			},
+			want: []string{"add cluster local2 to trust zone tz2"},
+		},
+		{
+			name: "update trust zone",
+			old:  base(),
+			mutate: func(c *Config) {
+				c.TrustZones[0].BundleEndpointUrl = fixtures.StringPtr("127.0.0.10")
+			},
+			want: []string{"update trust zone tz1"},
+		},
+		{
+			name: "delete trust zone cascade",
+			old:  base(),
+			mutate: func(c *Config) {
+				c.TrustZones = c.TrustZones[1:]
+				c.Clusters = nil
+				c.APBindings = nil
+				c.Federations = nil
+			},
+			want: []string{
+				"delete trust zone tz1",
+				"delete cluster local1 from trust zone tz1",
+				"unbind attestation policy ap1 from trust zone tz1",
+				"delete federation of trust zone tz1 with tz2",
+			},
+		},
+		{
+			name: "bind and federate",
+			old: func() *Config {
+				c := base()
+				c.APBindings = nil
+				c.Federations = nil
+				return c
+			}(),
+			mutate: func(c *Config) {},
+			want: []string{
+				"bind attestation policy ap1 to trust zone tz1",
+				"federate trust zone tz1 with tz2",
+			},
+		},
+		{
+			name: "plugins",
+			old:  base(),
+			mutate: func(c *Config) {
+				c.Plugins = fixtures.Plugins("plugins1")
+				c.PluginConfig = map[string]*structpb.Struct{"plugin1": fixtures.PluginConfig("plugin1")}
+			},
+			want: []string{"update plugins", "add plugin config for plugin1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			new := base()
+			tt.mutate(new)
+
+			var got []string
+			for _, change := range Diff(tt.old, new) {
+				got = append(got, change.Description)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// GitURIPrefix is the config location prefix used to select a GitLoader, e.g. git:cofide.yaml.
+const GitURIPrefix = "git:"
+
+// Revision describes a commit in the history of a GitLoader config file.
+type Revision struct {
+	Hash    string
+	Author  string
+	Time    time.Time
+	Message string
+}
+
+// HistoryLoader is a Loader that records a history of revisions of the config.
+type HistoryLoader interface {
+	Loader
+
+	// History returns the revisions of the config, most recent first.
+	History() ([]*Revision, error)
+	// Revert restores the config to its state at the specified revision, recording the
+	// restoration as a new revision.
+	Revert(rev string) error
+}
+
+// GitLoader implements the `HistoryLoader` interface by reading and writing to a file in a local
+// Git repository, creating a commit for each Write that changes the config.
+// The repository is initialised on the first Write if the file is not already in a repository.
+// The git binary must be available on the PATH. Nothing is pushed to remotes.
+type GitLoader struct {
+	fileLoader *FileLoader
+	dir        string
+	fileName   string
+}
+
+var _ HistoryLoader = (*GitLoader)(nil)
+var _ DirLoader = (*GitLoader)(nil)
+
+func NewGitLoader(filePath string) *GitLoader {
+	return &GitLoader{
+		fileLoader: NewFileLoader(filePath),
+		dir:        filepath.Dir(filePath),
+		fileName:   filepath.Base(filePath),
+	}
+}
+
+// IsGitURI returns whether the config location has the git: prefix.
+func IsGitURI(location string) bool {
+	return strings.HasPrefix(location, GitURIPrefix)
+}
+
+// NewGitLoaderFromURI returns a GitLoader for a location of the form git:PATH.
+func NewGitLoaderFromURI(uri string) (*GitLoader, error) {
+	filePath := strings.TrimPrefix(uri, GitURIPrefix)
+	if filePath == "" {
+		return nil, fmt.Errorf("invalid Git config location %s: expected git:PATH", uri)
+	}
+	return NewGitLoader(filePath), nil
+}
+
+// Dir returns the directory containing the config file.
+func (gl *GitLoader) Dir() string {
+	return gl.dir
+}
+
+func (gl *GitLoader) Exists() (bool, error) {
+	return gl.fileLoader.Exists()
+}
+
+func (gl *GitLoader) Read() (*Config, error) {
+	return gl.fileLoader.Read()
+}
+
+// Write writes the config to the file and commits it. The commit message describes the changes
+// relative to the previous contents of the file.
+func (gl *GitLoader) Write(config *Config) error {
+	var previous *Config
+	if exists, err := gl.fileLoader.Exists(); err != nil {
+		return err
+	} else if exists {
+		// Ignore errors reading the previous config, e.g. if it is invalid.
+		previous, _ = gl.fileLoader.Read()
+	}
+
+	changes := Diff(previous, config)
+	if previous != nil && len(changes) == 0 {
+		return nil
+	}
+	return gl.commit(config, commitMessage(changes))
+}
+
+func (gl *GitLoader) History() ([]*Revision, error) {
+	if ok, err := gl.isRepo(); err != nil {
+		return nil, err
+	} else if !ok {
+		return []*Revision{}, nil
+	}
+
+	out, err := gl.git("log", "--format=%H%x1f%an%x1f%aI%x1f%B%x1e", "--", gl.fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := []*Revision{}
+	for _, record := range strings.Split(out, "\x1e") {
+		record = strings.TrimSpace(record)
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, "\x1f", 4)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("unexpected git log output: %q", record)
+		}
+		commitTime, err := time.Parse(time.RFC3339, fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("unexpected git log time %s: %w", fields[2], err)
+		}
+		revisions = append(revisions, &Revision{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Time:    commitTime,
+			Message: strings.TrimSpace(fields[3]),
+		})
+	}
+	return revisions, nil
+}
+
+func (gl *GitLoader) Revert(rev string) error {
+	hash, err := gl.git("rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	if err != nil {
+		return fmt.Errorf("unknown config revision %s", rev)
+	}
+	hash = strings.TrimSpace(hash)
+
+	data, err := gl.git("show", fmt.Sprintf("%s:./%s", hash, gl.fileName))
+	if err != nil {
+		return fmt.Errorf("config file %s does not exist at revision %s", gl.fileName, rev)
+	}
+
+	config, err := validatedRead([]byte(data), NewValidator())
+	if err != nil {
+		return fmt.Errorf("config at revision %s is invalid: %w", rev, err)
+	}
+
+	return gl.commit(config, fmt.Sprintf("revert configuration to %s", ShortHash(hash)))
+}
+
+// commit writes the config to the file and commits it with the specified message.
+func (gl *GitLoader) commit(config *Config, message string) error {
+	if ok, err := gl.isRepo(); err != nil {
+		return err
+	} else if !ok {
+		if _, err := gl.git("init", "--quiet"); err != nil {
+			return err
+		}
+	}
+
+	if err := gl.fileLoader.Write(config); err != nil {
+		return err
+	}
+
+	if _, err := gl.git("add", "--", gl.fileName); err != nil {
+		return err
+	}
+
+	// Nothing to commit if the file is unchanged, e.g. when reverting to the current state.
+	if _, err := gl.git("diff", "--cached", "--quiet", "--", gl.fileName); err == nil {
+		return nil
+	}
+
+	if _, err := gl.runGit(gl.identityEnv(), "commit", "--quiet", "--message", message, "--", gl.fileName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// isRepo returns whether the config file's directory is within a Git work tree.
+func (gl *GitLoader) isRepo() (bool, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return false, fmt.Errorf("git is required for a Git-backed config: %w", err)
+	}
+	if _, err := os.Stat(gl.dir); errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	_, err := gl.git("rev-parse", "--is-inside-work-tree")
+	return err == nil, nil
+}
+
+// git runs a git command in the config file's directory, returning its standard output.
+func (gl *GitLoader) git(args ...string) (string, error) {
+	return gl.runGit(nil, args...)
+}
+
+// runGit runs a git command in the config file's directory with additional environment
+// variables, returning its standard output.
+func (gl *GitLoader) runGit(env []string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", gl.dir}, args...)...)
+	cmd.Env = append(os.Environ(), env...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// identityEnv returns environment variables that provide a fallback commit identity based on
+// the OS user, for use when no Git identity has been configured.
+func (gl *GitLoader) identityEnv() []string {
+	if out, err := gl.git("config", "user.email"); err == nil && strings.TrimSpace(out) != "" {
+		return nil
+	}
+
+	name := "cofidectl"
+	if usr, err := user.Current(); err == nil && usr.Username != "" {
+		name = usr.Username
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "localhost"
+	}
+	email := fmt.Sprintf("%s@%s", name, hostname)
+	return []string{
+		"GIT_AUTHOR_NAME=" + name,
+		"GIT_AUTHOR_EMAIL=" + email,
+		"GIT_COMMITTER_NAME=" + name,
+		"GIT_COMMITTER_EMAIL=" + email,
+	}
+}
+
+// commitMessage returns a commit message describing a set of changes.
+// The subject is the first change, and any further changes are listed in the body.
+func commitMessage(changes []Change) string {
+	switch len(changes) {
+	case 0:
+		return "initialise configuration"
+	case 1:
+		return changes[0].Description
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s and %d other changes\n\n", changes[0].Description, len(changes)-1)
+	for _, change := range changes {
+		fmt.Fprintf(&sb, "- %s\n", change.Description)
+	}
+	return sb.String()
+}
+
+// ShortHash returns the abbreviated form of a revision hash used in messages and output.
+func ShortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+)
+
+func newTestGitLoader(t *testing.T) *GitLoader {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	return NewGitLoader(filepath.Join(t.TempDir(), "cofide.yaml"))
+}
+
+func TestGitLoaderImplementsHistoryLoader(t *testing.T) {
+	loader := NewGitLoader("fake.yaml")
+	var _ HistoryLoader = loader
+}
+
+func TestGitLoaderWriteCommits(t *testing.T) {
+	loader := newTestGitLoader(t)
+
+	history, err := loader.History()
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	config := NewConfig()
+	require.NoError(t, loader.Write(config))
+
+	config.TrustZones = []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1")}
+	require.NoError(t, loader.Write(config))
+
+	config.Clusters = []*clusterpb.Cluster{fixtures.Cluster("local1")}
+	require.NoError(t, loader.Write(config))
+
+	// Writing an unchanged config should not create a commit.
+	require.NoError(t, loader.Write(config))
+
+	history, err = loader.History()
+	require.NoError(t, err)
+	var messages []string
+	for _, revision := range history {
+		messages = append(messages, revision.Message)
+		assert.Len(t, revision.Hash, 40)
+		assert.NotEmpty(t, revision.Author)
+	}
+	assert.Equal(t, []string{
+		"add cluster local1 to trust zone tz1",
+		"add trust zone tz1",
+		"initialise configuration",
+	}, messages)
+
+	got, err := loader.Read()
+	require.NoError(t, err)
+	assert.EqualExportedValues(t, config, got)
+}
+
+func TestGitLoaderWriteMultipleChanges(t *testing.T) {
+	loader := newTestGitLoader(t)
+	require.NoError(t, loader.Write(NewConfig()))
+
+	config := NewConfig()
+	config.TrustZones = []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1"), fixtures.TrustZone("tz2")}
+	require.NoError(t, loader.Write(config))
+
+	history, err := loader.History()
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "add trust zone tz1 and 1 other changes\n\n- add trust zone tz1\n- add trust zone tz2", history[0].Message)
+}
+
+func TestGitLoaderRevert(t *testing.T) {
+	loader := newTestGitLoader(t)
+
+	config := NewConfig()
+	require.NoError(t, loader.Write(config))
+
+	config.TrustZones = []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1")}
+	require.NoError(t, loader.Write(config))
+
+	history, err := loader.History()
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	initial := history[1].Hash
+
+	require.NoError(t, loader.Revert(initial[:8]))
+
+	got, err := loader.Read()
+	require.NoError(t, err)
+	assert.EqualExportedValues(t, NewConfig(), got)
+
+	history, err = loader.History()
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, "revert configuration to "+initial[:12], history[0].Message)
+
+	// Reverting to the current state is a no-op.
+	require.NoError(t, loader.Revert(initial))
+	history, err = loader.History()
+	require.NoError(t, err)
+	assert.Len(t, history, 3)
+}
+
+func TestGitLoaderRevertUnknown(t *testing.T) {
+	loader := newTestGitLoader(t)
+	require.NoError(t, loader.Write(NewConfig()))
+
+	err := loader.Revert("does-not-exist")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "unknown config revision does-not-exist")
+}
+
+func TestNewLoader(t *testing.T) {
+	loader, err := NewLoader("cofide.yaml", "")
+	require.NoError(t, err)
+	assert.IsType(t, &FileLoader{}, loader)
+
+	loader, err = NewLoader("git:config/cofide.yaml", "")
+	require.NoError(t, err)
+	require.IsType(t, &GitLoader{}, loader)
+	assert.Equal(t, "config", loader.(*GitLoader).dir)
+
+	_, err = NewLoader("git:", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/cofide/cofidectl/pkg/kube"
+)
+
+const (
+	// KubernetesURIScheme is the URI scheme used to select a KubernetesLoader,
+	// e.g. k8s://context/namespace/name.
+	KubernetesURIScheme = "k8s"
+
+	// KubernetesConfigKey is the key under which the config document is stored in the
+	// ConfigMap or Secret.
+	KubernetesConfigKey = "cofide.yaml"
+
+	KubernetesKindConfigMap = "configmap"
+	KubernetesKindSecret    = "secret"
+)
+
+// ErrConfigConflict is returned by KubernetesLoader.Write when the stored config has been
+// modified since it was last read.
+var ErrConfigConflict = errors.New("configuration was modified concurrently")
+
+// KubernetesRef identifies a ConfigMap or Secret that holds a config document.
+type KubernetesRef struct {
+	// Context is the kubeconfig context. If empty, the current context is used.
+	Context   string
+	Namespace string
+	Name      string
+	// Kind is either KubernetesKindConfigMap or KubernetesKindSecret.
+	Kind string
+}
+
+func (r *KubernetesRef) String() string {
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// IsKubernetesURI returns whether the config location is a k8s:// URI.
+func IsKubernetesURI(location string) bool {
+	return strings.HasPrefix(location, KubernetesURIScheme+"://")
+}
+
+// ParseKubernetesURI parses a URI of the form k8s://context/namespace/name[?kind=configmap|secret].
+// The context may be empty (k8s:///namespace/name) to use the current kubeconfig context.
+// The kind defaults to configmap.
+func ParseKubernetesURI(uri string) (*KubernetesRef, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kubernetes config URI %s: %w", uri, err)
+	}
+	if u.Scheme != KubernetesURIScheme {
+		return nil, fmt.Errorf("invalid Kubernetes config URI %s: scheme must be %s", uri, KubernetesURIScheme)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid Kubernetes config URI %s: expected k8s://context/namespace/name", uri)
+	}
+
+	kind := strings.ToLower(u.Query().Get("kind"))
+	switch kind {
+	case "":
+		kind = KubernetesKindConfigMap
+	case KubernetesKindConfigMap, KubernetesKindSecret:
+	default:
+		return nil, fmt.Errorf("invalid Kubernetes config URI %s: kind must be %s or %s", uri, KubernetesKindConfigMap, KubernetesKindSecret)
+	}
+
+	return &KubernetesRef{
+		Context:   u.Host,
+		Namespace: parts[0],
+		Name:      parts[1],
+		Kind:      kind,
+	}, nil
+}
+
+// KubernetesLoader implements the `Loader` interface by reading and writing to a ConfigMap or
+// Secret in a Kubernetes cluster.
+// Writes use optimistic concurrency: the resourceVersion observed by the most recent Read or
+// Write is sent with each update, and ErrConfigConflict is returned if it has changed.
+type KubernetesLoader struct {
+	client kubernetes.Interface
+	ref    *KubernetesRef
+	// observed records whether the object has been observed to exist, in which case Write
+	// updates it rather than creating it.
+	observed        bool
+	resourceVersion string
+	validator       *Validator
+}
+
+func NewKubernetesLoader(client kubernetes.Interface, ref *KubernetesRef) *KubernetesLoader {
+	return &KubernetesLoader{client: client, ref: ref, validator: NewValidator()}
+}
+
+// NewKubernetesLoaderFromURI returns a KubernetesLoader for a k8s:// URI, connecting to the
+// cluster using the specified kubeconfig file.
+func NewKubernetesLoaderFromURI(uri, kubeConfig string) (*KubernetesLoader, error) {
+	ref, err := ParseKubernetesURI(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kube.NewKubeClientFromSpecifiedContext(kubeConfig, ref.Context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client for config %s: %w", uri, err)
+	}
+	return NewKubernetesLoader(client.Clientset, ref), nil
+}
+
+// Exists returns whether the ConfigMap or Secret exists and contains the config key.
+// An object without the config key, e.g. one created in advance to grant RBAC access, is treated
+// as not existing, and the next Write adds the key to it.
+func (kl *KubernetesLoader) Exists() (bool, error) {
+	data, resourceVersion, err := kl.get(context.Background())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("error reading configuration from %s: %w", kl.ref, err)
+	}
+	if data == nil {
+		kl.observed = true
+		kl.resourceVersion = resourceVersion
+		return false, nil
+	}
+	return true, nil
+}
+
+func (kl *KubernetesLoader) Read() (*Config, error) {
+	data, resourceVersion, err := kl.get(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error reading configuration from %s: %w", kl.ref, err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("error reading configuration from %s: key %s not found", kl.ref, KubernetesConfigKey)
+	}
+
+	config, err := validatedRead(data, kl.validator)
+	if err != nil {
+		return nil, err
+	}
+	kl.observed = true
+	kl.resourceVersion = resourceVersion
+	return config, nil
+}
+
+func (kl *KubernetesLoader) Write(config *Config) error {
+	data, err := config.marshalYAML()
+	if err != nil {
+		return fmt.Errorf("error marshalling configuration to YAML: %w", err)
+	}
+
+	ctx := context.Background()
+	var resourceVersion string
+	if !kl.observed {
+		resourceVersion, err = kl.create(ctx, data)
+	} else {
+		resourceVersion, err = kl.update(ctx, data)
+	}
+	if apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("error writing configuration to %s: %w: re-run the command to apply changes to the latest version", kl.ref, ErrConfigConflict)
+	} else if err != nil {
+		return fmt.Errorf("error writing configuration to %s: %w", kl.ref, err)
+	}
+
+	kl.observed = true
+	kl.resourceVersion = resourceVersion
+	return nil
+}
+
+// get returns the config document and resourceVersion of the ConfigMap or Secret.
+// The returned data is nil if the object exists without the config key.
+func (kl *KubernetesLoader) get(ctx context.Context) ([]byte, string, error) {
+	if kl.ref.Kind == KubernetesKindSecret {
+		secret, err := kl.client.CoreV1().Secrets(kl.ref.Namespace).Get(ctx, kl.ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, "", err
+		}
+		return secret.Data[KubernetesConfigKey], secret.ResourceVersion, nil
+	}
+
+	configMap, err := kl.client.CoreV1().ConfigMaps(kl.ref.Namespace).Get(ctx, kl.ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	data, ok := configMap.Data[KubernetesConfigKey]
+	if !ok {
+		return nil, configMap.ResourceVersion, nil
+	}
+	return []byte(data), configMap.ResourceVersion, nil
+}
+
+func (kl *KubernetesLoader) create(ctx context.Context, data []byte) (string, error) {
+	meta := metav1.ObjectMeta{Name: kl.ref.Name, Namespace: kl.ref.Namespace}
+	if kl.ref.Kind == KubernetesKindSecret {
+		secret := &corev1.Secret{ObjectMeta: meta, Data: map[string][]byte{KubernetesConfigKey: data}}
+		secret, err := kl.client.CoreV1().Secrets(kl.ref.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+		if err != nil {
+			return "", err
+		}
+		return secret.ResourceVersion, nil
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: meta, Data: map[string]string{KubernetesConfigKey: string(data)}}
+	configMap, err := kl.client.CoreV1().ConfigMaps(kl.ref.Namespace).Create(ctx, configMap, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.ResourceVersion, nil
+}
+
+// update replaces the config document in the existing ConfigMap or Secret, preserving its other
+// keys and metadata. The update is conditional on the resourceVersion observed by the last Read or
+// Write.
+func (kl *KubernetesLoader) update(ctx context.Context, data []byte) (string, error) {
+	if kl.ref.Kind == KubernetesKindSecret {
+		secrets := kl.client.CoreV1().Secrets(kl.ref.Namespace)
+		secret, err := secrets.Get(ctx, kl.ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		if secret.ResourceVersion != kl.resourceVersion {
+			return "", apierrors.NewConflict(corev1.Resource("secrets"), kl.ref.Name, ErrConfigConflict)
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[KubernetesConfigKey] = data
+		secret, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+		if err != nil {
+			return "", err
+		}
+		return secret.ResourceVersion, nil
+	}
+
+	configMaps := kl.client.CoreV1().ConfigMaps(kl.ref.Namespace)
+	configMap, err := configMaps.Get(ctx, kl.ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if configMap.ResourceVersion != kl.resourceVersion {
+		return "", apierrors.NewConflict(corev1.Resource("configmaps"), kl.ref.Name, ErrConfigConflict)
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[KubernetesConfigKey] = string(data)
+	configMap, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.ResourceVersion, nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+)
+
+func TestKubernetesLoaderImplementsLoader(t *testing.T) {
+	loader := NewKubernetesLoader(fake.NewClientset(), &KubernetesRef{})
+	var _ Loader = loader
+}
+
+func TestParseKubernetesURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    *KubernetesRef
+		wantErr string
+	}{
+		{
+			name: "configmap default",
+			uri:  "k8s://kind-user/cofide/config",
+			want: &KubernetesRef{Context: "kind-user", Namespace: "cofide", Name: "config", Kind: KubernetesKindConfigMap},
+		},
+		{
+			name: "secret",
+			uri:  "k8s://kind-user/cofide/config?kind=Secret",
+			want: &KubernetesRef{Context: "kind-user", Namespace: "cofide", Name: "config", Kind: KubernetesKindSecret},
+		},
+		{
+			name: "current context",
+			uri:  "k8s:///cofide/config",
+			want: &KubernetesRef{Context: "", Namespace: "cofide", Name: "config", Kind: KubernetesKindConfigMap},
+		},
+		{
+			name:    "missing name",
+			uri:     "k8s://kind-user/cofide",
+			wantErr: "expected k8s://context/namespace/name",
+		},
+		{
+			name:    "too many parts",
+			uri:     "k8s://kind-user/cofide/config/extra",
+			wantErr: "expected k8s://context/namespace/name",
+		},
+		{
+			name:    "invalid kind",
+			uri:     "k8s://kind-user/cofide/config?kind=pod",
+			wantErr: "kind must be configmap or secret",
+		},
+		{
+			name:    "wrong scheme",
+			uri:     "file:///cofide/config",
+			wantErr: "scheme must be k8s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseKubernetesURI(tt.uri)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKubernetesLoaderRoundTrip(t *testing.T) {
+	for _, kind := range []string{KubernetesKindConfigMap, KubernetesKindSecret} {
+		t.Run(kind, func(t *testing.T) {
+			client := fake.NewClientset()
+			ref := &KubernetesRef{Namespace: "cofide", Name: "config", Kind: kind}
+			loader := NewKubernetesLoader(client, ref)
+
+			gotExists, err := loader.Exists()
+			require.NoError(t, err)
+			assert.False(t, gotExists, "KubernetesLoader.Exists() returned true")
+
+			config := NewConfig()
+			config.TrustZones = []*trust_zone_proto.TrustZone{
+				fixtures.TrustZone("tz1"),
+			}
+			config.Plugins = fixtures.Plugins("plugins1")
+			require.NoError(t, loader.Write(config))
+
+			gotExists, err = loader.Exists()
+			require.NoError(t, err)
+			assert.True(t, gotExists, "KubernetesLoader.Exists() returned false")
+
+			// A second write should update the existing object.
+			config.TrustZones = append(config.TrustZones, fixtures.TrustZone("tz2"))
+			require.NoError(t, loader.Write(config))
+
+			got, err := NewKubernetesLoader(client, ref).Read()
+			require.NoError(t, err)
+			assert.EqualExportedValues(t, config, got)
+		})
+	}
+}
+
+func TestKubernetesLoaderPreservesOtherKeys(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "cofide", Labels: map[string]string{"team": "platform"}},
+		Data:       map[string]string{KubernetesConfigKey: string(readTestConfig(t, "default.yaml")), "other": "value"},
+	}
+	client := fake.NewClientset(configMap)
+	loader := NewKubernetesLoader(client, &KubernetesRef{Namespace: "cofide", Name: "config", Kind: KubernetesKindConfigMap})
+
+	_, err := loader.Read()
+	require.NoError(t, err)
+	require.NoError(t, loader.Write(NewConfig()))
+
+	got, err := client.CoreV1().ConfigMaps("cofide").Get(context.Background(), "config", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "value", got.Data["other"])
+	assert.Equal(t, "platform", got.Labels["team"])
+	assert.NotEmpty(t, got.Data[KubernetesConfigKey])
+}
+
+func TestKubernetesLoaderConflict(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "cofide", ResourceVersion: "1"},
+		Data:       map[string]string{KubernetesConfigKey: string(readTestConfig(t, "default.yaml"))},
+	}
+	client := fake.NewClientset(configMap)
+	ref := &KubernetesRef{Namespace: "cofide", Name: "config", Kind: KubernetesKindConfigMap}
+	loader := NewKubernetesLoader(client, ref)
+
+	_, err := loader.Read()
+	require.NoError(t, err)
+
+	// Simulate a concurrent modification by another user.
+	configMap = configMap.DeepCopy()
+	configMap.ResourceVersion = "2"
+	_, err = client.CoreV1().ConfigMaps("cofide").Update(context.Background(), configMap, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	err = loader.Write(NewConfig())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConfigConflict)
+	assert.ErrorContains(t, err, "error writing configuration to configmap cofide/config: configuration was modified concurrently")
+}
+
+func TestKubernetesLoaderCreateConflict(t *testing.T) {
+	// Writing without reading when the object has since been created by another user should fail.
+	client := fake.NewClientset()
+	ref := &KubernetesRef{Namespace: "cofide", Name: "config", Kind: KubernetesKindSecret}
+	loader := NewKubernetesLoader(client, ref)
+
+	require.NoError(t, NewKubernetesLoader(client, ref).Write(NewConfig()))
+
+	err := loader.Write(NewConfig())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConfigConflict)
+}
+
+func TestKubernetesLoaderReadInvalid(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "cofide"},
+		Data:       map[string][]byte{KubernetesConfigKey: []byte(`plugins: 123`)},
+	}
+	loader := NewKubernetesLoader(fake.NewClientset(secret), &KubernetesRef{Namespace: "cofide", Name: "config", Kind: KubernetesKindSecret})
+
+	_, err := loader.Read()
+	require.Error(t, err)
+	assert.ErrorContains(t, err, `error validating configuration YAML: plugins: conflicting values 123 and`)
+}
+
+func TestKubernetesLoaderReadMissingKey(t *testing.T) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "cofide"}}
+	loader := NewKubernetesLoader(fake.NewClientset(configMap), &KubernetesRef{Namespace: "cofide", Name: "config", Kind: KubernetesKindConfigMap})
+
+	_, err := loader.Read()
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "key cofide.yaml not found")
+}
+
+func TestKubernetesLoaderExistsMissingKey(t *testing.T) {
+	// An object created in advance without the config key should be populated by the first Write.
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "cofide", Labels: map[string]string{"team": "platform"}},
+		Data:       map[string][]byte{"other": []byte("value")},
+	}
+	client := fake.NewClientset(secret)
+	loader := NewKubernetesLoader(client, &KubernetesRef{Namespace: "cofide", Name: "config", Kind: KubernetesKindSecret})
+
+	exists, err := loader.Exists()
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, loader.Write(NewConfig()))
+
+	exists, err = loader.Exists()
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	got, err := client.CoreV1().Secrets("cofide").Get(context.Background(), "config", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), got.Data["other"])
+	assert.Equal(t, "platform", got.Labels["team"])
+	assert.NotEmpty(t, got.Data[KubernetesConfigKey])
+}
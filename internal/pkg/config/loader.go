@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 )
 
 // Loader provides an interface to read and write a `Config`.
@@ -16,16 +17,45 @@ type Loader interface {
 	Write(*Config) error
 }
 
+// DirLoader is a Loader for a config file in a local directory. Relative paths in the config,
+// such as the plugins.path setting, are resolved against the directory.
+type DirLoader interface {
+	Loader
+	// Dir returns the directory containing the config file.
+	Dir() string
+}
+
+// NewLoader returns a Loader for a config location.
+// Locations of the form k8s://context/namespace/name use a KubernetesLoader with the specified
+// kubeconfig file. Locations of the form git:PATH use a GitLoader. Any other location is treated
+// as a file path.
+func NewLoader(location, kubeConfig string) (Loader, error) {
+	if IsKubernetesURI(location) {
+		return NewKubernetesLoaderFromURI(location, kubeConfig)
+	}
+	if IsGitURI(location) {
+		return NewGitLoaderFromURI(location)
+	}
+	return NewFileLoader(location), nil
+}
+
 // FileLoader implements the `Loader` interface by reading and writing to a file.
 type FileLoader struct {
 	filePath  string
 	validator *Validator
 }
 
+var _ DirLoader = (*FileLoader)(nil)
+
 func NewFileLoader(filePath string) *FileLoader {
 	return &FileLoader{filePath: filePath, validator: NewValidator()}
 }
 
+// Dir returns the directory containing the config file.
+func (fl *FileLoader) Dir() string {
+	return filepath.Dir(fl.filePath)
+}
+
 func (fl *FileLoader) Exists() (bool, error) {
 	if _, err := os.Stat(fl.filePath); errors.Is(err, os.ErrNotExist) {
 		return false, nil
@@ -132,6 +132,7 @@
 #Plugins: {
 	data_source?: string
 	provision?: string
+	path?: [...string]
 }
 
 #Config: {
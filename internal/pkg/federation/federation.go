@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/spirecrd"
 	"github.com/cofide/cofidectl/pkg/spiffe"
 )
 
@@ -25,7 +26,9 @@ func NewFederation(trustZone *trust_zone_proto.TrustZone) *Federation {
 	}
 }
 
-func (fed *Federation) GetHelmConfig() (map[string]any, error) {
+// GetClusterFederatedTrustDomainSpec returns a ClusterFederatedTrustDomain spec for federation
+// with the destination trust zone.
+func (fed *Federation) GetClusterFederatedTrustDomainSpec() (*spirecrd.ClusterFederatedTrustDomainSpec, error) {
 	switch fed.destTrustZone.GetBundleEndpointProfile() {
 	case trust_zone_proto.BundleEndpointProfile_BUNDLE_ENDPOINT_PROFILE_HTTPS_SPIFFE:
 		bundle, err := spiffe.GetSPIFFETrustBundle(fed.destTrustZone.GetBundle())
@@ -38,22 +41,22 @@ func (fed *Federation) GetHelmConfig() (map[string]any, error) {
 			return nil, fmt.Errorf("failed to marshal trust bundle to JSON: %w", err)
 		}
 
-		return map[string]any{
-			"bundleEndpointURL": fed.destTrustZone.GetBundleEndpointUrl(),
-			"bundleEndpointProfile": map[string]any{
-				"type":             bundleEndpointProfileHTTPSSPIFFE,
-				"endpointSPIFFEID": fmt.Sprintf("spiffe://%s/spire/server", fed.destTrustZone.TrustDomain),
+		return &spirecrd.ClusterFederatedTrustDomainSpec{
+			BundleEndpointURL: fed.destTrustZone.GetBundleEndpointUrl(),
+			BundleEndpointProfile: spirecrd.BundleEndpointProfile{
+				Type:             bundleEndpointProfileHTTPSSPIFFE,
+				EndpointSPIFFEID: fmt.Sprintf("spiffe://%s/spire/server", fed.destTrustZone.TrustDomain),
 			},
-			"trustDomain":       fed.destTrustZone.TrustDomain,
-			"trustDomainBundle": string(bundleJSON),
+			TrustDomain:       fed.destTrustZone.TrustDomain,
+			TrustDomainBundle: string(bundleJSON),
 		}, nil
 	case trust_zone_proto.BundleEndpointProfile_BUNDLE_ENDPOINT_PROFILE_HTTPS_WEB:
-		return map[string]any{
-			"bundleEndpointURL": fed.destTrustZone.GetBundleEndpointUrl(),
-			"bundleEndpointProfile": map[string]any{
-				"type": bundleEndpointProfileHTTPSWeb,
+		return &spirecrd.ClusterFederatedTrustDomainSpec{
+			BundleEndpointURL: fed.destTrustZone.GetBundleEndpointUrl(),
+			BundleEndpointProfile: spirecrd.BundleEndpointProfile{
+				Type: bundleEndpointProfileHTTPSWeb,
 			},
-			"trustDomain": fed.destTrustZone.TrustDomain,
+			TrustDomain: fed.destTrustZone.TrustDomain,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unexpected bundle endpoint profile %d", fed.destTrustZone.GetBundleEndpointProfile())
@@ -0,0 +1,348 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spireagent generates configuration for SPIRE agents that run outside of a Kubernetes
+// cluster managed by cofidectl, such as on VMs or bare-metal hosts.
+package spireagent
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/trustprovider"
+	"github.com/cofide/cofidectl/pkg/spiffe"
+)
+
+const (
+	NodeAttestorJoinToken = "join_token"
+	NodeAttestorX509PoP   = "x509pop"
+	NodeAttestorAWSIID    = "aws_iid"
+	NodeAttestorAzureMSI  = "azure_msi"
+	NodeAttestorGCPIIT    = "gcp_iit"
+	NodeAttestorK8sPSAT   = "k8s_psat"
+
+	WorkloadAttestorUnix    = "unix"
+	WorkloadAttestorDocker  = "docker"
+	WorkloadAttestorSystemd = "systemd"
+
+	DefaultConfigDir  = "/opt/spire/conf"
+	DefaultDataDir    = "/opt/spire/data/agent"
+	DefaultSocketPath = "/tmp/spire-agent/public/api.sock"
+	DefaultBinaryPath = "/opt/spire/bin/spire-agent"
+	DefaultServerPort = 443
+	DefaultLogLevel   = "INFO"
+
+	// ConfigFileName is the name of the SPIRE agent configuration file.
+	ConfigFileName = "agent.conf"
+	// BundleFileName is the name of the trust bundle file used to bootstrap the SPIRE agent.
+	BundleFileName = "bundle.crt"
+	// SystemdUnitFileName is the name of the systemd unit file for the SPIRE agent.
+	SystemdUnitFileName = "spire-agent.service"
+)
+
+// NodeAttestors are the node attestors that may be selected explicitly.
+var NodeAttestors = []string{
+	NodeAttestorJoinToken,
+	NodeAttestorX509PoP,
+	NodeAttestorAWSIID,
+	NodeAttestorAzureMSI,
+	NodeAttestorGCPIIT,
+	NodeAttestorK8sPSAT,
+}
+
+// WorkloadAttestors are the workload attestors that may be selected explicitly.
+var WorkloadAttestors = []string{
+	WorkloadAttestorUnix,
+	WorkloadAttestorDocker,
+	WorkloadAttestorSystemd,
+}
+
+// Opts configures the generated SPIRE agent configuration.
+type Opts struct {
+	// ServerAddress is the address of the SPIRE server. If empty, the host of the trust zone's
+	// bundle endpoint URL is used, which is served by the same load balancer as the SPIRE server.
+	ServerAddress string
+	ServerPort    int
+	ConfigDir     string
+	DataDir       string
+	SocketPath    string
+	LogLevel      string
+	// JoinToken is an optional join token to include in the configuration.
+	JoinToken string
+	// NodeAttestor is the node attestor plugin. If empty, it defaults to join_token.
+	// The k8s_psat node attestor requires TrustProvider and ClusterName, and is the only node
+	// attestor that may be used with them.
+	NodeAttestor string
+	// WorkloadAttestors are the workload attestor plugins. If empty, they are determined by
+	// TrustProvider, or default to unix.
+	WorkloadAttestors []string
+	// TrustProvider is the trust provider of the cluster of an agent using the k8s_psat node
+	// attestor, from which the attestors are derived.
+	TrustProvider *trustprovider.TrustProvider
+	// ClusterName is the name of the cluster of the trust provider, used by the k8s_psat node
+	// attestor.
+	ClusterName string
+	// InsecureBootstrap allows the agent to bootstrap without a trust bundle when the trust zone
+	// does not have one.
+	InsecureBootstrap bool
+}
+
+// Files is the generated configuration for a SPIRE agent.
+type Files struct {
+	// Config is the SPIRE agent configuration in JSON format, which the agent parses as HCL.
+	Config []byte
+	// Bundle is the trust bundle of the trust zone in PEM format, or nil if bootstrapping
+	// insecurely.
+	Bundle []byte
+}
+
+type agentConfig struct {
+	Agent   agentSection              `json:"agent"`
+	Plugins map[string]map[string]any `json:"plugins"`
+}
+
+type agentSection struct {
+	DataDir           string `json:"data_dir"`
+	LogLevel          string `json:"log_level"`
+	ServerAddress     string `json:"server_address"`
+	ServerPort        string `json:"server_port"`
+	SocketPath        string `json:"socket_path"`
+	TrustBundlePath   string `json:"trust_bundle_path,omitempty"`
+	TrustDomain       string `json:"trust_domain"`
+	JoinToken         string `json:"join_token,omitempty"`
+	InsecureBootstrap bool   `json:"insecure_bootstrap,omitempty"`
+}
+
+// Generate returns the configuration for a SPIRE agent in a trust zone.
+func Generate(trustZone *trust_zone_proto.TrustZone, opts Opts) (*Files, error) {
+	setDefaults(&opts)
+
+	serverAddress, err := getServerAddress(trustZone, opts.ServerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAttestor, err := getNodeAttestor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	workloadAttestors, err := getWorkloadAttestors(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	files := &Files{}
+	config := agentConfig{
+		Agent: agentSection{
+			DataDir:       opts.DataDir,
+			LogLevel:      opts.LogLevel,
+			ServerAddress: serverAddress,
+			ServerPort:    fmt.Sprint(opts.ServerPort),
+			SocketPath:    opts.SocketPath,
+			TrustDomain:   trustZone.GetTrustDomain(),
+			JoinToken:     opts.JoinToken,
+		},
+		Plugins: map[string]map[string]any{
+			"NodeAttestor": nodeAttestor,
+			"KeyManager": {
+				"disk": pluginData(map[string]any{"directory": opts.DataDir}),
+			},
+			"WorkloadAttestor": workloadAttestors,
+		},
+	}
+
+	if trustZone.GetBundle() != nil {
+		files.Bundle, err = getBundlePEM(trustZone)
+		if err != nil {
+			return nil, err
+		}
+		config.Agent.TrustBundlePath = path.Join(opts.ConfigDir, BundleFileName)
+	} else if opts.InsecureBootstrap {
+		config.Agent.InsecureBootstrap = true
+	} else {
+		return nil, fmt.Errorf("trust zone %s does not have a trust bundle, deploy it first or bootstrap insecurely", trustZone.GetName())
+	}
+
+	files.Config, err = json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files.Config = append(files.Config, '\n')
+	return files, nil
+}
+
+// SystemdUnit returns a systemd unit that runs a SPIRE agent with the configuration in configDir.
+func SystemdUnit(binaryPath, configDir string) []byte {
+	if binaryPath == "" {
+		binaryPath = DefaultBinaryPath
+	}
+	if configDir == "" {
+		configDir = DefaultConfigDir
+	}
+	unit := `[Unit]
+Description=SPIRE Agent
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+ExecStart=%s run -config %s
+Restart=on-failure
+RestartSec=5
+
+[Install]
+WantedBy=multi-user.target
+`
+	return fmt.Appendf(nil, unit, binaryPath, path.Join(configDir, ConfigFileName))
+}
+
+func setDefaults(opts *Opts) {
+	if opts.ServerPort == 0 {
+		opts.ServerPort = DefaultServerPort
+	}
+	if opts.ConfigDir == "" {
+		opts.ConfigDir = DefaultConfigDir
+	}
+	if opts.DataDir == "" {
+		opts.DataDir = DefaultDataDir
+	}
+	if opts.SocketPath == "" {
+		opts.SocketPath = DefaultSocketPath
+	}
+	if opts.LogLevel == "" {
+		opts.LogLevel = DefaultLogLevel
+	}
+}
+
+// getServerAddress returns the SPIRE server address, defaulting to the host of the trust zone's
+// bundle endpoint URL.
+func getServerAddress(trustZone *trust_zone_proto.TrustZone, serverAddress string) (string, error) {
+	if serverAddress != "" {
+		return serverAddress, nil
+	}
+	if trustZone.GetBundleEndpointUrl() == "" {
+		return "", fmt.Errorf("trust zone %s does not have a bundle endpoint URL, specify the SPIRE server address", trustZone.GetName())
+	}
+	rawURL := trustZone.GetBundleEndpointUrl()
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	endpoint, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid bundle endpoint URL for trust zone %s: %w", trustZone.GetName(), err)
+	}
+	if endpoint.Hostname() == "" {
+		return "", fmt.Errorf("bundle endpoint URL for trust zone %s has no host, specify the SPIRE server address", trustZone.GetName())
+	}
+	return endpoint.Hostname(), nil
+}
+
+func getNodeAttestor(opts Opts) (map[string]any, error) {
+	nodeAttestor := opts.NodeAttestor
+	if nodeAttestor == "" {
+		nodeAttestor = NodeAttestorJoinToken
+	}
+	if opts.TrustProvider != nil && nodeAttestor != NodeAttestorK8sPSAT {
+		return nil, fmt.Errorf("a cluster trust provider can only be used with the %s node attestor, not %s", NodeAttestorK8sPSAT, nodeAttestor)
+	}
+
+	switch nodeAttestor {
+	case NodeAttestorK8sPSAT:
+		if opts.TrustProvider == nil {
+			return nil, fmt.Errorf("the %s node attestor requires a cluster trust provider", NodeAttestorK8sPSAT)
+		}
+		return trustProviderNodeAttestor(opts.TrustProvider, opts.ClusterName)
+	case NodeAttestorX509PoP:
+		return map[string]any{
+			nodeAttestor: pluginData(map[string]any{
+				"private_key_path": path.Join(opts.ConfigDir, "agent.key.pem"),
+				"certificate_path": path.Join(opts.ConfigDir, "agent.crt.pem"),
+			}),
+		}, nil
+	case NodeAttestorJoinToken, NodeAttestorAWSIID, NodeAttestorAzureMSI, NodeAttestorGCPIIT:
+		return map[string]any{nodeAttestor: pluginData(nil)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported node attestor %q, expected one of %s", nodeAttestor, strings.Join(NodeAttestors, ", "))
+	}
+}
+
+func getWorkloadAttestors(opts Opts) (map[string]any, error) {
+	workloadAttestors := opts.WorkloadAttestors
+	if len(workloadAttestors) == 0 {
+		if opts.TrustProvider != nil {
+			return trustProviderWorkloadAttestor(opts.TrustProvider)
+		}
+		workloadAttestors = []string{WorkloadAttestorUnix}
+	}
+
+	result := map[string]any{}
+	for _, workloadAttestor := range workloadAttestors {
+		if !slices.Contains(WorkloadAttestors, workloadAttestor) {
+			return nil, fmt.Errorf("unsupported workload attestor %q, expected one of %s", workloadAttestor, strings.Join(WorkloadAttestors, ", "))
+		}
+		result[workloadAttestor] = pluginData(nil)
+	}
+	return result, nil
+}
+
+// trustProviderNodeAttestor returns the node attestor of a trust provider.
+func trustProviderNodeAttestor(tp *trustprovider.TrustProvider, clusterName string) (map[string]any, error) {
+	switch tp.Kind {
+	case "kubernetes":
+		if clusterName == "" {
+			return nil, errors.New("a cluster name is required for the k8s_psat node attestor")
+		}
+		return map[string]any{
+			NodeAttestorK8sPSAT: pluginData(map[string]any{"cluster": clusterName}),
+		}, nil
+	default:
+		return nil, fmt.Errorf("an unknown trust provider kind was specified: %s", tp.Kind)
+	}
+}
+
+// trustProviderWorkloadAttestor returns the workload attestor of a trust provider.
+func trustProviderWorkloadAttestor(tp *trustprovider.TrustProvider) (map[string]any, error) {
+	switch tp.Kind {
+	case "kubernetes":
+		data := map[string]any{}
+		if disable, ok := tp.AgentConfig.WorkloadAttestorConfig["disableContainerSelectors"].(bool); ok {
+			data["disable_container_selectors"] = disable
+		}
+		return map[string]any{
+			"k8s": pluginData(data),
+		}, nil
+	default:
+		return nil, fmt.Errorf("an unknown trust provider kind was specified: %s", tp.Kind)
+	}
+}
+
+func pluginData(data map[string]any) map[string]any {
+	if data == nil {
+		data = map[string]any{}
+	}
+	return map[string]any{"plugin_data": data}
+}
+
+// getBundlePEM returns the X.509 authorities of the trust zone's bundle in PEM format.
+func getBundlePEM(trustZone *trust_zone_proto.TrustZone) ([]byte, error) {
+	bundle, err := spiffe.GetSPIFFETrustBundle(trustZone.GetBundle())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert bundle to SPIFFE format: %w", err)
+	}
+
+	var result []byte
+	for _, authority := range bundle.X509Authorities() {
+		result = append(result, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: authority.Raw})...)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("trust bundle for trust zone %s has no X.509 authorities", trustZone.GetName())
+	}
+	return result, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spireagent
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/internal/pkg/trustprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	kubernetesTrustProvider := &trustprovider.TrustProvider{
+		Kind: "kubernetes",
+		AgentConfig: trustprovider.TrustProviderAgentConfig{
+			WorkloadAttestorConfig: map[string]any{"disableContainerSelectors": true},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		trustZone     *trust_zone_proto.TrustZone
+		opts          Opts
+		wantAgent     map[string]any
+		wantPlugins   map[string]any
+		wantBundle    bool
+		wantErrString string
+	}{
+		{
+			name:      "defaults",
+			trustZone: fixtures.TrustZone("tz1"),
+			wantAgent: map[string]any{
+				"data_dir":          DefaultDataDir,
+				"log_level":         DefaultLogLevel,
+				"server_address":    "127.0.0.1",
+				"server_port":       "443",
+				"socket_path":       DefaultSocketPath,
+				"trust_bundle_path": "/opt/spire/conf/bundle.crt",
+				"trust_domain":      "td1",
+			},
+			wantPlugins: map[string]any{
+				"NodeAttestor":     map[string]any{"join_token": map[string]any{"plugin_data": map[string]any{}}},
+				"KeyManager":       map[string]any{"disk": map[string]any{"plugin_data": map[string]any{"directory": DefaultDataDir}}},
+				"WorkloadAttestor": map[string]any{"unix": map[string]any{"plugin_data": map[string]any{}}},
+			},
+			wantBundle: true,
+		},
+		{
+			name: "explicit options",
+			trustZone: func() *trust_zone_proto.TrustZone {
+				tz := fixtures.TrustZone("tz1")
+				tz.BundleEndpointUrl = fixtures.StringPtr("https://spire.example.com:8443")
+				return tz
+			}(),
+			opts: Opts{
+				ServerPort:        8081,
+				ConfigDir:         "/etc/spire",
+				DataDir:           "/var/lib/spire",
+				SocketPath:        "/run/spire/agent.sock",
+				LogLevel:          "DEBUG",
+				JoinToken:         "fake-token",
+				NodeAttestor:      NodeAttestorX509PoP,
+				WorkloadAttestors: []string{WorkloadAttestorDocker, WorkloadAttestorSystemd},
+			},
+			wantAgent: map[string]any{
+				"data_dir":          "/var/lib/spire",
+				"log_level":         "DEBUG",
+				"server_address":    "spire.example.com",
+				"server_port":       "8081",
+				"socket_path":       "/run/spire/agent.sock",
+				"trust_bundle_path": "/etc/spire/bundle.crt",
+				"trust_domain":      "td1",
+				"join_token":        "fake-token",
+			},
+			wantPlugins: map[string]any{
+				"NodeAttestor": map[string]any{"x509pop": map[string]any{"plugin_data": map[string]any{
+					"private_key_path": "/etc/spire/agent.key.pem",
+					"certificate_path": "/etc/spire/agent.crt.pem",
+				}}},
+				"KeyManager": map[string]any{"disk": map[string]any{"plugin_data": map[string]any{"directory": "/var/lib/spire"}}},
+				"WorkloadAttestor": map[string]any{
+					"docker":  map[string]any{"plugin_data": map[string]any{}},
+					"systemd": map[string]any{"plugin_data": map[string]any{}},
+				},
+			},
+			wantBundle: true,
+		},
+		{
+			name:      "trust provider",
+			trustZone: fixtures.TrustZone("tz1"),
+			opts: Opts{
+				ServerAddress: "10.0.0.1",
+				NodeAttestor:  NodeAttestorK8sPSAT,
+				TrustProvider: kubernetesTrustProvider,
+				ClusterName:   "local1",
+			},
+			wantAgent: map[string]any{
+				"data_dir":          DefaultDataDir,
+				"log_level":         DefaultLogLevel,
+				"server_address":    "10.0.0.1",
+				"server_port":       "443",
+				"socket_path":       DefaultSocketPath,
+				"trust_bundle_path": "/opt/spire/conf/bundle.crt",
+				"trust_domain":      "td1",
+			},
+			wantPlugins: map[string]any{
+				"NodeAttestor":     map[string]any{"k8s_psat": map[string]any{"plugin_data": map[string]any{"cluster": "local1"}}},
+				"KeyManager":       map[string]any{"disk": map[string]any{"plugin_data": map[string]any{"directory": DefaultDataDir}}},
+				"WorkloadAttestor": map[string]any{"k8s": map[string]any{"plugin_data": map[string]any{"disable_container_selectors": true}}},
+			},
+			wantBundle: true,
+		},
+		{
+			name:          "trust provider without k8s_psat",
+			trustZone:     fixtures.TrustZone("tz1"),
+			opts:          Opts{TrustProvider: kubernetesTrustProvider, ClusterName: "local1"},
+			wantErrString: "a cluster trust provider can only be used with the k8s_psat node attestor, not join_token",
+		},
+		{
+			name:          "k8s_psat without trust provider",
+			trustZone:     fixtures.TrustZone("tz1"),
+			opts:          Opts{NodeAttestor: NodeAttestorK8sPSAT},
+			wantErrString: "the k8s_psat node attestor requires a cluster trust provider",
+		},
+		{
+			name:          "no bundle",
+			trustZone:     fixtures.TrustZone("tz2"),
+			wantErrString: "trust zone tz2 does not have a trust bundle, deploy it first or bootstrap insecurely",
+		},
+		{
+			name:      "no bundle, insecure bootstrap",
+			trustZone: fixtures.TrustZone("tz2"),
+			opts:      Opts{InsecureBootstrap: true},
+			wantAgent: map[string]any{
+				"data_dir":           DefaultDataDir,
+				"log_level":          DefaultLogLevel,
+				"server_address":     "127.0.0.2",
+				"server_port":        "443",
+				"socket_path":        DefaultSocketPath,
+				"trust_domain":       "td2",
+				"insecure_bootstrap": true,
+			},
+			wantPlugins: map[string]any{
+				"NodeAttestor":     map[string]any{"join_token": map[string]any{"plugin_data": map[string]any{}}},
+				"KeyManager":       map[string]any{"disk": map[string]any{"plugin_data": map[string]any{"directory": DefaultDataDir}}},
+				"WorkloadAttestor": map[string]any{"unix": map[string]any{"plugin_data": map[string]any{}}},
+			},
+		},
+		{
+			name: "no bundle endpoint",
+			trustZone: func() *trust_zone_proto.TrustZone {
+				tz := fixtures.TrustZone("tz1")
+				tz.BundleEndpointUrl = nil
+				return tz
+			}(),
+			wantErrString: "trust zone tz1 does not have a bundle endpoint URL, specify the SPIRE server address",
+		},
+		{
+			name:          "invalid node attestor",
+			trustZone:     fixtures.TrustZone("tz1"),
+			opts:          Opts{NodeAttestor: "invalid"},
+			wantErrString: "unsupported node attestor \"invalid\", expected one of join_token, x509pop, aws_iid, azure_msi, gcp_iit, k8s_psat",
+		},
+		{
+			name:          "invalid workload attestor",
+			trustZone:     fixtures.TrustZone("tz1"),
+			opts:          Opts{WorkloadAttestors: []string{"unix", "invalid"}},
+			wantErrString: "unsupported workload attestor \"invalid\", expected one of unix, docker, systemd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := Generate(tt.trustZone, tt.opts)
+			if tt.wantErrString != "" {
+				require.EqualError(t, err, tt.wantErrString)
+				return
+			}
+			require.NoError(t, err)
+
+			var config map[string]any
+			require.NoError(t, json.Unmarshal(files.Config, &config))
+			assert.Equal(t, tt.wantAgent, config["agent"])
+			assert.Equal(t, tt.wantPlugins, config["plugins"])
+
+			if tt.wantBundle {
+				block, rest := pem.Decode(files.Bundle)
+				require.NotNil(t, block)
+				assert.Equal(t, "CERTIFICATE", block.Type)
+				assert.Empty(t, rest)
+			} else {
+				assert.Nil(t, files.Bundle)
+			}
+		})
+	}
+}
+
+func TestSystemdUnit(t *testing.T) {
+	unit := string(SystemdUnit("/usr/local/bin/spire-agent", "/etc/spire"))
+	assert.Contains(t, unit, "ExecStart=/usr/local/bin/spire-agent run -config /etc/spire/agent.conf\n")
+
+	unit = string(SystemdUnit("", ""))
+	assert.Contains(t, unit, "ExecStart=/opt/spire/bin/spire-agent run -config /opt/spire/conf/agent.conf\n")
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spirecrd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+// FieldManager is the field manager used when applying resources.
+const FieldManager = "cofidectl"
+
+// MarshalYAML encodes objects as a multi-document YAML stream.
+func MarshalYAML(objects []Object) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	for _, object := range objects {
+		// Convert to unstructured content so that the encoding uses the JSON field names of the
+		// Kubernetes API types.
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s: %w", object.GetName(), err)
+		}
+		if err := encoder.Encode(content); err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", object.GetName(), err)
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Apply creates or updates objects in a cluster using server-side apply. Fields previously set by
+// another field manager are taken over.
+func Apply(ctx context.Context, client dynamic.Interface, objects []Object) error {
+	for _, object := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s: %w", object.GetName(), err)
+		}
+
+		gvr := object.GroupVersionResource()
+		_, err = client.Resource(gvr).Apply(
+			ctx,
+			object.GetName(),
+			&unstructured.Unstructured{Object: content},
+			metav1.ApplyOptions{FieldManager: FieldManager, Force: true},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to apply %s %s: %w", gvr.Resource, object.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spirecrd
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const namespaceNameLabel = "kubernetes.io/metadata.name"
+
+var templateActionRegexp = regexp.MustCompile(`{{[^}]*}}`)
+var trustDomainActionRegexp = regexp.MustCompile(`^{{\s*\.TrustDomain\s*}}$`)
+
+// EntryOwners returns the names of the resources that may have produced a registration entry in
+// a trust domain. The SPIRE controller manager does not record the resource that produced an
+// entry, so it is inferred: ClusterStaticEntries must match the entry's SPIFFE ID and parent ID,
+// while ClusterSPIFFEIDs must have a SPIFFE ID template that matches the entry's SPIFFE ID, and a
+// namespace selector that is consistent with the namespace of the entry's pod. The SPIRE
+// controller manager only uses a k8s:pod-uid selector in entries for pods, so the namespace must
+// be resolved by the caller from the pod, and is empty if the entry is not for a pod.
+func (r *Resources) EntryOwners(trustDomain, spiffeID, parentID, namespace string) []string {
+	owners := []string{}
+	for _, cse := range r.ClusterStaticEntries {
+		if cse.Spec.SPIFFEID == spiffeID && cse.Spec.ParentID == parentID {
+			owners = append(owners, cse.GetName())
+		}
+	}
+
+	if namespace == "" {
+		// ClusterSPIFFEID entries are always for a pod.
+		return owners
+	}
+
+	for _, csid := range r.ClusterSPIFFEIDs {
+		template := csid.Spec.SPIFFEIDTemplate
+		if template == "" {
+			template = DefaultSPIFFEIDTemplate
+		}
+		if !templateMatches(template, trustDomain, spiffeID) {
+			continue
+		}
+		if selector := csid.Spec.NamespaceSelector; selector != nil {
+			if name, ok := selector.MatchLabels[namespaceNameLabel]; ok && name != namespace {
+				continue
+			}
+			if !namespaceMatchesExpressions(selector.MatchExpressions, namespace) {
+				continue
+			}
+		}
+		owners = append(owners, csid.GetName())
+	}
+	return owners
+}
+
+// templateMatches returns whether a SPIFFE ID could have been rendered from a SPIFFE ID template.
+// Template actions other than the trust domain match a single path segment.
+func templateMatches(template, trustDomain, spiffeID string) bool {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, loc := range templateActionRegexp.FindAllStringIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		if trustDomainActionRegexp.MatchString(template[loc[0]:loc[1]]) {
+			pattern.WriteString(regexp.QuoteMeta(trustDomain))
+		} else {
+			pattern.WriteString("[^/]+")
+		}
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString("$")
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return false
+	}
+	return re.MatchString(spiffeID)
+}
+
+// namespaceMatchesExpressions returns whether a namespace name is consistent with any match
+// expressions on the namespace name label. Expressions on other labels are ignored.
+func namespaceMatchesExpressions(expressions []metav1.LabelSelectorRequirement, namespace string) bool {
+	for _, me := range expressions {
+		if me.Key != namespaceNameLabel {
+			continue
+		}
+		switch me.Operator {
+		case metav1.LabelSelectorOpIn:
+			if !slices.Contains(me.Values, namespace) {
+				return false
+			}
+		case metav1.LabelSelectorOpNotIn:
+			if slices.Contains(me.Values, namespace) {
+				return false
+			}
+		}
+	}
+	return true
+}
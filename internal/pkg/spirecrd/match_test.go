@@ -0,0 +1,101 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spirecrd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResources_EntryOwners(t *testing.T) {
+	resources := &Resources{
+		ClusterSPIFFEIDs: []*ClusterSPIFFEID{
+			NewClusterSPIFFEID("default", &ClusterSPIFFEIDSpec{}),
+			NewClusterSPIFFEID("ns1", &ClusterSPIFFEIDSpec{
+				SPIFFEIDTemplate: "spiffe://{{ .TrustDomain }}/ns1/{{ .PodMeta.Name }}",
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ns1"},
+				},
+			}),
+			NewClusterSPIFFEID("not-ns2", &ClusterSPIFFEIDSpec{
+				SPIFFEIDTemplate: "spiffe://{{ .TrustDomain }}/other/{{ .PodMeta.Name }}",
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "kubernetes.io/metadata.name", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"ns2"}},
+					},
+				},
+			}),
+		},
+		ClusterStaticEntries: []*ClusterStaticEntry{
+			NewClusterStaticEntry("static", &ClusterStaticEntrySpec{
+				SPIFFEID: "spiffe://td1/vm1",
+				ParentID: "spiffe://td1/spire/agent/join_token/token1",
+			}),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		spiffeID  string
+		parentID  string
+		namespace string
+		want      []string
+	}{
+		{
+			name:      "default template",
+			spiffeID:  "spiffe://td1/ns/ns2/sa/sa1",
+			namespace: "ns2",
+			want:      []string{"default"},
+		},
+		{
+			name:      "default template in other trust domain",
+			spiffeID:  "spiffe://td2/ns/ns2/sa/sa1",
+			namespace: "ns2",
+			want:      []string{},
+		},
+		{
+			name:      "namespace match labels",
+			spiffeID:  "spiffe://td1/ns1/pod1",
+			namespace: "ns1",
+			want:      []string{"ns1"},
+		},
+		{
+			name:      "namespace match labels mismatch",
+			spiffeID:  "spiffe://td1/ns1/pod1",
+			namespace: "ns2",
+			want:      []string{},
+		},
+		{
+			name:      "namespace match expressions",
+			spiffeID:  "spiffe://td1/other/pod1",
+			namespace: "ns1",
+			want:      []string{"not-ns2"},
+		},
+		{
+			name:      "namespace match expressions mismatch",
+			spiffeID:  "spiffe://td1/other/pod1",
+			namespace: "ns2",
+			want:      []string{},
+		},
+		{
+			name:     "static entry",
+			spiffeID: "spiffe://td1/vm1",
+			parentID: "spiffe://td1/spire/agent/join_token/token1",
+			want:     []string{"static"},
+		},
+		{
+			name:     "not a pod",
+			spiffeID: "spiffe://td1/ns/ns2/sa/sa1",
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resources.EntryOwners("td1", tt.spiffeID, tt.parentID, tt.namespace)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
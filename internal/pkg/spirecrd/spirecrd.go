@@ -0,0 +1,269 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spirecrd provides typed representations of the custom resources reconciled by the SPIRE
+// controller manager: ClusterSPIFFEID, ClusterStaticEntry and ClusterFederatedTrustDomain.
+package spirecrd
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	Group   = "spire.spiffe.io"
+	Version = "v1alpha1"
+
+	KindClusterSPIFFEID             = "ClusterSPIFFEID"
+	KindClusterStaticEntry          = "ClusterStaticEntry"
+	KindClusterFederatedTrustDomain = "ClusterFederatedTrustDomain"
+
+	// DefaultSPIFFEIDTemplate is the SPIFFE ID template used by the SPIRE Helm chart for
+	// ClusterSPIFFEIDs that do not specify one.
+	DefaultSPIFFEIDTemplate = "spiffe://{{ .TrustDomain }}/ns/{{ .PodMeta.Namespace }}/sa/{{ .PodSpec.ServiceAccountName }}"
+)
+
+// APIVersion is the API version of the SPIRE controller manager custom resources.
+var APIVersion = schema.GroupVersion{Group: Group, Version: Version}.String()
+
+// Object is a SPIRE controller manager custom resource.
+type Object interface {
+	// GetName returns the name of the resource.
+	GetName() string
+	// GroupVersionResource returns the API resource of the resource's kind.
+	GroupVersionResource() schema.GroupVersionResource
+}
+
+// ObjectMeta is the metadata of a cluster-scoped custom resource.
+type ObjectMeta struct {
+	Name string `json:"name"`
+}
+
+// ClusterSPIFFEID is a custom resource that describes the SPIFFE IDs to issue to pods.
+type ClusterSPIFFEID struct {
+	APIVersion string              `json:"apiVersion"`
+	Kind       string              `json:"kind"`
+	Metadata   ObjectMeta          `json:"metadata"`
+	Spec       ClusterSPIFFEIDSpec `json:"spec"`
+}
+
+type ClusterSPIFFEIDSpec struct {
+	ClassName         string                `json:"className,omitempty"`
+	SPIFFEIDTemplate  string                `json:"spiffeIDTemplate,omitempty"`
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	PodSelector       *metav1.LabelSelector `json:"podSelector,omitempty"`
+	DNSNameTemplates  []string              `json:"dnsNameTemplates,omitempty"`
+	FederatesWith     []string              `json:"federatesWith,omitempty"`
+}
+
+// NewClusterSPIFFEID returns a ClusterSPIFFEID with the specified name and spec.
+func NewClusterSPIFFEID(name string, spec *ClusterSPIFFEIDSpec) *ClusterSPIFFEID {
+	return &ClusterSPIFFEID{
+		APIVersion: APIVersion,
+		Kind:       KindClusterSPIFFEID,
+		Metadata:   ObjectMeta{Name: name},
+		Spec:       *spec,
+	}
+}
+
+func (c *ClusterSPIFFEID) GetName() string {
+	return c.Metadata.Name
+}
+
+func (c *ClusterSPIFFEID) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: Group, Version: Version, Resource: "clusterspiffeids"}
+}
+
+// HelmValues returns the spec as values for a ClusterSPIFFEID in the SPIRE Helm chart.
+// The class name is set by the chart, so is omitted.
+func (s *ClusterSPIFFEIDSpec) HelmValues() map[string]any {
+	values := map[string]any{}
+	if s.NamespaceSelector != nil {
+		values["namespaceSelector"] = labelSelectorHelmValues(s.NamespaceSelector)
+	}
+	if s.PodSelector != nil {
+		values["podSelector"] = labelSelectorHelmValues(s.PodSelector)
+	}
+	if s.SPIFFEIDTemplate != "" {
+		values["spiffeIDTemplate"] = s.SPIFFEIDTemplate
+	}
+	if len(s.DNSNameTemplates) > 0 {
+		values["dnsNameTemplates"] = s.DNSNameTemplates
+	}
+	if len(s.FederatesWith) > 0 {
+		values["federatesWith"] = s.FederatesWith
+	}
+	return values
+}
+
+func labelSelectorHelmValues(selector *metav1.LabelSelector) map[string]any {
+	matchLabels := map[string]any{}
+	for k, v := range selector.MatchLabels {
+		matchLabels[k] = v
+	}
+
+	matchExpressions := []map[string]any{}
+	for _, me := range selector.MatchExpressions {
+		matchExpressions = append(matchExpressions, map[string]any{
+			"key":      me.Key,
+			"operator": string(me.Operator),
+			"values":   me.Values,
+		})
+	}
+
+	return map[string]any{
+		"matchLabels":      matchLabels,
+		"matchExpressions": matchExpressions,
+	}
+}
+
+// ClusterStaticEntry is a custom resource that describes a static registration entry.
+type ClusterStaticEntry struct {
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Metadata   ObjectMeta             `json:"metadata"`
+	Spec       ClusterStaticEntrySpec `json:"spec"`
+}
+
+type ClusterStaticEntrySpec struct {
+	ClassName     string   `json:"className,omitempty"`
+	SPIFFEID      string   `json:"spiffeID"`
+	ParentID      string   `json:"parentID"`
+	Selectors     []string `json:"selectors"`
+	DNSNames      []string `json:"dnsNames,omitempty"`
+	FederatesWith []string `json:"federatesWith,omitempty"`
+}
+
+// NewClusterStaticEntry returns a ClusterStaticEntry with the specified name and spec.
+func NewClusterStaticEntry(name string, spec *ClusterStaticEntrySpec) *ClusterStaticEntry {
+	return &ClusterStaticEntry{
+		APIVersion: APIVersion,
+		Kind:       KindClusterStaticEntry,
+		Metadata:   ObjectMeta{Name: name},
+		Spec:       *spec,
+	}
+}
+
+func (c *ClusterStaticEntry) GetName() string {
+	return c.Metadata.Name
+}
+
+func (c *ClusterStaticEntry) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: Group, Version: Version, Resource: "clusterstaticentries"}
+}
+
+// HelmValues returns the spec as values for a ClusterStaticEntry in the SPIRE Helm chart.
+// The class name is set by the chart, so is omitted.
+func (s *ClusterStaticEntrySpec) HelmValues() map[string]any {
+	values := map[string]any{
+		"spiffeID":  s.SPIFFEID,
+		"parentID":  s.ParentID,
+		"selectors": s.Selectors,
+	}
+	if len(s.DNSNames) > 0 {
+		values["dnsNames"] = s.DNSNames
+	}
+	if len(s.FederatesWith) > 0 {
+		values["federatesWith"] = s.FederatesWith
+	}
+	return values
+}
+
+// ClusterFederatedTrustDomain is a custom resource that describes a federated trust domain.
+type ClusterFederatedTrustDomain struct {
+	APIVersion string                          `json:"apiVersion"`
+	Kind       string                          `json:"kind"`
+	Metadata   ObjectMeta                      `json:"metadata"`
+	Spec       ClusterFederatedTrustDomainSpec `json:"spec"`
+}
+
+type ClusterFederatedTrustDomainSpec struct {
+	ClassName             string                `json:"className,omitempty"`
+	TrustDomain           string                `json:"trustDomain"`
+	BundleEndpointURL     string                `json:"bundleEndpointURL"`
+	BundleEndpointProfile BundleEndpointProfile `json:"bundleEndpointProfile"`
+	TrustDomainBundle     string                `json:"trustDomainBundle,omitempty"`
+}
+
+type BundleEndpointProfile struct {
+	Type             string `json:"type"`
+	EndpointSPIFFEID string `json:"endpointSPIFFEID,omitempty"`
+}
+
+// NewClusterFederatedTrustDomain returns a ClusterFederatedTrustDomain with the specified name and
+// spec.
+func NewClusterFederatedTrustDomain(name string, spec *ClusterFederatedTrustDomainSpec) *ClusterFederatedTrustDomain {
+	return &ClusterFederatedTrustDomain{
+		APIVersion: APIVersion,
+		Kind:       KindClusterFederatedTrustDomain,
+		Metadata:   ObjectMeta{Name: name},
+		Spec:       *spec,
+	}
+}
+
+func (c *ClusterFederatedTrustDomain) GetName() string {
+	return c.Metadata.Name
+}
+
+func (c *ClusterFederatedTrustDomain) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: Group, Version: Version, Resource: "clusterfederatedtrustdomains"}
+}
+
+// HelmValues returns the spec as values for a ClusterFederatedTrustDomain in the SPIRE Helm chart.
+// The class name is set by the chart, so is omitted.
+func (s *ClusterFederatedTrustDomainSpec) HelmValues() map[string]any {
+	profile := map[string]any{
+		"type": s.BundleEndpointProfile.Type,
+	}
+	if s.BundleEndpointProfile.EndpointSPIFFEID != "" {
+		profile["endpointSPIFFEID"] = s.BundleEndpointProfile.EndpointSPIFFEID
+	}
+	values := map[string]any{
+		"bundleEndpointURL":     s.BundleEndpointURL,
+		"bundleEndpointProfile": profile,
+		"trustDomain":           s.TrustDomain,
+	}
+	if s.TrustDomainBundle != "" {
+		values["trustDomainBundle"] = s.TrustDomainBundle
+	}
+	return values
+}
+
+// Resources is the set of SPIRE controller manager custom resources for a trust zone.
+type Resources struct {
+	ClusterSPIFFEIDs             []*ClusterSPIFFEID
+	ClusterStaticEntries         []*ClusterStaticEntry
+	ClusterFederatedTrustDomains []*ClusterFederatedTrustDomain
+}
+
+// Objects returns all resources in the set.
+func (r *Resources) Objects() []Object {
+	objects := []Object{}
+	for _, csid := range r.ClusterSPIFFEIDs {
+		objects = append(objects, csid)
+	}
+	for _, cse := range r.ClusterStaticEntries {
+		objects = append(objects, cse)
+	}
+	for _, cftd := range r.ClusterFederatedTrustDomains {
+		objects = append(objects, cftd)
+	}
+	return objects
+}
+
+// SetDefaults sets the fields that the SPIRE Helm chart would otherwise populate, so that the
+// resources may be applied directly to a cluster. className may be empty.
+func (r *Resources) SetDefaults(className string) {
+	for _, csid := range r.ClusterSPIFFEIDs {
+		csid.Spec.ClassName = className
+		if csid.Spec.SPIFFEIDTemplate == "" {
+			csid.Spec.SPIFFEIDTemplate = DefaultSPIFFEIDTemplate
+		}
+	}
+	for _, cse := range r.ClusterStaticEntries {
+		cse.Spec.ClassName = className
+	}
+	for _, cftd := range r.ClusterFederatedTrustDomains {
+		cftd.Spec.ClassName = className
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spirecrd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestResources_SetDefaults(t *testing.T) {
+	resources := &Resources{
+		ClusterSPIFFEIDs: []*ClusterSPIFFEID{
+			NewClusterSPIFFEID("csid1", &ClusterSPIFFEIDSpec{}),
+			NewClusterSPIFFEID("csid2", &ClusterSPIFFEIDSpec{SPIFFEIDTemplate: "spiffe://{{ .TrustDomain }}/foo"}),
+		},
+		ClusterStaticEntries: []*ClusterStaticEntry{
+			NewClusterStaticEntry("cse1", &ClusterStaticEntrySpec{}),
+		},
+		ClusterFederatedTrustDomains: []*ClusterFederatedTrustDomain{
+			NewClusterFederatedTrustDomain("cftd1", &ClusterFederatedTrustDomainSpec{}),
+		},
+	}
+
+	resources.SetDefaults("fake-class")
+
+	assert.Equal(t, "fake-class", resources.ClusterSPIFFEIDs[0].Spec.ClassName)
+	assert.Equal(t, DefaultSPIFFEIDTemplate, resources.ClusterSPIFFEIDs[0].Spec.SPIFFEIDTemplate)
+	assert.Equal(t, "fake-class", resources.ClusterSPIFFEIDs[1].Spec.ClassName)
+	assert.Equal(t, "spiffe://{{ .TrustDomain }}/foo", resources.ClusterSPIFFEIDs[1].Spec.SPIFFEIDTemplate)
+	assert.Equal(t, "fake-class", resources.ClusterStaticEntries[0].Spec.ClassName)
+	assert.Equal(t, "fake-class", resources.ClusterFederatedTrustDomains[0].Spec.ClassName)
+}
+
+func TestApply(t *testing.T) {
+	objects := []Object{
+		NewClusterSPIFFEID("csid1", &ClusterSPIFFEIDSpec{SPIFFEIDTemplate: DefaultSPIFFEIDTemplate}),
+		NewClusterStaticEntry("cse1", &ClusterStaticEntrySpec{
+			SPIFFEID:  "spiffe://td1/foo",
+			ParentID:  "spiffe://td1/spire/agent/bar",
+			Selectors: []string{"k8s:ns:foo"},
+		}),
+		NewClusterFederatedTrustDomain("cftd1", &ClusterFederatedTrustDomainSpec{
+			TrustDomain:           "td2",
+			BundleEndpointURL:     "https://127.0.0.2",
+			BundleEndpointProfile: BundleEndpointProfile{Type: "https_web"},
+		}),
+	}
+
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	client.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+
+	err := Apply(context.Background(), client, objects)
+	require.NoError(t, err)
+
+	actions := client.Actions()
+	require.Len(t, actions, 3)
+	want := []struct {
+		resource string
+		kind     string
+	}{
+		{resource: "clusterspiffeids", kind: KindClusterSPIFFEID},
+		{resource: "clusterstaticentries", kind: KindClusterStaticEntry},
+		{resource: "clusterfederatedtrustdomains", kind: KindClusterFederatedTrustDomain},
+	}
+	for i, action := range actions {
+		patch, ok := action.(k8stesting.PatchAction)
+		require.True(t, ok)
+		assert.Equal(t, types.ApplyPatchType, patch.GetPatchType())
+		assert.Equal(t, want[i].resource, patch.GetResource().Resource)
+		assert.Equal(t, objects[i].GetName(), patch.GetName())
+		assert.Contains(t, string(patch.GetPatch()), `"kind":"`+want[i].kind+`"`)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package svid
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/bundle/spiffebundle"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/jwtsvid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+)
+
+const (
+	TypeX509 = "x509"
+	TypeJWT  = "jwt"
+)
+
+// Inspection contains the decoded contents of an SVID and the result of verifying it against a
+// set of trust bundles.
+type Inspection struct {
+	Type        string
+	SPIFFEID    string
+	TrustDomain string
+	NotBefore   time.Time
+	NotAfter    time.Time
+
+	// X.509-SVID fields.
+	SerialNumber string
+	KeyUsage     []string
+	ExtKeyUsage  []string
+	DNSNames     []string
+	ChainLength  int
+
+	// JWT-SVID fields.
+	Algorithm string
+	KeyID     string
+	Audience  []string
+	// AudienceChecked records whether the audience of a JWT-SVID was verified.
+	AudienceChecked bool
+
+	// VerifyErr is the reason verification failed, or nil if the SVID is valid.
+	VerifyErr error
+}
+
+// Valid returns whether the SVID was successfully verified.
+func (i *Inspection) Valid() bool {
+	return i.VerifyErr == nil
+}
+
+// Inspect decodes an X.509-SVID PEM chain or a JWT-SVID token and verifies it against the trust
+// bundles. The audience is only checked for JWT-SVIDs, and only if non-empty. An error is
+// returned if the data cannot be decoded; verification failures are recorded in the inspection.
+func Inspect(data []byte, bundles *spiffebundle.Set, audience []string) (*Inspection, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("-----BEGIN")) {
+		return InspectX509(data, bundles)
+	}
+	return InspectJWT(string(data), bundles, audience)
+}
+
+// InspectX509 decodes an X.509-SVID PEM chain, leaf certificate first, and verifies it against
+// the X.509 authorities of the trust bundles.
+func InspectX509(pemData []byte, bundles *spiffebundle.Set) (*Inspection, error) {
+	certs := []*x509.Certificate{}
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM certificates found")
+	}
+
+	leaf := certs[0]
+	id, err := x509svid.IDFromCert(leaf)
+	if err != nil {
+		return nil, fmt.Errorf("certificate is not an X.509-SVID: %w", err)
+	}
+
+	inspection := &Inspection{
+		Type:         TypeX509,
+		SPIFFEID:     id.String(),
+		TrustDomain:  id.TrustDomain().Name(),
+		NotBefore:    leaf.NotBefore,
+		NotAfter:     leaf.NotAfter,
+		SerialNumber: leaf.SerialNumber.Text(16),
+		KeyUsage:     keyUsageNames(leaf.KeyUsage),
+		ExtKeyUsage:  extKeyUsageNames(leaf.ExtKeyUsage),
+		DNSNames:     leaf.DNSNames,
+		ChainLength:  len(certs),
+	}
+
+	_, _, inspection.VerifyErr = x509svid.Verify(certs, bundles)
+	return inspection, nil
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Subject  string          `json:"sub"`
+	Audience json.RawMessage `json:"aud"`
+	Expiry   int64           `json:"exp"`
+	IssuedAt int64           `json:"iat"`
+}
+
+// InspectJWT decodes a JWT-SVID token and verifies its signature and expiry against the JWT
+// authorities of the trust bundles. If the audience is non-empty, the token must contain at
+// least one of its values.
+func InspectJWT(token string, bundles *spiffebundle.Set, audience []string) (*Inspection, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("data is neither a PEM certificate chain nor a JWT")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("failed to decode JWT header: %w", err)
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("failed to decode JWT claims: %w", err)
+	}
+
+	tokenAudience, err := decodeAudience(claims.Audience)
+	if err != nil {
+		return nil, err
+	}
+
+	inspection := &Inspection{
+		Type:            TypeJWT,
+		SPIFFEID:        claims.Subject,
+		Algorithm:       header.Algorithm,
+		KeyID:           header.KeyID,
+		Audience:        tokenAudience,
+		AudienceChecked: len(audience) > 0,
+	}
+	if claims.IssuedAt != 0 {
+		inspection.NotBefore = time.Unix(claims.IssuedAt, 0).UTC()
+	}
+	if claims.Expiry != 0 {
+		inspection.NotAfter = time.Unix(claims.Expiry, 0).UTC()
+	}
+
+	if id, err := spiffeid.FromString(claims.Subject); err == nil {
+		inspection.TrustDomain = id.TrustDomain().Name()
+	}
+
+	_, inspection.VerifyErr = jwtsvid.ParseAndValidate(token, bundles, audience)
+	return inspection, nil
+}
+
+func decodeJWTPart(part string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// decodeAudience decodes a JWT aud claim, which may be a single string or an array of strings.
+func decodeAudience(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}, nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(raw, &multiple); err != nil {
+		return nil, fmt.Errorf("failed to decode JWT audience: %w", err)
+	}
+	return multiple, nil
+}
+
+var keyUsages = []struct {
+	usage x509.KeyUsage
+	name  string
+}{
+	{x509.KeyUsageDigitalSignature, "digitalSignature"},
+	{x509.KeyUsageContentCommitment, "contentCommitment"},
+	{x509.KeyUsageKeyEncipherment, "keyEncipherment"},
+	{x509.KeyUsageDataEncipherment, "dataEncipherment"},
+	{x509.KeyUsageKeyAgreement, "keyAgreement"},
+	{x509.KeyUsageCertSign, "keyCertSign"},
+	{x509.KeyUsageCRLSign, "cRLSign"},
+	{x509.KeyUsageEncipherOnly, "encipherOnly"},
+	{x509.KeyUsageDecipherOnly, "decipherOnly"},
+}
+
+func keyUsageNames(usage x509.KeyUsage) []string {
+	names := []string{}
+	for _, ku := range keyUsages {
+		if usage&ku.usage != 0 {
+			names = append(names, ku.name)
+		}
+	}
+	return names
+}
+
+var extKeyUsages = map[x509.ExtKeyUsage]string{
+	x509.ExtKeyUsageAny:             "any",
+	x509.ExtKeyUsageServerAuth:      "serverAuth",
+	x509.ExtKeyUsageClientAuth:      "clientAuth",
+	x509.ExtKeyUsageCodeSigning:     "codeSigning",
+	x509.ExtKeyUsageEmailProtection: "emailProtection",
+	x509.ExtKeyUsageTimeStamping:    "timeStamping",
+	x509.ExtKeyUsageOCSPSigning:     "OCSPSigning",
+}
+
+func extKeyUsageNames(usages []x509.ExtKeyUsage) []string {
+	names := []string{}
+	for _, usage := range usages {
+		name, ok := extKeyUsages[usage]
+		if !ok {
+			name = fmt.Sprintf("unknown(%d)", usage)
+		}
+		names = append(names, name)
+	}
+	return names
+}
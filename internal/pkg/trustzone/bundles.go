@@ -0,0 +1,60 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package trustzone
+
+import (
+	"fmt"
+
+	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/spiffe"
+	"github.com/spiffe/go-spiffe/v2/bundle/spiffebundle"
+)
+
+// GetTrustBundles returns the trust bundles stored in the config. If a trust zone name is
+// specified, the set contains the bundles of that trust zone and the trust zones it federates
+// with, otherwise it contains the bundles of all trust zones. Trust zones without a stored
+// bundle are skipped.
+func GetTrustBundles(ds datasource.DataSource, tzName string) (*spiffebundle.Set, error) {
+	var trustZones []*trust_zone_proto.TrustZone
+	if tzName == "" {
+		var err error
+		trustZones, err = ds.ListTrustZones()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		trustZone, err := ds.GetTrustZoneByName(tzName)
+		if err != nil {
+			return nil, err
+		}
+		trustZones = append(trustZones, trustZone)
+
+		federations, err := ds.ListFederations(&datasourcepb.ListFederationsRequest_Filter{TrustZoneId: trustZone.Id})
+		if err != nil {
+			return nil, err
+		}
+		for _, fed := range federations {
+			remote, err := ds.GetTrustZone(fed.GetRemoteTrustZoneId())
+			if err != nil {
+				return nil, err
+			}
+			trustZones = append(trustZones, remote)
+		}
+	}
+
+	bundles := spiffebundle.NewSet()
+	for _, trustZone := range trustZones {
+		if trustZone.GetBundle() == nil {
+			continue
+		}
+		bundle, err := spiffe.GetSPIFFETrustBundle(trustZone.GetBundle())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bundle of trust zone %s: %w", trustZone.GetName(), err)
+		}
+		bundles.Add(bundle)
+	}
+	return bundles, nil
+}
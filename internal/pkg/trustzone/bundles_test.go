@@ -0,0 +1,65 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package trustzone
+
+import (
+	"testing"
+
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrustBundles(t *testing.T) {
+	tz2 := fixtures.TrustZone("tz2")
+	tz2.Bundle = fixtures.TrustZone("tz1").Bundle
+	tz2.Bundle.TrustDomain = "td2"
+
+	cfg := &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+			tz2,
+			fixtures.TrustZone("tz3"),
+		},
+		Federations: []*federation_proto.Federation{
+			fixtures.Federation("fed1"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+	configLoader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	ds, err := local.NewLocalDataSource(configLoader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		trustZone string
+		want      []string
+		wantErr   string
+	}{
+		{name: "all trust zones", want: []string{"td1", "td2"}},
+		{name: "trust zone with federation", trustZone: "tz1", want: []string{"td1", "td2"}},
+		{name: "trust zone without bundle", trustZone: "tz3", want: []string{}},
+		{name: "unknown trust zone", trustZone: "invalid", wantErr: "failed to find trust zone invalid in local config"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetTrustBundles(ds, tt.trustZone)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			trustDomains := []string{}
+			for _, bundle := range got.Bundles() {
+				trustDomains = append(trustDomains, bundle.TrustDomain().Name())
+			}
+			assert.Equal(t, tt.want, trustDomains)
+		})
+	}
+}
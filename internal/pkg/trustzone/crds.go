@@ -0,0 +1,72 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package trustzone
+
+import (
+	"fmt"
+
+	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/attestationpolicy"
+	"github.com/cofide/cofidectl/internal/pkg/federation"
+	"github.com/cofide/cofidectl/internal/pkg/spirecrd"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+)
+
+// GetSPIRECRDs returns the SPIRE controller manager custom resources for a trust zone.
+// Attestation policy bindings become ClusterSPIFFEID or ClusterStaticEntry resources named after
+// the policy, and federations with trust zones that have a bundle endpoint URL become
+// ClusterFederatedTrustDomain resources named after the remote trust zone.
+func GetSPIRECRDs(trustZone *trust_zone_proto.TrustZone, ds datasource.DataSource) (*spirecrd.Resources, error) {
+	resources := &spirecrd.Resources{}
+
+	bindings, err := ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{TrustZoneId: trustZone.Id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attestation policy bindings: %w", err)
+	}
+
+	for _, binding := range bindings {
+		policy, err := ds.GetAttestationPolicy(binding.GetPolicyId())
+		if err != nil {
+			return nil, err
+		}
+
+		if kubernetes := policy.GetKubernetes(); kubernetes != nil {
+			spec, err := attestationpolicy.MakeClusterSPIFFEID(kubernetes, ds, binding)
+			if err != nil {
+				return nil, err
+			}
+			resources.ClusterSPIFFEIDs = append(resources.ClusterSPIFFEIDs, spirecrd.NewClusterSPIFFEID(policy.GetName(), spec))
+		} else if static := policy.GetStatic(); static != nil {
+			spec, err := attestationpolicy.MakeClusterStaticEntry(static, ds, binding)
+			if err != nil {
+				return nil, err
+			}
+			resources.ClusterStaticEntries = append(resources.ClusterStaticEntries, spirecrd.NewClusterStaticEntry(policy.GetName(), spec))
+		}
+	}
+
+	federations, err := ds.ListFederations(&datasourcepb.ListFederationsRequest_Filter{TrustZoneId: trustZone.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fed := range federations {
+		remote, err := ds.GetTrustZone(fed.GetRemoteTrustZoneId())
+		if err != nil {
+			return nil, err
+		}
+
+		if remote.GetBundleEndpointUrl() == "" {
+			continue
+		}
+
+		spec, err := federation.NewFederation(remote).GetClusterFederatedTrustDomainSpec()
+		if err != nil {
+			return nil, err
+		}
+		resources.ClusterFederatedTrustDomains = append(resources.ClusterFederatedTrustDomains, spirecrd.NewClusterFederatedTrustDomain(remote.GetName(), spec))
+	}
+	return resources, nil
+}
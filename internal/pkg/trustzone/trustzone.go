@@ -10,6 +10,7 @@ import (
 	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
 	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 )
 
@@ -57,3 +58,28 @@ func ResolveCluster(trustZone *trust_zone_proto.TrustZone, clusterName string, d
 	}
 	return clusters[0], nil
 }
+
+// GetServerClient returns a trust zone and a Kubernetes client for the cluster running its SPIRE
+// server, resolving the cluster as ResolveCluster does. An error is returned if the cluster uses
+// an external SPIRE server.
+func GetServerClient(ds datasource.DataSource, kubeConfig, tzName, clusterName string) (*trust_zone_proto.TrustZone, *kubeutil.Client, error) {
+	trustZone, err := ds.GetTrustZoneByName(tzName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cluster, err := ResolveCluster(trustZone, clusterName, ds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cluster.GetExternalServer() {
+		return nil, nil, fmt.Errorf("cluster %s uses an external SPIRE server", cluster.GetName())
+	}
+
+	client, err := kubeutil.NewKubeClientFromSpecifiedContext(kubeConfig, cluster.GetKubernetesContext())
+	if err != nil {
+		return nil, nil, err
+	}
+	return trustZone, client, nil
+}
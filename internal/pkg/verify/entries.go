@@ -0,0 +1,403 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package verify compares the state of SPIRE in a trust zone's cluster with the state expected
+// from the Cofide configuration.
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/cofide/cofidectl/internal/pkg/spirecrd"
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const k8sPodUIDSelectorPrefix = "k8s:pod-uid:"
+
+// joinTokenAgentPathPrefix is the path prefix of the SPIFFE IDs of agents attested using the
+// join_token node attestor.
+const joinTokenAgentPathPrefix = "/spire/agent/join_token/"
+
+// DefaultIgnoredNamespaces are the namespaces ignored by the SPIRE controller manager in the SPIRE
+// Helm chart. Each is a regular expression that, as in the SPIRE controller manager, may match any
+// part of the namespace name.
+var DefaultIgnoredNamespaces = []string{"kube-system", "kube-public", "local-path-storage", "openshift-*"}
+
+// ClusterState contains the Kubernetes resources that determine the registration entries produced
+// by ClusterSPIFFEIDs.
+type ClusterState struct {
+	Namespaces []corev1.Namespace
+	Pods       []corev1.Pod
+	Nodes      []corev1.Node
+}
+
+// GetClusterState lists the namespaces, pods and nodes in a cluster.
+func GetClusterState(ctx context.Context, client *kubeutil.Client) (*ClusterState, error) {
+	namespaces, err := client.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	pods, err := client.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	nodes, err := client.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	return &ClusterState{
+		Namespaces: namespaces.Items,
+		Pods:       pods.Items,
+		Nodes:      nodes.Items,
+	}, nil
+}
+
+// ExpectedEntry is a registration entry that is expected to exist.
+type ExpectedEntry struct {
+	// Owner is the name of the custom resource that produces the entry.
+	Owner    string
+	SPIFFEID string
+	// ParentID is empty for entries produced by ClusterSPIFFEIDs, since it depends on the agent
+	// that attested the pod's node.
+	ParentID      string
+	Selectors     []string
+	DNSNames      []string
+	FederatesWith []string
+}
+
+// key returns a key that identifies the workload of an entry, independent of its SPIFFE ID.
+func (e *ExpectedEntry) key() string {
+	return entryKey(e.ParentID, e.Selectors)
+}
+
+// templateData is the data available to ClusterSPIFFEID templates, as in the SPIRE controller
+// manager.
+type templateData struct {
+	TrustDomain string
+	ClusterName string
+	PodMeta     *metav1.ObjectMeta
+	PodSpec     *corev1.PodSpec
+	NodeMeta    *metav1.ObjectMeta
+	NodeSpec    *corev1.NodeSpec
+}
+
+// ExpectedEntries returns the registration entries that the SPIRE controller manager is expected
+// to produce for a set of custom resources. Entries for ClusterSPIFFEIDs are computed from the
+// scheduled, non-terminated pods in the cluster state, excluding pods in ignored namespaces.
+func ExpectedEntries(resources *spirecrd.Resources, trustDomain, clusterName string, state *ClusterState, ignoredNamespaces []string) ([]*ExpectedEntry, error) {
+	expected := []*ExpectedEntry{}
+	for _, cse := range resources.ClusterStaticEntries {
+		expected = append(expected, &ExpectedEntry{
+			Owner:         cse.GetName(),
+			SPIFFEID:      cse.Spec.SPIFFEID,
+			ParentID:      cse.Spec.ParentID,
+			Selectors:     cse.Spec.Selectors,
+			DNSNames:      cse.Spec.DNSNames,
+			FederatesWith: cse.Spec.FederatesWith,
+		})
+	}
+
+	ignored, err := compileIgnoredNamespaces(ignoredNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := map[string]*corev1.Namespace{}
+	for i := range state.Namespaces {
+		namespaces[state.Namespaces[i].Name] = &state.Namespaces[i]
+	}
+	nodes := map[string]*corev1.Node{}
+	for i := range state.Nodes {
+		nodes[state.Nodes[i].Name] = &state.Nodes[i]
+	}
+
+	for _, csid := range resources.ClusterSPIFFEIDs {
+		namespaceSelector, err := asSelector(csid.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector in %s: %w", csid.GetName(), err)
+		}
+		podSelector, err := asSelector(csid.Spec.PodSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pod selector in %s: %w", csid.GetName(), err)
+		}
+		spiffeIDTemplate := csid.Spec.SPIFFEIDTemplate
+		if spiffeIDTemplate == "" {
+			spiffeIDTemplate = spirecrd.DefaultSPIFFEIDTemplate
+		}
+
+		for i := range state.Pods {
+			pod := &state.Pods[i]
+			if !isPodRegistrable(pod) || isIgnored(ignored, pod.Namespace) {
+				continue
+			}
+			namespace, ok := namespaces[pod.Namespace]
+			if !ok || !namespaceSelector.Matches(labels.Set(namespace.Labels)) {
+				continue
+			}
+			if !podSelector.Matches(labels.Set(pod.Labels)) {
+				continue
+			}
+
+			data := &templateData{
+				TrustDomain: trustDomain,
+				ClusterName: clusterName,
+				PodMeta:     &pod.ObjectMeta,
+				PodSpec:     &pod.Spec,
+			}
+			if node, ok := nodes[pod.Spec.NodeName]; ok {
+				data.NodeMeta = &node.ObjectMeta
+				data.NodeSpec = &node.Spec
+			}
+
+			spiffeID, err := renderTemplate(spiffeIDTemplate, data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render SPIFFE ID template of %s for pod %s/%s: %w", csid.GetName(), pod.Namespace, pod.Name, err)
+			}
+			dnsNames := []string{}
+			for _, dnsNameTemplate := range csid.Spec.DNSNameTemplates {
+				dnsName, err := renderTemplate(dnsNameTemplate, data)
+				if err != nil {
+					return nil, fmt.Errorf("failed to render DNS name template of %s for pod %s/%s: %w", csid.GetName(), pod.Namespace, pod.Name, err)
+				}
+				if !slices.Contains(dnsNames, dnsName) {
+					dnsNames = append(dnsNames, dnsName)
+				}
+			}
+
+			expected = append(expected, &ExpectedEntry{
+				Owner:         csid.GetName(),
+				SPIFFEID:      spiffeID,
+				Selectors:     []string{k8sPodUIDSelectorPrefix + string(pod.UID)},
+				DNSNames:      dnsNames,
+				FederatesWith: csid.Spec.FederatesWith,
+			})
+		}
+	}
+	return expected, nil
+}
+
+// isPodRegistrable returns whether the SPIRE controller manager registers a pod: it must be
+// scheduled to a node and not terminated.
+func isPodRegistrable(pod *corev1.Pod) bool {
+	if pod.Spec.NodeName == "" {
+		return false
+	}
+	return pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
+func asSelector(selector *metav1.LabelSelector) (labels.Selector, error) {
+	// A nil label selector in a ClusterSPIFFEID matches everything.
+	if selector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+func compileIgnoredNamespaces(namespaces []string) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		re, err := regexp.Compile(namespace)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignored namespace %q: %w", namespace, err)
+		}
+		result = append(result, re)
+	}
+	return result, nil
+}
+
+func isIgnored(ignored []*regexp.Regexp, namespace string) bool {
+	for _, re := range ignored {
+		if re.MatchString(namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func renderTemplate(text string, data *templateData) (string, error) {
+	tmpl, err := template.New("").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Mismatch is a registration entry that exists, but differs from the expected entry.
+type Mismatch struct {
+	Expected *ExpectedEntry
+	Actual   *spire.Entry
+	// Differences describes each field that differs.
+	Differences []string
+}
+
+// EntriesResult is the result of comparing expected and actual registration entries.
+type EntriesResult struct {
+	Missing    []*ExpectedEntry
+	Unexpected []*spire.Entry
+	Mismatched []*Mismatch
+	// Unmanaged contains entries that are not managed by the SPIRE controller manager, such as the
+	// entries created by SPIRE to alias agents attested using join tokens. They are not drift.
+	Unmanaged []*spire.Entry
+}
+
+// HasDrift returns whether the actual registration entries differ from the expected entries.
+func (r *EntriesResult) HasDrift() bool {
+	return len(r.Missing) > 0 || len(r.Unexpected) > 0 || len(r.Mismatched) > 0
+}
+
+// CompareEntries compares expected registration entries with the actual entries in a SPIRE
+// server. Entries are paired by workload: entries for pods by their pod UID selector, and other
+// entries by their parent ID and selectors. Actual entries for pods in ignored namespaces are not
+// reported as unexpected; the namespace of a pod is taken from the cluster state. Actual entries
+// that are not managed by the SPIRE controller manager are reported as unmanaged.
+func CompareEntries(expected []*ExpectedEntry, actual []*spire.Entry, state *ClusterState, ignoredNamespaces []string) (*EntriesResult, error) {
+	ignored, err := compileIgnoredNamespaces(ignoredNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	podNamespaces := map[string]string{}
+	for _, pod := range state.Pods {
+		podNamespaces[string(pod.UID)] = pod.Namespace
+	}
+
+	byKey := map[string][]*spire.Entry{}
+	for _, entry := range actual {
+		key := entryKey(entry.ParentID, entry.Selectors)
+		byKey[key] = append(byKey[key], entry)
+	}
+	used := map[*spire.Entry]bool{}
+
+	result := &EntriesResult{
+		Missing:    []*ExpectedEntry{},
+		Unexpected: []*spire.Entry{},
+		Mismatched: []*Mismatch{},
+		Unmanaged:  []*spire.Entry{},
+	}
+
+	// Pair entries with matching SPIFFE IDs first, so that a workload with several expected
+	// entries is not reported as mismatched.
+	unpaired := []*ExpectedEntry{}
+	for _, exp := range expected {
+		if entry := findUnused(byKey[exp.key()], used, exp.SPIFFEID); entry != nil {
+			used[entry] = true
+			if differences := compareEntry(exp, entry); len(differences) > 0 {
+				result.Mismatched = append(result.Mismatched, &Mismatch{Expected: exp, Actual: entry, Differences: differences})
+			}
+			continue
+		}
+		unpaired = append(unpaired, exp)
+	}
+
+	for _, exp := range unpaired {
+		if entry := findUnused(byKey[exp.key()], used, ""); entry != nil {
+			used[entry] = true
+			result.Mismatched = append(result.Mismatched, &Mismatch{Expected: exp, Actual: entry, Differences: compareEntry(exp, entry)})
+			continue
+		}
+		result.Missing = append(result.Missing, exp)
+	}
+
+	for _, entry := range actual {
+		if used[entry] {
+			continue
+		}
+		if namespace := podNamespaces[getPodUID(entry.Selectors)]; namespace != "" && isIgnored(ignored, namespace) {
+			continue
+		}
+		if isJoinTokenAlias(entry) {
+			result.Unmanaged = append(result.Unmanaged, entry)
+			continue
+		}
+		result.Unexpected = append(result.Unexpected, entry)
+	}
+	return result, nil
+}
+
+// isJoinTokenAlias returns whether an entry is one created by SPIRE when generating a join token
+// with a SPIFFE ID, e.g. using cofidectl agent token create --spiffe-id. Such an entry is
+// parented by the agent attested using the token, and selects it by its SPIFFE ID.
+func isJoinTokenAlias(entry *spire.Entry) bool {
+	parentID, err := spiffeid.FromString(entry.ParentID)
+	if err != nil || !strings.HasPrefix(parentID.Path(), joinTokenAgentPathPrefix) {
+		return false
+	}
+	return slices.Equal(entry.Selectors, []string{"spiffe_id:" + entry.ParentID})
+}
+
+// findUnused returns the first entry that has not been used and, if spiffeID is non-empty, has
+// that SPIFFE ID.
+func findUnused(entries []*spire.Entry, used map[*spire.Entry]bool, spiffeID string) *spire.Entry {
+	for _, entry := range entries {
+		if used[entry] || (spiffeID != "" && entry.SPIFFEID != spiffeID) {
+			continue
+		}
+		return entry
+	}
+	return nil
+}
+
+func compareEntry(expected *ExpectedEntry, actual *spire.Entry) []string {
+	differences := []string{}
+	if expected.SPIFFEID != actual.SPIFFEID {
+		differences = append(differences, fmt.Sprintf("SPIFFE ID: expected %s, got %s", expected.SPIFFEID, actual.SPIFFEID))
+	}
+	if expected.ParentID != "" && expected.ParentID != actual.ParentID {
+		differences = append(differences, fmt.Sprintf("parent ID: expected %s, got %s", expected.ParentID, actual.ParentID))
+	}
+	if !equalSets(expected.Selectors, actual.Selectors) {
+		differences = append(differences, fmt.Sprintf("selectors: expected %s, got %s", formatList(expected.Selectors), formatList(actual.Selectors)))
+	}
+	if !equalSets(expected.DNSNames, actual.DNSNames) {
+		differences = append(differences, fmt.Sprintf("DNS names: expected %s, got %s", formatList(expected.DNSNames), formatList(actual.DNSNames)))
+	}
+	if !equalSets(expected.FederatesWith, actual.FederatesWith) {
+		differences = append(differences, fmt.Sprintf("federatesWith: expected %s, got %s", formatList(expected.FederatesWith), formatList(actual.FederatesWith)))
+	}
+	return differences
+}
+
+func equalSets(a, b []string) bool {
+	a = slices.Compact(slices.Sorted(slices.Values(a)))
+	b = slices.Compact(slices.Sorted(slices.Values(b)))
+	return slices.Equal(a, b)
+}
+
+func formatList(values []string) string {
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// entryKey returns a key for the workload of an entry. Entries for pods are identified by their
+// pod UID selector alone, since their parent ID depends on the agent that attested the pod's node.
+func entryKey(parentID string, selectors []string) string {
+	for _, selector := range selectors {
+		if strings.HasPrefix(selector, k8sPodUIDSelectorPrefix) {
+			return selector
+		}
+	}
+	return parentID + "|" + strings.Join(slices.Sorted(slices.Values(selectors)), ",")
+}
+
+func getPodUID(selectors []string) string {
+	for _, selector := range selectors {
+		if uid, ok := strings.CutPrefix(selector, k8sPodUIDSelectorPrefix); ok {
+			return uid
+		}
+	}
+	return ""
+}
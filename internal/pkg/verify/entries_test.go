@@ -0,0 +1,327 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cofide/cofidectl/internal/pkg/spirecrd"
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newNamespace(name string, labels map[string]string) corev1.Namespace {
+	return corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func newPod(namespace, name, uid, nodeName string, labels map[string]string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID(uid), Labels: labels},
+		Spec:       corev1.PodSpec{NodeName: nodeName, ServiceAccountName: "sa-" + name},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func testState() *ClusterState {
+	succeeded := newPod("ns1", "job", "uid-job", "node1", nil)
+	succeeded.Status.Phase = corev1.PodSucceeded
+	return &ClusterState{
+		Namespaces: []corev1.Namespace{
+			newNamespace("ns1", map[string]string{"kubernetes.io/metadata.name": "ns1"}),
+			newNamespace("ns2", map[string]string{"kubernetes.io/metadata.name": "ns2"}),
+			newNamespace("kube-system", map[string]string{"kubernetes.io/metadata.name": "kube-system"}),
+			newNamespace("openshift-monitoring", map[string]string{"kubernetes.io/metadata.name": "openshift-monitoring"}),
+		},
+		Pods: []corev1.Pod{
+			newPod("ns1", "app1", "uid-app1", "node1", map[string]string{"app": "app1"}),
+			newPod("ns1", "app2", "uid-app2", "node1", map[string]string{"app": "app2"}),
+			newPod("ns1", "pending", "uid-pending", "", map[string]string{"app": "app1"}),
+			newPod("ns2", "app1", "uid-app1-ns2", "node1", map[string]string{"app": "app1"}),
+			newPod("kube-system", "coredns", "uid-coredns", "node1", nil),
+			newPod("openshift-monitoring", "prometheus", "uid-prometheus", "node1", nil),
+			succeeded,
+		},
+		Nodes: []corev1.Node{
+			{ObjectMeta: metav1.ObjectMeta{Name: "node1"}},
+		},
+	}
+}
+
+func testResources() *spirecrd.Resources {
+	return &spirecrd.Resources{
+		ClusterSPIFFEIDs: []*spirecrd.ClusterSPIFFEID{
+			spirecrd.NewClusterSPIFFEID("ap1", &spirecrd.ClusterSPIFFEIDSpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ns1"},
+				},
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "app1"},
+				},
+				DNSNameTemplates: []string{"{{ .PodMeta.Name }}.{{ .PodMeta.Namespace }}.svc"},
+				FederatesWith:    []string{"td2"},
+			}),
+			spirecrd.NewClusterSPIFFEID("ap2", &spirecrd.ClusterSPIFFEIDSpec{
+				SPIFFEIDTemplate: "spiffe://{{ .TrustDomain }}/cluster/{{ .ClusterName }}/node/{{ .NodeMeta.Name }}/pod/{{ .PodMeta.Name }}",
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ns2"},
+				},
+			}),
+		},
+		ClusterStaticEntries: []*spirecrd.ClusterStaticEntry{
+			spirecrd.NewClusterStaticEntry("ap3", &spirecrd.ClusterStaticEntrySpec{
+				SPIFFEID:  "spiffe://td1/vm1",
+				ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+				Selectors: []string{"unix:uid:1000"},
+			}),
+		},
+	}
+}
+
+func TestExpectedEntries(t *testing.T) {
+	got, err := ExpectedEntries(testResources(), "td1", "local1", testState(), DefaultIgnoredNamespaces)
+	require.NoError(t, err)
+	want := []*ExpectedEntry{
+		{
+			Owner:     "ap3",
+			SPIFFEID:  "spiffe://td1/vm1",
+			ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+			Selectors: []string{"unix:uid:1000"},
+		},
+		{
+			Owner:         "ap1",
+			SPIFFEID:      "spiffe://td1/ns/ns1/sa/sa-app1",
+			Selectors:     []string{"k8s:pod-uid:uid-app1"},
+			DNSNames:      []string{"app1.ns1.svc"},
+			FederatesWith: []string{"td2"},
+		},
+		{
+			Owner:     "ap2",
+			SPIFFEID:  "spiffe://td1/cluster/local1/node/node1/pod/app1",
+			Selectors: []string{"k8s:pod-uid:uid-app1-ns2"},
+			DNSNames:  []string{},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestExpectedEntries_InvalidTemplate(t *testing.T) {
+	resources := &spirecrd.Resources{
+		ClusterSPIFFEIDs: []*spirecrd.ClusterSPIFFEID{
+			spirecrd.NewClusterSPIFFEID("ap1", &spirecrd.ClusterSPIFFEIDSpec{
+				SPIFFEIDTemplate: "spiffe://{{ .TrustDomain }}/{{ .Invalid }}",
+			}),
+		},
+	}
+	_, err := ExpectedEntries(resources, "td1", "local1", testState(), DefaultIgnoredNamespaces)
+	require.ErrorContains(t, err, "failed to render SPIFFE ID template of ap1 for pod ns1/app1")
+}
+
+func TestCompareEntries(t *testing.T) {
+	expected := []*ExpectedEntry{
+		{
+			Owner:     "ap3",
+			SPIFFEID:  "spiffe://td1/vm1",
+			ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+			Selectors: []string{"unix:uid:1000"},
+		},
+		{
+			Owner:         "ap1",
+			SPIFFEID:      "spiffe://td1/ns/ns1/sa/sa-app1",
+			Selectors:     []string{"k8s:pod-uid:uid-app1"},
+			DNSNames:      []string{"app1.ns1.svc"},
+			FederatesWith: []string{"td2"},
+		},
+		{
+			Owner:     "ap2",
+			SPIFFEID:  "spiffe://td1/ns2/app1",
+			Selectors: []string{"k8s:pod-uid:uid-app1-ns2"},
+		},
+	}
+	actual := []*spire.Entry{
+		{
+			ID:        "entry1",
+			SPIFFEID:  "spiffe://td1/vm1",
+			ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+			Selectors: []string{"unix:uid:1000"},
+		},
+		{
+			ID:        "entry2",
+			SPIFFEID:  "spiffe://td1/ns/ns1/sa/sa-app1",
+			ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			Selectors: []string{"k8s:pod-uid:uid-app1"},
+			DNSNames:  []string{"app1.ns1.svc"},
+		},
+		{
+			ID:        "entry3",
+			SPIFFEID:  "spiffe://td1/ns/ns1/sa/sa-app2",
+			ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			Selectors: []string{"k8s:pod-uid:uid-app2"},
+		},
+		{
+			ID:        "entry4",
+			SPIFFEID:  "spiffe://td1/ns/kube-system/sa/coredns",
+			ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			Selectors: []string{"k8s:pod-uid:uid-coredns"},
+		},
+		{
+			ID:        "entry5",
+			SPIFFEID:  "spiffe://td1/ns/openshift-monitoring/sa/prometheus",
+			ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			Selectors: []string{"k8s:pod-uid:uid-prometheus"},
+		},
+		{
+			ID:        "entry6",
+			SPIFFEID:  "spiffe://td1/vm2",
+			ParentID:  "spiffe://td1/spire/agent/join_token/token2",
+			Selectors: []string{"spiffe_id:spiffe://td1/spire/agent/join_token/token2"},
+		},
+		{
+			ID:        "entry7",
+			SPIFFEID:  "spiffe://td1/vm1/app",
+			ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+			Selectors: []string{"unix:uid:1001"},
+		},
+	}
+
+	got, err := CompareEntries(expected, actual, testState(), DefaultIgnoredNamespaces)
+	require.NoError(t, err)
+	assert.True(t, got.HasDrift())
+	assert.Equal(t, []*ExpectedEntry{expected[2]}, got.Missing)
+	assert.Equal(t, []*spire.Entry{actual[2], actual[6]}, got.Unexpected)
+	assert.Equal(t, []*spire.Entry{actual[5]}, got.Unmanaged)
+	assert.Equal(t, []*Mismatch{
+		{
+			Expected:    expected[1],
+			Actual:      actual[1],
+			Differences: []string{"federatesWith: expected [td2], got []"},
+		},
+	}, got.Mismatched)
+}
+
+func TestCompareEntries_MismatchedSPIFFEID(t *testing.T) {
+	expected := []*ExpectedEntry{
+		{
+			Owner:     "ap1",
+			SPIFFEID:  "spiffe://td1/ns/ns1/sa/sa-app1",
+			Selectors: []string{"k8s:pod-uid:uid-app1"},
+		},
+	}
+	actual := []*spire.Entry{
+		{
+			ID:        "entry1",
+			SPIFFEID:  "spiffe://td1/ns/ns1/sa/default",
+			ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			Selectors: []string{"k8s:pod-uid:uid-app1"},
+		},
+	}
+
+	got, err := CompareEntries(expected, actual, testState(), DefaultIgnoredNamespaces)
+	require.NoError(t, err)
+	assert.Empty(t, got.Missing)
+	assert.Empty(t, got.Unexpected)
+	require.Len(t, got.Mismatched, 1)
+	assert.Equal(t, []string{"SPIFFE ID: expected spiffe://td1/ns/ns1/sa/sa-app1, got spiffe://td1/ns/ns1/sa/default"}, got.Mismatched[0].Differences)
+
+	got, err = CompareEntries(expected, nil, testState(), DefaultIgnoredNamespaces)
+	require.NoError(t, err)
+	assert.Equal(t, expected, got.Missing)
+}
+
+func TestCompareEntries_Unmanaged(t *testing.T) {
+	actual := []*spire.Entry{
+		{
+			ID:        "entry1",
+			SPIFFEID:  "spiffe://td1/vm1",
+			ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+			Selectors: []string{"spiffe_id:spiffe://td1/spire/agent/join_token/token1"},
+		},
+	}
+
+	got, err := CompareEntries(nil, actual, testState(), DefaultIgnoredNamespaces)
+	require.NoError(t, err)
+	assert.False(t, got.HasDrift())
+	assert.Equal(t, actual, got.Unmanaged)
+}
+
+func Test_isJoinTokenAlias(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry *spire.Entry
+		want  bool
+	}{
+		{
+			name: "alias",
+			entry: &spire.Entry{
+				ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+				Selectors: []string{"spiffe_id:spiffe://td1/spire/agent/join_token/token1"},
+			},
+			want: true,
+		},
+		{
+			name: "workload of join token agent",
+			entry: &spire.Entry{
+				ParentID:  "spiffe://td1/spire/agent/join_token/token1",
+				Selectors: []string{"unix:uid:1000"},
+			},
+			want: false,
+		},
+		{
+			name: "other agent",
+			entry: &spire.Entry{
+				ParentID:  "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+				Selectors: []string{"spiffe_id:spiffe://td1/spire/agent/k8s_psat/local1/node1"},
+			},
+			want: false,
+		},
+		{
+			name:  "invalid parent ID",
+			entry: &spire.Entry{ParentID: "invalid", Selectors: []string{"spiffe_id:invalid"}},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isJoinTokenAlias(tt.entry))
+		})
+	}
+}
+
+func Test_isIgnored(t *testing.T) {
+	ignored, err := compileIgnoredNamespaces(DefaultIgnoredNamespaces)
+	require.NoError(t, err)
+
+	tests := []struct {
+		namespace string
+		want      bool
+	}{
+		{namespace: "kube-system", want: true},
+		{namespace: "openshift-monitoring", want: true},
+		{namespace: "local-path-storage", want: true},
+		{namespace: "ns1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.namespace, func(t *testing.T) {
+			assert.Equal(t, tt.want, isIgnored(ignored, tt.namespace))
+		})
+	}
+}
+
+func TestGetClusterState(t *testing.T) {
+	state := testState()
+	clientSet := fake.NewClientset(&state.Namespaces[0], &state.Pods[0], &state.Nodes[0])
+	client := &kubeutil.Client{Clientset: clientSet}
+
+	got, err := GetClusterState(context.Background(), client)
+	require.NoError(t, err)
+	assert.Len(t, got.Namespaces, 1)
+	assert.Len(t, got.Pods, 1)
+	assert.Len(t, got.Nodes, 1)
+}
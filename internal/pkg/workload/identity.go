@@ -0,0 +1,158 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package workload
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	kubeutil "github.com/cofide/cofidectl/pkg/kube"
+	"github.com/cofide/cofidectl/pkg/spire"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	k8sSelectorPrefix     = "k8s:"
+	agentNodeNameSelector = "k8s_psat:agent_node_name:"
+)
+
+// Identity contains the SPIRE identity of a workload pod, as seen by the SPIRE server.
+type Identity struct {
+	Pod *v1.Pod
+	// Entries are the registration entries whose selectors match the pod.
+	Entries []*spire.Entry
+	// Agent is the SPIRE agent on the pod's node, or nil if there is no such agent.
+	Agent *spire.Agent
+	// FederatedBundles records, for each trust domain that the entries federate with, whether
+	// the SPIRE server has a bundle for it.
+	FederatedBundles map[string]bool
+}
+
+// GetIdentity resolves the SPIRE identity of a workload pod by querying the SPIRE server, without
+// modifying the pod.
+func GetIdentity(ctx context.Context, client *kubeutil.Client, podName string, namespace string) (*Identity, error) {
+	pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := spire.ListEntries(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	agents, err := spire.GetAgentStatus(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	_, bundles, err := spire.GetServerCABundleAndFederatedBundles(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Pod:              pod,
+		Entries:          getPodEntries(pod, entries),
+		Agent:            getNodeAgent(pod.Spec.NodeName, agents.Agents),
+		FederatedBundles: map[string]bool{},
+	}
+	for _, entry := range identity.Entries {
+		for _, trustDomain := range entry.FederatesWith {
+			_, ok := bundles[trustDomain]
+			identity.FederatedBundles[trustDomain] = ok
+		}
+	}
+	return identity, nil
+}
+
+// getPodEntries returns the registration entries whose selectors all match the pod. Only entries
+// with exclusively k8s selectors are considered, since other selectors cannot be evaluated
+// against the pod.
+func getPodEntries(pod *v1.Pod, entries []*spire.Entry) []*spire.Entry {
+	result := []*spire.Entry{}
+	for _, entry := range entries {
+		if len(entry.Selectors) == 0 {
+			continue
+		}
+		matches := true
+		for _, selector := range entry.Selectors {
+			if !matchesK8sSelector(pod, selector) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// matchesK8sSelector returns whether a pod matches a selector of the SPIRE k8s workload attestor.
+// Container selectors match if any container in the pod matches.
+func matchesK8sSelector(pod *v1.Pod, selector string) bool {
+	value, ok := strings.CutPrefix(selector, k8sSelectorPrefix)
+	if !ok {
+		return false
+	}
+	key, value, _ := strings.Cut(value, ":")
+	switch key {
+	case "ns":
+		return pod.Namespace == value
+	case "sa":
+		return pod.Spec.ServiceAccountName == value
+	case "pod-uid":
+		return string(pod.UID) == value
+	case "pod-name":
+		return pod.Name == value
+	case "node-name":
+		return pod.Spec.NodeName == value
+	case "pod-label":
+		labelKey, labelValue, _ := strings.Cut(value, ":")
+		actual, ok := pod.Labels[labelKey]
+		return ok && actual == labelValue
+	case "pod-owner":
+		for _, owner := range pod.OwnerReferences {
+			if owner.Kind+":"+owner.Name == value {
+				return true
+			}
+		}
+		return false
+	case "pod-owner-uid":
+		for _, owner := range pod.OwnerReferences {
+			if string(owner.UID) == value {
+				return true
+			}
+		}
+		return false
+	case "container-name":
+		return slices.ContainsFunc(pod.Spec.Containers, func(c v1.Container) bool { return c.Name == value })
+	case "container-image", "pod-image":
+		return slices.ContainsFunc(pod.Spec.Containers, func(c v1.Container) bool { return c.Image == value })
+	default:
+		return false
+	}
+}
+
+// getNodeAgent returns the SPIRE agent attested on a node, preferring the agent whose SVID
+// expires last if there are several.
+func getNodeAgent(nodeName string, agents []spire.Agent) *spire.Agent {
+	if nodeName == "" {
+		return nil
+	}
+	var result *spire.Agent
+	for i := range agents {
+		agent := &agents[i]
+		if !slices.Contains(agent.Selectors, agentNodeNameSelector+nodeName) {
+			continue
+		}
+		if result == nil || agent.ExpirationTime.After(result.ExpirationTime) {
+			result = agent
+		}
+	}
+	return result
+}
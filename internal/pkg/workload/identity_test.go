@@ -0,0 +1,90 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package workload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cofide/cofidectl/pkg/spire"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func testPod() *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-1234",
+			Namespace: "ns1",
+			UID:       types.UID("uid1"),
+			Labels:    map[string]string{"app": "app"},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "app-rs", UID: types.UID("rs-uid")},
+			},
+		},
+		Spec: v1.PodSpec{
+			NodeName:           "node1",
+			ServiceAccountName: "sa1",
+			Containers: []v1.Container{
+				{Name: "app", Image: "ghcr.io/example/app:v1"},
+			},
+		},
+	}
+}
+
+func Test_matchesK8sSelector(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "k8s:ns:ns1", want: true},
+		{selector: "k8s:ns:ns2", want: false},
+		{selector: "k8s:sa:sa1", want: true},
+		{selector: "k8s:pod-uid:uid1", want: true},
+		{selector: "k8s:pod-name:app-1234", want: true},
+		{selector: "k8s:node-name:node1", want: true},
+		{selector: "k8s:pod-label:app:app", want: true},
+		{selector: "k8s:pod-label:app:other", want: false},
+		{selector: "k8s:pod-owner:ReplicaSet:app-rs", want: true},
+		{selector: "k8s:pod-owner-uid:rs-uid", want: true},
+		{selector: "k8s:container-name:app", want: true},
+		{selector: "k8s:container-image:ghcr.io/example/app:v1", want: true},
+		{selector: "k8s:pod-image:ghcr.io/example/app:v2", want: false},
+		{selector: "k8s:unknown:foo", want: false},
+		{selector: "unix:uid:1000", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesK8sSelector(testPod(), tt.selector))
+		})
+	}
+}
+
+func Test_getPodEntries(t *testing.T) {
+	entries := []*spire.Entry{
+		{ID: "entry1", Selectors: []string{"k8s:ns:ns1", "k8s:pod-uid:uid1"}},
+		{ID: "entry2", Selectors: []string{"k8s:ns:ns1", "k8s:sa:sa2"}},
+		{ID: "entry3", Selectors: []string{"k8s:ns:ns1", "unix:uid:1000"}},
+		{ID: "entry4", Selectors: []string{"k8s:ns:ns1", "k8s:sa:sa1"}},
+		{ID: "entry5"},
+	}
+
+	got := getPodEntries(testPod(), entries)
+	assert.Equal(t, []*spire.Entry{entries[0], entries[3]}, got)
+}
+
+func Test_getNodeAgent(t *testing.T) {
+	now := time.Now()
+	agents := []spire.Agent{
+		{Id: "agent1", Selectors: []string{"k8s_psat:agent_node_name:node1"}, ExpirationTime: now},
+		{Id: "agent2", Selectors: []string{"k8s_psat:agent_node_name:node1"}, ExpirationTime: now.Add(time.Hour)},
+		{Id: "agent3", Selectors: []string{"k8s_psat:agent_node_name:node2"}, ExpirationTime: now.Add(2 * time.Hour)},
+	}
+
+	assert.Equal(t, "agent2", getNodeAgent("node1", agents).Id)
+	assert.Nil(t, getNodeAgent("node3", agents))
+	assert.Nil(t, getNodeAgent("", agents))
+}
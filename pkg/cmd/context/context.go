@@ -7,9 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
@@ -19,20 +22,27 @@ import (
 
 const shutdownTimeoutSec = 10
 
+// Log formats supported by SetLogOutput.
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+var logFormats = []string{LogFormatText, LogFormatJSON}
+
 type CommandContext struct {
 	Ctx           context.Context
 	cancel        context.CancelCauseFunc
 	PluginManager *manager.PluginManager
 	logLevel      *slog.LevelVar
+	logFile       *os.File
 }
 
 // NewCommandContext returns a command context wired up with a config loader and plugin manager.
 // If customLoader is non-nil, it may be used to load custom in-process plugins.
 func NewCommandContext(cofideConfigFile string, customLoader manager.PluginLoader) *CommandContext {
 	logLevel := &slog.LevelVar{}
-	handler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel})
-	logger := slog.New(handler)
-	slog.SetDefault(logger)
+	slog.SetDefault(slog.New(newLogHandler(os.Stderr, LogFormatText, logLevel)))
 	ctx, cancel := context.WithCancelCause(context.Background())
 	configLoader := config.NewFileLoader(cofideConfigFile)
 	pluginManager := manager.NewManager(configLoader, customLoader)
@@ -45,6 +55,7 @@ func (cc *CommandContext) Shutdown() {
 		cc.cancel = nil
 	}
 	cc.PluginManager.Shutdown()
+	cc.closeLogFile()
 }
 
 // HandleSignals waits for SIGINT or SIGTERM, then triggers a clean shutdown using the command context.
@@ -62,9 +73,16 @@ func (cc *CommandContext) HandleSignals() {
 	os.Exit(1)
 }
 
-// UpdateConfigFile replaces the config file path used by the plugin manager.
-func (cc *CommandContext) UpdateConfigFile(path string) {
-	cc.PluginManager.UpdateConfigLoader(config.NewFileLoader(path))
+// UpdateConfigFile replaces the config location used by the plugin manager.
+// The location may be a file path or a k8s://context/namespace/name URI, in which case kubeConfig
+// is used to connect to the cluster.
+func (cc *CommandContext) UpdateConfigFile(location, kubeConfig string) error {
+	loader, err := config.NewLoader(location, kubeConfig)
+	if err != nil {
+		return err
+	}
+	cc.PluginManager.UpdateConfigLoader(loader)
+	return nil
 }
 
 // SetLogLevel sets the log level of the default handler and gRPC plugins.
@@ -72,3 +90,44 @@ func (cc *CommandContext) SetLogLevel(level slog.Level) {
 	cc.logLevel.Set(level)
 	cc.PluginManager.SetLogLevel(level)
 }
+
+// SetLogOutput sets the destination and format of log output from cofidectl and gRPC plugins.
+// Logs are appended to logFile, or written to stderr if logFile is empty, so that they are not
+// mixed with command output. The format is one of LogFormatText or LogFormatJSON.
+func (cc *CommandContext) SetLogOutput(logFile, format string) error {
+	format = strings.ToLower(format)
+	if !slices.Contains(logFormats, format) {
+		return fmt.Errorf("unexpected log format %s, valid formats: %s", format, strings.Join(logFormats, ", "))
+	}
+
+	var w io.Writer = os.Stderr
+	if logFile != "" {
+		f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		cc.closeLogFile()
+		cc.logFile = f
+		w = f
+	}
+
+	slog.SetDefault(slog.New(newLogHandler(w, format, cc.logLevel)))
+	cc.PluginManager.SetLogOutput(w, format == LogFormatJSON)
+	return nil
+}
+
+func (cc *CommandContext) closeLogFile() {
+	if cc.logFile != nil {
+		_ = cc.logFile.Close()
+		cc.logFile = nil
+	}
+}
+
+// newLogHandler returns an slog handler that writes logs to w in the specified format.
+func newLogHandler(w io.Writer, format string, level slog.Leveler) slog.Handler {
+	opts := &slog.HandlerOptions{Level: level}
+	if format == LogFormatJSON {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandContext_SetLogOutput(t *testing.T) {
+	defaultLogger := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	cmdCtx := NewCommandContext("cofide.yaml", nil)
+	cmdCtx.SetLogLevel(slog.LevelInfo)
+
+	logFile := filepath.Join(t.TempDir(), "cofidectl.log")
+	require.NoError(t, cmdCtx.SetLogOutput(logFile, "JSON"))
+	slog.Info("Test message", "key", "value")
+	cmdCtx.Shutdown()
+
+	data, err := os.ReadFile(logFile)
+	require.NoError(t, err)
+	var record map[string]any
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(string(data))), &record))
+	assert.Equal(t, "Test message", record["msg"])
+	assert.Equal(t, "value", record["key"])
+}
+
+func TestCommandContext_SetLogOutput_invalidFormat(t *testing.T) {
+	cmdCtx := NewCommandContext("cofide.yaml", nil)
+	defer cmdCtx.Shutdown()
+
+	err := cmdCtx.SetLogOutput("", "yaml")
+	assert.EqualError(t, err, "unexpected log format yaml, valid formats: text, json")
+}
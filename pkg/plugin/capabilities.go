@@ -0,0 +1,83 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	capabilitiespb "github.com/cofide/cofidectl/gen/go/proto/cofidectl/plugin/v1alpha1"
+)
+
+// ErrNotSupported is returned when a plugin does not support an API version or feature.
+var ErrNotSupported = errors.New("not supported")
+
+// UnsupportedError is returned when a plugin does not support an API version or feature.
+// It wraps ErrNotSupported, and the error returned by the plugin if there is one.
+type UnsupportedError struct {
+	// Plugin is the name of the plugin.
+	Plugin string
+	// Feature describes the unsupported API version or feature.
+	Feature string
+	// Err is the error returned by the plugin, such as an Unimplemented gRPC status, or nil.
+	Err error
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("plugin %s does not support %s", e.Plugin, e.Feature)
+}
+
+func (e *UnsupportedError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrNotSupported}
+	}
+	return []error{ErrNotSupported, e.Err}
+}
+
+// Capabilities describes the API versions and optional features supported by a plugin.
+type Capabilities struct {
+	APIVersions []string
+	Features    []string
+	// ConfigSchema is a CUE schema or JSON Schema document for the plugin's configuration, or
+	// empty if the plugin does not declare one. See ConfigSchemaProvider.
+	ConfigSchema string
+}
+
+// SupportsAPIVersion returns whether the plugin supports the specified API version.
+func (c *Capabilities) SupportsAPIVersion(version string) bool {
+	return slices.Contains(c.APIVersions, version)
+}
+
+// HasFeature returns whether the plugin supports the specified optional feature.
+func (c *Capabilities) HasFeature(feature string) bool {
+	return slices.Contains(c.Features, feature)
+}
+
+// CapabilityReporter is implemented by plugins that report their capabilities, including the
+// gRPC plugin clients. The capabilities of other plugins are inferred from the interfaces they
+// implement.
+type CapabilityReporter interface {
+	Capabilities(ctx context.Context) (*Capabilities, error)
+}
+
+// ToProto returns the protobuf representation of the capabilities, as returned by the
+// capabilities service of each plugin type.
+func (c *Capabilities) ToProto() *capabilitiespb.Capabilities {
+	return &capabilitiespb.Capabilities{
+		ApiVersions:  c.APIVersions,
+		Features:     c.Features,
+		ConfigSchema: &c.ConfigSchema,
+	}
+}
+
+// CapabilitiesFromProto returns the capabilities described by their protobuf representation.
+func CapabilitiesFromProto(capabilities *capabilitiespb.Capabilities) *Capabilities {
+	return &Capabilities{
+		APIVersions:  append([]string{}, capabilities.GetApiVersions()...),
+		Features:     append([]string{}, capabilities.GetFeatures()...),
+		ConfigSchema: capabilities.GetConfigSchema(),
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCapabilities_proto(t *testing.T) {
+	capabilities := &Capabilities{APIVersions: []string{"v1alpha2", "v1alpha3"}, Features: []string{"transactions"}}
+	got := CapabilitiesFromProto(capabilities.ToProto())
+	assert.Equal(t, capabilities, got)
+	assert.True(t, got.SupportsAPIVersion("v1alpha3"))
+	assert.False(t, got.SupportsAPIVersion("v1"))
+	assert.True(t, got.HasFeature("transactions"))
+	assert.False(t, got.HasFeature("dry-run"))
+
+	// A plugin that returns no capabilities supports nothing.
+	assert.Equal(t, &Capabilities{APIVersions: []string{}, Features: []string{}}, CapabilitiesFromProto(nil))
+}
+
+func TestUnsupportedError(t *testing.T) {
+	err := &UnsupportedError{Plugin: "my-plugin", Feature: "transactions"}
+	assert.EqualError(t, err, "plugin my-plugin does not support transactions")
+	assert.ErrorIs(t, err, ErrNotSupported)
+
+	unimplemented := status.Error(codes.Unimplemented, "unknown method UpdateAttestationPolicy")
+	err = &UnsupportedError{Plugin: "my-plugin", Feature: "UpdateAttestationPolicy", Err: unimplemented}
+	assert.EqualError(t, err, "plugin my-plugin does not support UpdateAttestationPolicy")
+	assert.ErrorIs(t, err, ErrNotSupported)
+	assert.ErrorIs(t, err, unimplemented)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -4,23 +4,63 @@
 package plugin
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/user"
 	"path/filepath"
+	"slices"
+	"strings"
 	"syscall"
 )
 
 const (
+	// CliPluginPrefix is the binary name prefix of CLI plugins. A CLI plugin named
+	// cofidectl-example is run by cofidectl example.
+	CliPluginPrefix = "cofidectl-"
+	// ManifestExtension is appended to the name of a CLI plugin binary to give the name of its
+	// manifest file.
+	ManifestExtension = ".manifest.json"
+
 	relativePluginDir = ".cofide/plugins"
 )
 
+// Environment variables set for CLI plugins, describing the cofidectl execution context. Each is
+// set from the corresponding cofidectl global flag preceding the plugin name, or the flag's
+// default value.
+const (
+	// CliPluginConfigEnvVar is the cofidectl config file, from --config.
+	CliPluginConfigEnvVar = "COFIDECTL_CONFIG"
+	// CliPluginKubeConfigEnvVar is the kubeconfig file, from --kube-config.
+	CliPluginKubeConfigEnvVar = "COFIDECTL_KUBECONFIG"
+	// CliPluginLogLevelEnvVar is the log level, from --log-level.
+	CliPluginLogLevelEnvVar = "COFIDECTL_LOG_LEVEL"
+	// CliPluginLogFileEnvVar is the log file, from --log-file.
+	CliPluginLogFileEnvVar = "COFIDECTL_LOG_FILE"
+	// CliPluginLogFormatEnvVar is the log format, from --log-format.
+	CliPluginLogFormatEnvVar = "COFIDECTL_LOG_FORMAT"
+	// CliPluginAuditLogEnvVar is the audit log file, from --audit-log.
+	CliPluginAuditLogEnvVar = "COFIDECTL_AUDIT_LOG"
+	// CliPluginVersionEnvVar is the version of cofidectl.
+	CliPluginVersionEnvVar = "COFIDECTL_VERSION"
+)
+
 type CliPlugin struct {
 	BinaryName string
 	Args       []string
+	// SignaturePolicy is used to verify the plugin before executing it. If nil, the plugin must
+	// be signed by a key in the default trusted keys directory.
+	SignaturePolicy *SignaturePolicy
+	// PluginDir is the directory containing the plugin. If empty, the default plugin directory
+	// is used.
+	PluginDir string
+	// Env contains environment variables in KEY=value form to set for the plugin, in addition to
+	// the environment of cofidectl.
+	Env []string
 }
 
+// GetPluginDir returns the default plugin directory, ~/.cofide/plugins.
 func GetPluginDir() (string, error) {
 	usr, err := user.Current()
 	if err != nil {
@@ -29,6 +69,8 @@ func GetPluginDir() (string, error) {
 	return filepath.Join(usr.HomeDir, relativePluginDir), nil
 }
 
+// GetPluginPath returns the path of the named plugin in the default plugin directory.
+// Use FindPlugin to search the plugin search path.
 func GetPluginPath(name string) (string, error) {
 	pluginDir, err := GetPluginDir()
 	if err != nil {
@@ -38,6 +80,8 @@ func GetPluginPath(name string) (string, error) {
 	return filepath.Join(pluginDir, name), nil
 }
 
+// PluginExists returns whether the named plugin exists in the default plugin directory.
+// Use FindPlugin to search the plugin search path.
 func PluginExists(name string) (bool, error) {
 	pluginPath, err := GetPluginPath(name)
 	if err != nil {
@@ -60,18 +104,125 @@ func NewCliPlugin(binary string, args []string) *CliPlugin {
 	}
 }
 
+// Verify verifies the CLI plugin against the plugin lockfile and signature policy, returning
+// the path of the plugin binary.
+func (cp *CliPlugin) Verify() (string, error) {
+	var err error
+	pluginDir := cp.PluginDir
+	if pluginDir == "" {
+		if pluginDir, err = GetPluginDir(); err != nil {
+			return "", err
+		}
+	}
+	pluginPath := filepath.Join(pluginDir, cp.BinaryName)
+	if err := VerifyPlugin(pluginDir, cp.BinaryName); err != nil {
+		return "", err
+	}
+
+	policy := cp.SignaturePolicy
+	if policy == nil {
+		if policy, err = NewSignaturePolicy(false); err != nil {
+			return "", err
+		}
+	}
+	if err := policy.Verify(pluginPath); err != nil {
+		return "", err
+	}
+	return pluginPath, nil
+}
+
 // Execute executes a CLI plugin by exec'ing into it, replacing the current process.
 // This may change to execute the plugin as a subprocess, but Exec keeps things simple for now (no signal handling etc.)
 func (cp *CliPlugin) Execute() error {
-	pluginPath, err := GetPluginPath(cp.BinaryName)
+	pluginPath, err := cp.Verify()
 	if err != nil {
 		return err
 	}
+
 	// syscall.Exec requires the binary to be the 0th element of the arguments.
 	args := append([]string{cp.BinaryName}, cp.Args...)
-	err = syscall.Exec(pluginPath, args, os.Environ())
+	err = syscall.Exec(pluginPath, args, append(os.Environ(), cp.Env...))
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// CliPluginManifest describes a CLI plugin for cofidectl help output. It is read from a JSON file
+// next to the plugin binary, named after the binary with the ManifestExtension, e.g.
+// cofidectl-example.manifest.json.
+type CliPluginManifest struct {
+	// Short is a one-line description of the plugin command.
+	Short string `json:"short"`
+	// Long is an optional longer description of the plugin command.
+	Long string `json:"long,omitempty"`
+}
+
+// DiscoveredCliPlugin is a CLI plugin found in the plugin search path.
+type DiscoveredCliPlugin struct {
+	// Command is the cofidectl subcommand that runs the plugin, i.e. the binary name without
+	// the CliPluginPrefix.
+	Command  string
+	Plugin   *ResolvedPlugin
+	Manifest *CliPluginManifest
+}
+
+// DiscoverCliPlugins returns the CLI plugins in the plugin directories, sorted by command name.
+// Where multiple directories contain a plugin with the same name, the first is returned.
+// Plugins are not executed during discovery.
+func DiscoverCliPlugins(dirs []PluginDir) ([]*DiscoveredCliPlugin, error) {
+	discovered := []*DiscoveredCliPlugin{}
+	found := map[string]bool{}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir.Path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read plugin directory: %w", err)
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			command, ok := strings.CutPrefix(name, CliPluginPrefix)
+			if !ok || command == "" || entry.IsDir() || found[name] || validatePluginName(name) != nil {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil || info.Mode()&0111 == 0 {
+				continue
+			}
+			found[name] = true
+
+			path := filepath.Join(dir.Path, name)
+			manifest, err := ReadCliPluginManifest(path)
+			if err != nil {
+				return nil, err
+			}
+			discovered = append(discovered, &DiscoveredCliPlugin{
+				Command:  command,
+				Plugin:   &ResolvedPlugin{Name: name, Path: path, Dir: dir},
+				Manifest: manifest,
+			})
+		}
+	}
+	slices.SortFunc(discovered, func(a, b *DiscoveredCliPlugin) int { return strings.Compare(a.Command, b.Command) })
+	return discovered, nil
+}
+
+// ReadCliPluginManifest reads the manifest of the CLI plugin binary at pluginPath, returning nil
+// if it has none.
+func ReadCliPluginManifest(pluginPath string) (*CliPluginManifest, error) {
+	path := pluginPath + ManifestExtension
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read plugin manifest: %w", err)
+	}
+
+	manifest := &CliPluginManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid plugin manifest %s: %w", path, err)
+	}
+	return manifest, nil
+}
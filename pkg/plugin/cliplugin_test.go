@@ -0,0 +1,85 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverCliPlugins(t *testing.T) {
+	dir1 := t.TempDir()
+	dir2 := t.TempDir()
+	writeTestPlugin(t, dir1, "cofidectl-foo", "foo1")
+	require.NoError(t, os.WriteFile(filepath.Join(dir1, "cofidectl-foo"+ManifestExtension), []byte(`{"short": "Do foo things"}`), 0644))
+	writeTestPlugin(t, dir2, "cofidectl-foo", "foo2")
+	writeTestPlugin(t, dir2, "cofidectl-bar", "bar")
+	// gRPC plugins, signatures, non-executable files and directories are not CLI plugins.
+	writeTestPlugin(t, dir2, "my-plugin", "grpc")
+	require.NoError(t, os.WriteFile(filepath.Join(dir2, "cofidectl-bar"+SignatureExtension), []byte("sig"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir2, "cofidectl-baz"), []byte("baz"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir2, "cofidectl-qux"), 0755))
+	dirs := []PluginDir{
+		{Path: dir1, Source: PluginDirSourceEnv},
+		{Path: dir2, Source: PluginDirSourceDefault},
+		{Path: filepath.Join(dir2, "missing"), Source: PluginDirSourceConfig},
+	}
+
+	discovered, err := DiscoverCliPlugins(dirs)
+	require.NoError(t, err)
+	want := []*DiscoveredCliPlugin{
+		{
+			Command: "bar",
+			Plugin:  &ResolvedPlugin{Name: "cofidectl-bar", Path: filepath.Join(dir2, "cofidectl-bar"), Dir: dirs[1]},
+		},
+		{
+			Command:  "foo",
+			Plugin:   &ResolvedPlugin{Name: "cofidectl-foo", Path: filepath.Join(dir1, "cofidectl-foo"), Dir: dirs[0]},
+			Manifest: &CliPluginManifest{Short: "Do foo things"},
+		},
+	}
+	assert.Equal(t, want, discovered)
+}
+
+func TestReadCliPluginManifest(t *testing.T) {
+	pluginPath := writeTestPlugin(t, t.TempDir(), "cofidectl-foo", "foo")
+
+	manifest, err := ReadCliPluginManifest(pluginPath)
+	require.NoError(t, err)
+	assert.Nil(t, manifest)
+
+	require.NoError(t, os.WriteFile(pluginPath+ManifestExtension, []byte(`{"short": "Foo", "long": "Foo does things"}`), 0644))
+	manifest, err = ReadCliPluginManifest(pluginPath)
+	require.NoError(t, err)
+	assert.Equal(t, &CliPluginManifest{Short: "Foo", Long: "Foo does things"}, manifest)
+
+	require.NoError(t, os.WriteFile(pluginPath+ManifestExtension, []byte("invalid"), 0644))
+	_, err = ReadCliPluginManifest(pluginPath)
+	assert.ErrorContains(t, err, "invalid plugin manifest")
+}
+
+func TestInstallPlugin_manifest(t *testing.T) {
+	pluginDir := t.TempDir()
+	source := writeTestPlugin(t, t.TempDir(), "cofidectl-foo", "foo")
+	require.NoError(t, os.WriteFile(source+ManifestExtension, []byte(`{"short": "Foo"}`), 0644))
+
+	installed, err := InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+	manifest, err := ReadCliPluginManifest(installed.Path)
+	require.NoError(t, err)
+	assert.Equal(t, &CliPluginManifest{Short: "Foo"}, manifest)
+
+	// Manifests are not listed as plugins.
+	plugins, err := ListPlugins(pluginDir)
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	assert.Equal(t, "cofidectl-foo", plugins[0].Name)
+
+	require.NoError(t, RemovePlugin(pluginDir, "cofidectl-foo"))
+	assert.NoFileExists(t, installed.Path+ManifestExtension)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	cueerrors "cuelang.org/go/cue/errors"
+	"cuelang.org/go/encoding/jsonschema"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ConfigSchemaProvider may be implemented by plugins to declare a schema for their configuration
+// in the plugin_config section of the config file. The schema is reported to the host in the
+// plugin's Capabilities, and is used to validate the plugin's configuration.
+type ConfigSchemaProvider interface {
+	// ConfigSchema returns a CUE schema, or a JSON Schema document.
+	ConfigSchema() string
+}
+
+// ValidateConfig validates plugin configuration against a CUE schema or JSON Schema document.
+// A nil configuration is validated as an empty struct, so that required fields are reported.
+func ValidateConfig(schema string, pluginConfig *structpb.Struct) error {
+	cueContext := cuecontext.New()
+	schemaValue, err := compileConfigSchema(cueContext, schema)
+	if err != nil {
+		return err
+	}
+
+	if pluginConfig == nil {
+		pluginConfig = &structpb.Struct{}
+	}
+	data, err := protojson.Marshal(pluginConfig)
+	if err != nil {
+		return err
+	}
+	value := schemaValue.Unify(cueContext.CompileBytes(data))
+	if err := value.Validate(cue.Concrete(true)); err != nil {
+		return formatCueErrors(err)
+	}
+	return nil
+}
+
+// compileConfigSchema compiles a configuration schema. Schemas that are valid JSON are treated
+// as JSON Schema documents, and are converted to CUE.
+func compileConfigSchema(cueContext *cue.Context, schema string) (cue.Value, error) {
+	var value cue.Value
+	if json.Valid([]byte(schema)) {
+		file, err := jsonschema.Extract(cueContext.CompileString(schema), &jsonschema.Config{})
+		if err != nil {
+			return value, fmt.Errorf("invalid plugin configuration JSON Schema: %w", err)
+		}
+		value = cueContext.BuildFile(file)
+	} else {
+		value = cueContext.CompileString(schema)
+	}
+	if err := value.Err(); err != nil {
+		return value, fmt.Errorf("invalid plugin configuration schema: %w", err)
+	}
+	return value, nil
+}
+
+// formatCueErrors returns an error describing each CUE validation error with the path of the
+// invalid field.
+func formatCueErrors(err error) error {
+	messages := []string{}
+	for _, e := range cueerrors.Errors(err) {
+		format, args := e.Msg()
+		message := fmt.Sprintf(format, args...)
+		if path := strings.Join(e.Path(), "."); path != "" {
+			message = path + ": " + message
+		}
+		messages = append(messages, message)
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testCueSchema = `
+endpoint: string
+port?: int & >0 & <65536
+`
+
+const testJSONSchema = `{
+	"type": "object",
+	"properties": {
+		"endpoint": {"type": "string"},
+		"port": {"type": "integer", "minimum": 1, "maximum": 65535}
+	},
+	"required": ["endpoint"]
+}`
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		schema       string
+		pluginConfig map[string]any
+		wantErr      string
+	}{
+		{
+			name:         "cue valid",
+			schema:       testCueSchema,
+			pluginConfig: map[string]any{"endpoint": "https://example.com", "port": 443},
+		},
+		{
+			name:         "cue missing required field",
+			schema:       testCueSchema,
+			pluginConfig: map[string]any{},
+			wantErr:      "endpoint: incomplete value string",
+		},
+		{
+			name:         "cue invalid value",
+			schema:       testCueSchema,
+			pluginConfig: map[string]any{"endpoint": "https://example.com", "port": 0},
+			wantErr:      "port: invalid value 0 (out of bound >0)",
+		},
+		{
+			name:         "json schema valid",
+			schema:       testJSONSchema,
+			pluginConfig: map[string]any{"endpoint": "https://example.com", "port": 443},
+		},
+		{
+			name:         "json schema wrong type",
+			schema:       testJSONSchema,
+			pluginConfig: map[string]any{"endpoint": 1},
+			wantErr:      "endpoint: conflicting values string and 1 (mismatched types string and int)",
+		},
+		{
+			name:         "json schema invalid value",
+			schema:       testJSONSchema,
+			pluginConfig: map[string]any{"endpoint": "https://example.com", "port": 65536},
+			wantErr:      "port: invalid value 65536 (out of bound <=65535)",
+		},
+		{
+			name:    "invalid schema",
+			schema:  "endpoint: ",
+			wantErr: "invalid plugin configuration schema",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pluginConfig *structpb.Struct
+			if tt.pluginConfig != nil {
+				var err error
+				pluginConfig, err = structpb.NewStruct(tt.pluginConfig)
+				require.NoError(t, err)
+			}
+			err := ValidateConfig(tt.schema, pluginConfig)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource
+
+import (
+	"context"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/pkg/plugin"
+)
+
+// FromV1 adapts a DataSource to the DataSourceV2 interface.
+// Since DataSource methods do not accept a context, the context is checked before each call but
+// cannot interrupt a call in progress. If ds was returned by ToV1, the underlying DataSourceV2 is
+// returned, and if ds is a gRPC plugin client, a context-aware client is returned.
+func FromV1(ds DataSource) DataSourceV2 {
+	switch ds := ds.(type) {
+	case *v2ToV1Adapter:
+		return ds.ds
+	case *DataSourcePluginClientGRPC:
+		return ds.V2()
+	}
+	return &v1ToV2Adapter{ds: ds}
+}
+
+// ToV1 adapts a DataSourceV2 to the DataSource interface, using ctx for all calls.
+// If ds was returned by FromV1, the underlying DataSource is returned.
+func ToV1(ctx context.Context, ds DataSourceV2) DataSource {
+	if ds, ok := ds.(*v1ToV2Adapter); ok {
+		return ds.ds
+	}
+	return &v2ToV1Adapter{ctx: ctx, ds: ds}
+}
+
+// v1ToV2Adapter implements DataSourceV2 using a DataSource.
+type v1ToV2Adapter struct {
+	ds DataSource
+}
+
+var _ DataSourceV2 = (*v1ToV2Adapter)(nil)
+
+// Begin implements TransactorV2.
+// It returns ErrTransactionsNotSupported if the underlying DataSource does not implement Transactor.
+func (a *v1ToV2Adapter) Begin(ctx context.Context) (TransactionV2, error) {
+	transactor, ok := a.ds.(Transactor)
+	if !ok {
+		return nil, ErrTransactionsNotSupported
+	}
+	tx, err := transactor.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1ToV2Transaction{DataSourceV2: &v1ToV2Adapter{ds: tx}, tx: tx}, nil
+}
+
+// Capabilities implements plugin.CapabilityReporter, reporting the capabilities of the underlying
+// DataSource.
+func (a *v1ToV2Adapter) Capabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	return GetCapabilities(ctx, a.ds)
+}
+
+// v1ToV2Transaction implements TransactionV2 using a Transaction.
+type v1ToV2Transaction struct {
+	DataSourceV2
+	tx Transaction
+}
+
+func (t *v1ToV2Transaction) Commit(ctx context.Context) error {
+	return t.tx.Commit(ctx)
+}
+
+func (t *v1ToV2Transaction) Abort(ctx context.Context) error {
+	return t.tx.Abort(ctx)
+}
+
+func (a *v1ToV2Adapter) Validate(ctx context.Context) error {
+	return a.ds.Validate(ctx)
+}
+
+func (a *v1ToV2Adapter) AddTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.AddTrustZone(trustZone)
+}
+
+func (a *v1ToV2Adapter) DestroyTrustZone(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.ds.DestroyTrustZone(id)
+}
+
+func (a *v1ToV2Adapter) GetTrustZone(ctx context.Context, id string) (*trust_zone_proto.TrustZone, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.GetTrustZone(id)
+}
+
+func (a *v1ToV2Adapter) GetTrustZoneByName(ctx context.Context, name string) (*trust_zone_proto.TrustZone, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.GetTrustZoneByName(name)
+}
+
+func (a *v1ToV2Adapter) ListTrustZones(ctx context.Context) ([]*trust_zone_proto.TrustZone, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.ListTrustZones()
+}
+
+func (a *v1ToV2Adapter) UpdateTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.UpdateTrustZone(trustZone)
+}
+
+func (a *v1ToV2Adapter) AddCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.AddCluster(cluster)
+}
+
+func (a *v1ToV2Adapter) DestroyCluster(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.ds.DestroyCluster(id)
+}
+
+func (a *v1ToV2Adapter) GetCluster(ctx context.Context, id string) (*clusterpb.Cluster, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.GetCluster(id)
+}
+
+func (a *v1ToV2Adapter) GetClusterByName(ctx context.Context, name, trustZoneID string) (*clusterpb.Cluster, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.GetClusterByName(name, trustZoneID)
+}
+
+func (a *v1ToV2Adapter) ListClusters(ctx context.Context, filter *datasourcepb.ListClustersRequest_Filter) ([]*clusterpb.Cluster, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.ListClusters(filter)
+}
+
+func (a *v1ToV2Adapter) UpdateCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.UpdateCluster(cluster)
+}
+
+func (a *v1ToV2Adapter) AddAttestationPolicy(ctx context.Context, policy *attestation_policy_proto.AttestationPolicy) (*attestation_policy_proto.AttestationPolicy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.AddAttestationPolicy(policy)
+}
+
+func (a *v1ToV2Adapter) DestroyAttestationPolicy(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.ds.DestroyAttestationPolicy(id)
+}
+
+func (a *v1ToV2Adapter) GetAttestationPolicy(ctx context.Context, id string) (*attestation_policy_proto.AttestationPolicy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.GetAttestationPolicy(id)
+}
+
+func (a *v1ToV2Adapter) GetAttestationPolicyByName(ctx context.Context, name string) (*attestation_policy_proto.AttestationPolicy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.GetAttestationPolicyByName(name)
+}
+
+func (a *v1ToV2Adapter) ListAttestationPolicies(ctx context.Context) ([]*attestation_policy_proto.AttestationPolicy, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.ListAttestationPolicies()
+}
+
+func (a *v1ToV2Adapter) AddAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.AddAPBinding(binding)
+}
+
+func (a *v1ToV2Adapter) DestroyAPBinding(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.ds.DestroyAPBinding(id)
+}
+
+func (a *v1ToV2Adapter) ListAPBindings(ctx context.Context, filter *datasourcepb.ListAPBindingsRequest_Filter) ([]*ap_binding_proto.APBinding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.ListAPBindings(filter)
+}
+
+func (a *v1ToV2Adapter) UpdateAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.UpdateAPBinding(binding)
+}
+
+func (a *v1ToV2Adapter) AddFederation(ctx context.Context, federation *federation_proto.Federation) (*federation_proto.Federation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.AddFederation(federation)
+}
+
+func (a *v1ToV2Adapter) DestroyFederation(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.ds.DestroyFederation(id)
+}
+
+func (a *v1ToV2Adapter) ListFederations(ctx context.Context, filter *datasourcepb.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.ds.ListFederations(filter)
+}
+
+// v2ToV1Adapter implements DataSource using a DataSourceV2 and a fixed context.
+type v2ToV1Adapter struct {
+	ctx context.Context
+	ds  DataSourceV2
+}
+
+var _ DataSource = (*v2ToV1Adapter)(nil)
+
+// Begin implements Transactor.
+// It returns ErrTransactionsNotSupported if the underlying DataSourceV2 does not implement
+// TransactorV2.
+func (a *v2ToV1Adapter) Begin(ctx context.Context) (Transaction, error) {
+	transactor, ok := a.ds.(TransactorV2)
+	if !ok {
+		return nil, ErrTransactionsNotSupported
+	}
+	tx, err := transactor.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toV1Transaction(ctx, tx), nil
+}
+
+// Capabilities implements plugin.CapabilityReporter, reporting the capabilities of the underlying
+// DataSourceV2.
+func (a *v2ToV1Adapter) Capabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	return GetCapabilities(ctx, a.ds)
+}
+
+// v2ToV1Transaction implements Transaction using a TransactionV2.
+type v2ToV1Transaction struct {
+	DataSource
+	tx TransactionV2
+}
+
+// toV1Transaction adapts a TransactionV2 to the Transaction interface, using ctx for all calls.
+func toV1Transaction(ctx context.Context, tx TransactionV2) Transaction {
+	return &v2ToV1Transaction{DataSource: &v2ToV1Adapter{ctx: ctx, ds: tx}, tx: tx}
+}
+
+func (t *v2ToV1Transaction) Commit(ctx context.Context) error {
+	return t.tx.Commit(ctx)
+}
+
+func (t *v2ToV1Transaction) Abort(ctx context.Context) error {
+	return t.tx.Abort(ctx)
+}
+
+func (a *v2ToV1Adapter) Validate(ctx context.Context) error {
+	return a.ds.Validate(ctx)
+}
+
+func (a *v2ToV1Adapter) AddTrustZone(trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	return a.ds.AddTrustZone(a.ctx, trustZone)
+}
+
+func (a *v2ToV1Adapter) DestroyTrustZone(id string) error {
+	return a.ds.DestroyTrustZone(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) GetTrustZone(id string) (*trust_zone_proto.TrustZone, error) {
+	return a.ds.GetTrustZone(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) GetTrustZoneByName(name string) (*trust_zone_proto.TrustZone, error) {
+	return a.ds.GetTrustZoneByName(a.ctx, name)
+}
+
+func (a *v2ToV1Adapter) ListTrustZones() ([]*trust_zone_proto.TrustZone, error) {
+	return a.ds.ListTrustZones(a.ctx)
+}
+
+func (a *v2ToV1Adapter) UpdateTrustZone(trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	return a.ds.UpdateTrustZone(a.ctx, trustZone)
+}
+
+func (a *v2ToV1Adapter) AddCluster(cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	return a.ds.AddCluster(a.ctx, cluster)
+}
+
+func (a *v2ToV1Adapter) DestroyCluster(id string) error {
+	return a.ds.DestroyCluster(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) GetCluster(id string) (*clusterpb.Cluster, error) {
+	return a.ds.GetCluster(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) GetClusterByName(name, trustZoneID string) (*clusterpb.Cluster, error) {
+	return a.ds.GetClusterByName(a.ctx, name, trustZoneID)
+}
+
+func (a *v2ToV1Adapter) ListClusters(filter *datasourcepb.ListClustersRequest_Filter) ([]*clusterpb.Cluster, error) {
+	return a.ds.ListClusters(a.ctx, filter)
+}
+
+func (a *v2ToV1Adapter) UpdateCluster(cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	return a.ds.UpdateCluster(a.ctx, cluster)
+}
+
+func (a *v2ToV1Adapter) AddAttestationPolicy(policy *attestation_policy_proto.AttestationPolicy) (*attestation_policy_proto.AttestationPolicy, error) {
+	return a.ds.AddAttestationPolicy(a.ctx, policy)
+}
+
+func (a *v2ToV1Adapter) DestroyAttestationPolicy(id string) error {
+	return a.ds.DestroyAttestationPolicy(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) GetAttestationPolicy(id string) (*attestation_policy_proto.AttestationPolicy, error) {
+	return a.ds.GetAttestationPolicy(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) GetAttestationPolicyByName(name string) (*attestation_policy_proto.AttestationPolicy, error) {
+	return a.ds.GetAttestationPolicyByName(a.ctx, name)
+}
+
+func (a *v2ToV1Adapter) ListAttestationPolicies() ([]*attestation_policy_proto.AttestationPolicy, error) {
+	return a.ds.ListAttestationPolicies(a.ctx)
+}
+
+func (a *v2ToV1Adapter) AddAPBinding(binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	return a.ds.AddAPBinding(a.ctx, binding)
+}
+
+func (a *v2ToV1Adapter) DestroyAPBinding(id string) error {
+	return a.ds.DestroyAPBinding(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) ListAPBindings(filter *datasourcepb.ListAPBindingsRequest_Filter) ([]*ap_binding_proto.APBinding, error) {
+	return a.ds.ListAPBindings(a.ctx, filter)
+}
+
+func (a *v2ToV1Adapter) UpdateAPBinding(binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	return a.ds.UpdateAPBinding(a.ctx, binding)
+}
+
+func (a *v2ToV1Adapter) AddFederation(federation *federation_proto.Federation) (*federation_proto.Federation, error) {
+	return a.ds.AddFederation(a.ctx, federation)
+}
+
+func (a *v2ToV1Adapter) DestroyFederation(id string) error {
+	return a.ds.DestroyFederation(a.ctx, id)
+}
+
+func (a *v2ToV1Adapter) ListFederations(filter *datasourcepb.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error) {
+	return a.ds.ListFederations(a.ctx, filter)
+}
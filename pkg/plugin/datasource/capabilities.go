@@ -0,0 +1,84 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	dspb "github.com/cofide/cofidectl/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// APIVersion is the version of the data source plugin API implemented by this package.
+	APIVersion = "v1alpha2"
+	// FeatureTransactions indicates that a data source supports transactions.
+	FeatureTransactions = "transactions"
+)
+
+// GetCapabilities returns the capabilities of a data source implementation. If impl implements
+// plugin.CapabilityReporter, it is asked for its capabilities, otherwise they are inferred from
+// the optional interfaces that it implements.
+func GetCapabilities(ctx context.Context, impl any) (*plugin.Capabilities, error) {
+	if reporter, ok := impl.(plugin.CapabilityReporter); ok {
+		return reporter.Capabilities(ctx)
+	}
+
+	capabilities := baselineCapabilities()
+	switch impl.(type) {
+	case Transactor, TransactorV2:
+		capabilities.Features = append(capabilities.Features, FeatureTransactions)
+	}
+	if provider, ok := impl.(plugin.ConfigSchemaProvider); ok {
+		capabilities.ConfigSchema = provider.ConfigSchema()
+	}
+	return capabilities, nil
+}
+
+// baselineCapabilities returns the capabilities of a data source that supports the current API
+// version and no optional features.
+func baselineCapabilities() *plugin.Capabilities {
+	return &plugin.Capabilities{APIVersions: []string{APIVersion}, Features: []string{}}
+}
+
+// GetCapabilitiesGRPC returns the capabilities of a data source plugin over a gRPC connection.
+// Plugins that do not implement the capabilities service are assumed to support the current API
+// version and no optional features.
+func GetCapabilitiesGRPC(ctx context.Context, conn grpc.ClientConnInterface) (*plugin.Capabilities, error) {
+	resp, err := dspb.NewDataSourceCapabilitiesServiceClient(conn).GetCapabilities(ctx, &dspb.GetCapabilitiesRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return baselineCapabilities(), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get plugin capabilities: %w", err)
+	}
+	return plugin.CapabilitiesFromProto(resp.GetCapabilities()), nil
+}
+
+var _ dspb.DataSourceCapabilitiesServiceServer = &GRPCServer{}
+
+// GetCapabilities implements the DataSourceCapabilitiesService.
+func (s *GRPCServer) GetCapabilities(ctx context.Context, _ *dspb.GetCapabilitiesRequest) (*dspb.GetCapabilitiesResponse, error) {
+	capabilities, err := GetCapabilities(ctx, s.implV2())
+	if err != nil {
+		return nil, err
+	}
+	return &dspb.GetCapabilitiesResponse{Capabilities: capabilities.ToProto()}, nil
+}
+
+// Capabilities implements plugin.CapabilityReporter.
+func (c *DataSourcePluginClientGRPC) Capabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	return c.V2().Capabilities(ctx)
+}
+
+// Capabilities implements plugin.CapabilityReporter.
+func (c *DataSourcePluginClientGRPCV2) Capabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	if c.conn == nil {
+		return baselineCapabilities(), nil
+	}
+	return GetCapabilitiesGRPC(ctx, c.conn)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCapabilities(t *testing.T) {
+	tests := []struct {
+		name     string
+		grpc     bool
+		register bool
+		want     *plugin.Capabilities
+	}{
+		{
+			name: "local",
+			want: &plugin.Capabilities{APIVersions: []string{datasource.APIVersion}, Features: []string{datasource.FeatureTransactions}},
+		},
+		{
+			name:     "grpc",
+			grpc:     true,
+			register: true,
+			want:     &plugin.Capabilities{APIVersions: []string{datasource.APIVersion}, Features: []string{datasource.FeatureTransactions}},
+		},
+		{
+			// Plugins that predate the capabilities service support the baseline API.
+			name: "grpc without capabilities service",
+			grpc: true,
+			want: &plugin.Capabilities{APIVersions: []string{datasource.APIVersion}, Features: []string{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lds, _ := newLocalDataSource(t)
+			var ds datasource.DataSource = lds
+			if tt.grpc {
+				ds = newGRPCClient(t, lds, tt.register)
+			}
+
+			got, err := datasource.GetCapabilities(context.Background(), ds)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,845 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance provides a test suite for implementations of datasource.DataSource.
+//
+// The suite checks the behaviour that cofidectl relies on from a data source plugin: IDs are
+// generated on add, names are unique, immutable fields cannot be updated, list filters are
+// applied, destroying a resource that is in use fails, and errors are reported using the
+// datasource.ErrNotFound, datasource.ErrAlreadyExists and datasource.ErrFailedPrecondition
+// kinds. Plugin authors can run it from their own tests:
+//
+//	func TestMyDataSource_conformance(t *testing.T) {
+//		newDataSource := func(t *testing.T) datasource.DataSource {
+//			return mydatasource.New()
+//		}
+//		conformance.Run(t, newDataSource)
+//		conformance.RunGRPC(t, newDataSource)
+//	}
+package conformance
+
+import (
+	"testing"
+
+	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
+	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	federation_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/federation/v1alpha1"
+	trust_provider_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_provider/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	go_plugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// Factory returns a new data source containing no resources. It is called once for each test
+// in the suite, and may use t to register cleanup functions.
+type Factory func(t *testing.T) datasource.DataSource
+
+// Run runs the conformance suite against data sources returned by newDataSource, calling them
+// in-process.
+func Run(t *testing.T, newDataSource Factory) {
+	t.Run("TrustZones", func(t *testing.T) { testTrustZones(t, newDataSource) })
+	t.Run("Clusters", func(t *testing.T) { testClusters(t, newDataSource) })
+	t.Run("AttestationPolicies", func(t *testing.T) { testAttestationPolicies(t, newDataSource) })
+	t.Run("APBindings", func(t *testing.T) { testAPBindings(t, newDataSource) })
+	t.Run("Federations", func(t *testing.T) { testFederations(t, newDataSource) })
+}
+
+// RunGRPC runs the conformance suite against data sources returned by newDataSource, served by
+// a datasource.DataSourcePlugin and called over the gRPC plugin transport.
+func RunGRPC(t *testing.T, newDataSource Factory) {
+	t.Run("gRPC", func(t *testing.T) {
+		Run(t, func(t *testing.T) datasource.DataSource {
+			return serveGRPC(t, newDataSource(t))
+		})
+	})
+}
+
+// serveGRPC serves ds as a data source plugin, returning a connected client.
+func serveGRPC(t *testing.T, ds datasource.DataSource) datasource.DataSource {
+	t.Helper()
+	plugins := map[string]go_plugin.Plugin{
+		datasource.DataSourcePluginName: &datasource.DataSourcePlugin{Impl: ds},
+	}
+	client, server := go_plugin.TestPluginGRPCConn(t, false, plugins)
+	t.Cleanup(func() {
+		_ = client.Close()
+		server.Stop()
+	})
+
+	raw, err := client.Dispense(datasource.DataSourcePluginName)
+	require.NoError(t, err)
+	source, ok := raw.(datasource.DataSource)
+	require.True(t, ok, "dispensed plugin does not implement DataSource")
+	return source
+}
+
+func testTrustZones(t *testing.T, newDataSource Factory) {
+	t.Run("Add", func(t *testing.T) {
+		ds := newDataSource(t)
+		input := newTrustZone("tz1")
+		got, err := ds.AddTrustZone(input)
+		require.NoError(t, err)
+		assert.NotEmpty(t, got.GetId(), "ID should be generated")
+		assert.Equal(t, "tz1", got.GetName())
+		assert.Equal(t, "td1.example.com", got.GetTrustDomain())
+		assert.Empty(t, input.GetId(), "input should not be modified")
+
+		// Modifying the input after adding should not modify the stored trust zone.
+		input.TrustDomain = "modified.example.com"
+		stored, err := ds.GetTrustZone(got.GetId())
+		require.NoError(t, err)
+		assert.Equal(t, "td1.example.com", stored.GetTrustDomain())
+	})
+
+	t.Run("AddWithID", func(t *testing.T) {
+		ds := newDataSource(t)
+		input := newTrustZone("tz1")
+		input.Id = ptr("tz1-id")
+		_, err := ds.AddTrustZone(input)
+		require.Error(t, err)
+	})
+
+	t.Run("AddDuplicate", func(t *testing.T) {
+		ds := newDataSource(t)
+		addTrustZone(t, ds, "tz1")
+		_, err := ds.AddTrustZone(newTrustZone("tz1"))
+		assert.ErrorIs(t, err, datasource.ErrAlreadyExists)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		addTrustZone(t, ds, "tz2")
+
+		got, err := ds.GetTrustZone(tz.GetId())
+		require.NoError(t, err)
+		assertProtoEqual(t, tz, got)
+
+		got, err = ds.GetTrustZoneByName("tz1")
+		require.NoError(t, err)
+		assertProtoEqual(t, tz, got)
+
+		// Modifying the result should not modify the stored trust zone.
+		got.TrustDomain = "modified.example.com"
+		got, err = ds.GetTrustZone(tz.GetId())
+		require.NoError(t, err)
+		assertProtoEqual(t, tz, got)
+	})
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		_, err := ds.GetTrustZone("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+		_, err = ds.GetTrustZoneByName("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		ds := newDataSource(t)
+		got, err := ds.ListTrustZones()
+		require.NoError(t, err)
+		assert.Empty(t, got)
+
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		got, err = ds.ListTrustZones()
+		require.NoError(t, err)
+		assertIDs(t, got, tz1, tz2)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		tz.JwtIssuer = ptr("https://issuer.example.com")
+		got, err := ds.UpdateTrustZone(tz)
+		require.NoError(t, err)
+		assert.Equal(t, "https://issuer.example.com", got.GetJwtIssuer())
+
+		stored, err := ds.GetTrustZone(tz.GetId())
+		require.NoError(t, err)
+		assert.Equal(t, "https://issuer.example.com", stored.GetJwtIssuer())
+	})
+
+	t.Run("UpdateImmutable", func(t *testing.T) {
+		tests := map[string]func(*trust_zone_proto.TrustZone){
+			"name":         func(tz *trust_zone_proto.TrustZone) { tz.Name = "modified" },
+			"trust domain": func(tz *trust_zone_proto.TrustZone) { tz.TrustDomain = "modified.example.com" },
+		}
+		for name, modify := range tests {
+			t.Run(name, func(t *testing.T) {
+				ds := newDataSource(t)
+				tz := addTrustZone(t, ds, "tz1")
+				modify(tz)
+				_, err := ds.UpdateTrustZone(tz)
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("UpdateNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := newTrustZone("tz1")
+		tz.Id = ptr("invalid")
+		_, err := ds.UpdateTrustZone(tz)
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("Destroy", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		require.NoError(t, ds.DestroyTrustZone(tz.GetId()))
+		_, err := ds.GetTrustZone(tz.GetId())
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("DestroyWithClusters", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		addCluster(t, ds, "cluster1", tz.GetId())
+		err := ds.DestroyTrustZone(tz.GetId())
+		assert.ErrorIs(t, err, datasource.ErrFailedPrecondition)
+	})
+
+	t.Run("DestroyCascades", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		addAPBinding(t, ds, tz1.GetId(), policy.GetId())
+		addFederation(t, ds, tz1.GetId(), tz2.GetId())
+		addFederation(t, ds, tz2.GetId(), tz1.GetId())
+
+		require.NoError(t, ds.DestroyTrustZone(tz1.GetId()))
+
+		bindings, err := ds.ListAPBindings(nil)
+		require.NoError(t, err)
+		assert.Empty(t, bindings, "bindings in the trust zone should be destroyed")
+		federations, err := ds.ListFederations(nil)
+		require.NoError(t, err)
+		assert.Empty(t, federations, "federations with the trust zone should be destroyed")
+	})
+
+	t.Run("DestroyNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		err := ds.DestroyTrustZone("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+}
+
+func testClusters(t *testing.T, newDataSource Factory) {
+	t.Run("Add", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		input := newCluster("cluster1", tz.GetId())
+		got, err := ds.AddCluster(input)
+		require.NoError(t, err)
+		assert.NotEmpty(t, got.GetId(), "ID should be generated")
+		assert.Equal(t, "cluster1", got.GetName())
+		assert.Equal(t, tz.GetId(), got.GetTrustZoneId())
+		assert.Equal(t, "kubernetes", got.GetProfile())
+		assert.Equal(t, "kubernetes", got.GetTrustProvider().GetKind())
+		assert.Empty(t, input.GetId(), "input should not be modified")
+	})
+
+	t.Run("AddWithID", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		input := newCluster("cluster1", tz.GetId())
+		input.Id = ptr("cluster1-id")
+		_, err := ds.AddCluster(input)
+		require.Error(t, err)
+	})
+
+	t.Run("AddDuplicate", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		addCluster(t, ds, "cluster1", tz1.GetId())
+		_, err := ds.AddCluster(newCluster("cluster1", tz1.GetId()))
+		assert.ErrorIs(t, err, datasource.ErrAlreadyExists)
+
+		// Cluster names are unique within a trust zone.
+		addCluster(t, ds, "cluster1", tz2.GetId())
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		cluster := addCluster(t, ds, "cluster1", tz.GetId())
+		addCluster(t, ds, "cluster2", tz.GetId())
+
+		got, err := ds.GetCluster(cluster.GetId())
+		require.NoError(t, err)
+		assertProtoEqual(t, cluster, got)
+
+		got, err = ds.GetClusterByName("cluster1", tz.GetId())
+		require.NoError(t, err)
+		assertProtoEqual(t, cluster, got)
+
+		// Modifying the result should not modify the stored cluster.
+		got.KubernetesContext = ptr("modified")
+		got, err = ds.GetCluster(cluster.GetId())
+		require.NoError(t, err)
+		assertProtoEqual(t, cluster, got)
+	})
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		_, err := ds.GetCluster("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+		_, err = ds.GetClusterByName("invalid", tz.GetId())
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		got, err := ds.ListClusters(nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+
+		cluster1 := addCluster(t, ds, "cluster1", tz1.GetId())
+		cluster2 := addCluster(t, ds, "cluster2", tz2.GetId())
+
+		got, err = ds.ListClusters(nil)
+		require.NoError(t, err)
+		assertIDs(t, got, cluster1, cluster2)
+
+		got, err = ds.ListClusters(&datasourcepb.ListClustersRequest_Filter{})
+		require.NoError(t, err)
+		assertIDs(t, got, cluster1, cluster2)
+
+		got, err = ds.ListClusters(&datasourcepb.ListClustersRequest_Filter{TrustZoneId: tz2.Id})
+		require.NoError(t, err)
+		assertIDs(t, got, cluster2)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		cluster := addCluster(t, ds, "cluster1", tz.GetId())
+		cluster.KubernetesContext = ptr("modified")
+		got, err := ds.UpdateCluster(cluster)
+		require.NoError(t, err)
+		assert.Equal(t, "modified", got.GetKubernetesContext())
+
+		stored, err := ds.GetCluster(cluster.GetId())
+		require.NoError(t, err)
+		assert.Equal(t, "modified", stored.GetKubernetesContext())
+	})
+
+	t.Run("UpdateImmutable", func(t *testing.T) {
+		tests := map[string]func(*clusterpb.Cluster){
+			"name":                func(c *clusterpb.Cluster) { c.Name = ptr("modified") },
+			"trust zone":          func(c *clusterpb.Cluster) { c.TrustZoneId = ptr("modified") },
+			"profile":             func(c *clusterpb.Cluster) { c.Profile = ptr("modified") },
+			"trust provider kind": func(c *clusterpb.Cluster) { c.TrustProvider.Kind = ptr("modified") },
+			"no trust provider":   func(c *clusterpb.Cluster) { c.TrustProvider = nil },
+		}
+		for name, modify := range tests {
+			t.Run(name, func(t *testing.T) {
+				ds := newDataSource(t)
+				tz := addTrustZone(t, ds, "tz1")
+				cluster := addCluster(t, ds, "cluster1", tz.GetId())
+				modify(cluster)
+				_, err := ds.UpdateCluster(cluster)
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("UpdateNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		cluster := newCluster("cluster1", tz.GetId())
+		cluster.Id = ptr("invalid")
+		_, err := ds.UpdateCluster(cluster)
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("Destroy", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		cluster := addCluster(t, ds, "cluster1", tz.GetId())
+		require.NoError(t, ds.DestroyCluster(cluster.GetId()))
+		_, err := ds.GetCluster(cluster.GetId())
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+
+		// The trust zone can be destroyed once it has no clusters.
+		require.NoError(t, ds.DestroyTrustZone(tz.GetId()))
+	})
+
+	t.Run("DestroyNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		err := ds.DestroyCluster("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+}
+
+func testAttestationPolicies(t *testing.T, newDataSource Factory) {
+	t.Run("Add", func(t *testing.T) {
+		ds := newDataSource(t)
+		input := newAttestationPolicy("ap1")
+		got, err := ds.AddAttestationPolicy(input)
+		require.NoError(t, err)
+		assert.NotEmpty(t, got.GetId(), "ID should be generated")
+		assert.Equal(t, "ap1", got.GetName())
+		assert.Equal(t, map[string]string{"app": "ap1"}, got.GetKubernetes().GetNamespaceSelector().GetMatchLabels())
+		assert.Empty(t, input.GetId(), "input should not be modified")
+	})
+
+	t.Run("AddWithID", func(t *testing.T) {
+		ds := newDataSource(t)
+		input := newAttestationPolicy("ap1")
+		input.Id = ptr("ap1-id")
+		_, err := ds.AddAttestationPolicy(input)
+		require.Error(t, err)
+	})
+
+	t.Run("AddDuplicate", func(t *testing.T) {
+		ds := newDataSource(t)
+		addAttestationPolicy(t, ds, "ap1")
+		_, err := ds.AddAttestationPolicy(newAttestationPolicy("ap1"))
+		assert.ErrorIs(t, err, datasource.ErrAlreadyExists)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		ds := newDataSource(t)
+		policy := addAttestationPolicy(t, ds, "ap1")
+		addAttestationPolicy(t, ds, "ap2")
+
+		got, err := ds.GetAttestationPolicy(policy.GetId())
+		require.NoError(t, err)
+		assertProtoEqual(t, policy, got)
+
+		got, err = ds.GetAttestationPolicyByName("ap1")
+		require.NoError(t, err)
+		assertProtoEqual(t, policy, got)
+
+		// Modifying the result should not modify the stored policy.
+		got.GetKubernetes().GetNamespaceSelector().MatchLabels["app"] = "modified"
+		got, err = ds.GetAttestationPolicy(policy.GetId())
+		require.NoError(t, err)
+		assertProtoEqual(t, policy, got)
+	})
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		_, err := ds.GetAttestationPolicy("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+		_, err = ds.GetAttestationPolicyByName("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		ds := newDataSource(t)
+		got, err := ds.ListAttestationPolicies()
+		require.NoError(t, err)
+		assert.Empty(t, got)
+
+		policy1 := addAttestationPolicy(t, ds, "ap1")
+		policy2 := addAttestationPolicy(t, ds, "ap2")
+		got, err = ds.ListAttestationPolicies()
+		require.NoError(t, err)
+		assertIDs(t, got, policy1, policy2)
+	})
+
+	t.Run("Destroy", func(t *testing.T) {
+		ds := newDataSource(t)
+		policy := addAttestationPolicy(t, ds, "ap1")
+		require.NoError(t, ds.DestroyAttestationPolicy(policy.GetId()))
+		_, err := ds.GetAttestationPolicy(policy.GetId())
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("DestroyBound", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		binding := addAPBinding(t, ds, tz.GetId(), policy.GetId())
+		err := ds.DestroyAttestationPolicy(policy.GetId())
+		assert.ErrorIs(t, err, datasource.ErrFailedPrecondition)
+
+		// The policy can be destroyed once it is unbound.
+		require.NoError(t, ds.DestroyAPBinding(binding.GetId()))
+		require.NoError(t, ds.DestroyAttestationPolicy(policy.GetId()))
+	})
+
+	t.Run("DestroyNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		err := ds.DestroyAttestationPolicy("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+}
+
+func testAPBindings(t *testing.T, newDataSource Factory) {
+	t.Run("Add", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		addFederation(t, ds, tz1.GetId(), tz2.GetId())
+
+		input := newAPBinding(tz1.GetId(), policy.GetId(), tz2.GetId())
+		got, err := ds.AddAPBinding(input)
+		require.NoError(t, err)
+		assert.NotEmpty(t, got.GetId(), "ID should be generated")
+		assert.Equal(t, tz1.GetId(), got.GetTrustZoneId())
+		assert.Equal(t, policy.GetId(), got.GetPolicyId())
+		require.Len(t, got.GetFederations(), 1)
+		assert.Equal(t, tz2.GetId(), got.GetFederations()[0].GetTrustZoneId())
+		assert.Empty(t, input.GetId(), "input should not be modified")
+	})
+
+	t.Run("AddWithID", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		input := newAPBinding(tz.GetId(), policy.GetId())
+		input.Id = ptr("binding-id")
+		_, err := ds.AddAPBinding(input)
+		require.Error(t, err)
+	})
+
+	t.Run("AddNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		_, err := ds.AddAPBinding(newAPBinding("invalid", policy.GetId()))
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+		_, err = ds.AddAPBinding(newAPBinding(tz.GetId(), "invalid"))
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("AddDuplicate", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		addAPBinding(t, ds, tz.GetId(), policy.GetId())
+		_, err := ds.AddAPBinding(newAPBinding(tz.GetId(), policy.GetId()))
+		assert.ErrorIs(t, err, datasource.ErrAlreadyExists)
+	})
+
+	t.Run("AddInvalidFederation", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		policy := addAttestationPolicy(t, ds, "ap1")
+
+		// A binding cannot federate with its own trust zone.
+		_, err := ds.AddAPBinding(newAPBinding(tz1.GetId(), policy.GetId(), tz1.GetId()))
+		require.Error(t, err)
+		// A binding cannot federate with a trust zone that its trust zone is not federated with.
+		_, err = ds.AddAPBinding(newAPBinding(tz1.GetId(), policy.GetId(), tz2.GetId()))
+		require.Error(t, err)
+		// A binding cannot federate with a trust zone that does not exist.
+		_, err = ds.AddAPBinding(newAPBinding(tz1.GetId(), policy.GetId(), "invalid"))
+		require.Error(t, err)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		policy1 := addAttestationPolicy(t, ds, "ap1")
+		policy2 := addAttestationPolicy(t, ds, "ap2")
+		got, err := ds.ListAPBindings(nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+
+		binding1 := addAPBinding(t, ds, tz1.GetId(), policy1.GetId())
+		binding2 := addAPBinding(t, ds, tz1.GetId(), policy2.GetId())
+		binding3 := addAPBinding(t, ds, tz2.GetId(), policy1.GetId())
+
+		got, err = ds.ListAPBindings(nil)
+		require.NoError(t, err)
+		assertIDs(t, got, binding1, binding2, binding3)
+
+		got, err = ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{TrustZoneId: tz1.Id})
+		require.NoError(t, err)
+		assertIDs(t, got, binding1, binding2)
+
+		got, err = ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{PolicyId: policy1.Id})
+		require.NoError(t, err)
+		assertIDs(t, got, binding1, binding3)
+
+		got, err = ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{TrustZoneId: tz2.Id, PolicyId: policy1.Id})
+		require.NoError(t, err)
+		assertIDs(t, got, binding3)
+	})
+
+	t.Run("ListTrustZoneNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		_, err := ds.ListAPBindings(&datasourcepb.ListAPBindingsRequest_Filter{TrustZoneId: ptr("invalid")})
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		addFederation(t, ds, tz1.GetId(), tz2.GetId())
+		binding := addAPBinding(t, ds, tz1.GetId(), policy.GetId())
+
+		binding.Federations = newAPBinding(tz1.GetId(), policy.GetId(), tz2.GetId()).Federations
+		got, err := ds.UpdateAPBinding(binding)
+		require.NoError(t, err)
+		require.Len(t, got.GetFederations(), 1)
+		assert.Equal(t, tz2.GetId(), got.GetFederations()[0].GetTrustZoneId())
+
+		stored, err := ds.ListAPBindings(nil)
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		assertProtoEqual(t, got, stored[0])
+	})
+
+	t.Run("UpdateImmutable", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		policy1 := addAttestationPolicy(t, ds, "ap1")
+		policy2 := addAttestationPolicy(t, ds, "ap2")
+		binding := addAPBinding(t, ds, tz1.GetId(), policy1.GetId())
+
+		update := proto.Clone(binding).(*ap_binding_proto.APBinding)
+		update.TrustZoneId = tz2.Id
+		_, err := ds.UpdateAPBinding(update)
+		require.Error(t, err)
+
+		update = proto.Clone(binding).(*ap_binding_proto.APBinding)
+		update.PolicyId = policy2.Id
+		_, err = ds.UpdateAPBinding(update)
+		require.Error(t, err)
+	})
+
+	t.Run("UpdateNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		binding := newAPBinding(tz.GetId(), policy.GetId())
+		binding.Id = ptr("invalid")
+		_, err := ds.UpdateAPBinding(binding)
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("Destroy", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		policy := addAttestationPolicy(t, ds, "ap1")
+		binding := addAPBinding(t, ds, tz.GetId(), policy.GetId())
+		require.NoError(t, ds.DestroyAPBinding(binding.GetId()))
+		got, err := ds.ListAPBindings(nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("DestroyNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		err := ds.DestroyAPBinding("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+}
+
+func testFederations(t *testing.T, newDataSource Factory) {
+	t.Run("Add", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		input := newFederation(tz1.GetId(), tz2.GetId())
+		got, err := ds.AddFederation(input)
+		require.NoError(t, err)
+		assert.NotEmpty(t, got.GetId(), "ID should be generated")
+		assert.Equal(t, tz1.GetId(), got.GetTrustZoneId())
+		assert.Equal(t, tz2.GetId(), got.GetRemoteTrustZoneId())
+		assert.Empty(t, input.GetId(), "input should not be modified")
+	})
+
+	t.Run("AddWithID", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		input := newFederation(tz1.GetId(), tz2.GetId())
+		input.Id = ptr("federation-id")
+		_, err := ds.AddFederation(input)
+		require.Error(t, err)
+	})
+
+	t.Run("AddNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		_, err := ds.AddFederation(newFederation("invalid", tz.GetId()))
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+		_, err = ds.AddFederation(newFederation(tz.GetId(), "invalid"))
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+
+	t.Run("AddSelf", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz := addTrustZone(t, ds, "tz1")
+		_, err := ds.AddFederation(newFederation(tz.GetId(), tz.GetId()))
+		require.Error(t, err)
+	})
+
+	t.Run("AddDuplicate", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		addFederation(t, ds, tz1.GetId(), tz2.GetId())
+		_, err := ds.AddFederation(newFederation(tz1.GetId(), tz2.GetId()))
+		assert.ErrorIs(t, err, datasource.ErrAlreadyExists)
+
+		// Federations are directional.
+		addFederation(t, ds, tz2.GetId(), tz1.GetId())
+	})
+
+	t.Run("List", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		got, err := ds.ListFederations(nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+
+		federation1 := addFederation(t, ds, tz1.GetId(), tz2.GetId())
+		federation2 := addFederation(t, ds, tz2.GetId(), tz1.GetId())
+
+		got, err = ds.ListFederations(nil)
+		require.NoError(t, err)
+		assertIDs(t, got, federation1, federation2)
+
+		got, err = ds.ListFederations(&datasourcepb.ListFederationsRequest_Filter{TrustZoneId: tz1.Id})
+		require.NoError(t, err)
+		assertIDs(t, got, federation1)
+	})
+
+	t.Run("Destroy", func(t *testing.T) {
+		ds := newDataSource(t)
+		tz1 := addTrustZone(t, ds, "tz1")
+		tz2 := addTrustZone(t, ds, "tz2")
+		federation := addFederation(t, ds, tz1.GetId(), tz2.GetId())
+		require.NoError(t, ds.DestroyFederation(federation.GetId()))
+		got, err := ds.ListFederations(nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("DestroyNotFound", func(t *testing.T) {
+		ds := newDataSource(t)
+		err := ds.DestroyFederation("invalid")
+		assert.ErrorIs(t, err, datasource.ErrNotFound)
+	})
+}
+
+func newTrustZone(name string) *trust_zone_proto.TrustZone {
+	return &trust_zone_proto.TrustZone{
+		Name:        name,
+		TrustDomain: "td" + name[len(name)-1:] + ".example.com",
+	}
+}
+
+func newCluster(name, trustZoneID string) *clusterpb.Cluster {
+	return &clusterpb.Cluster{
+		Name:              ptr(name),
+		TrustZoneId:       ptr(trustZoneID),
+		KubernetesContext: ptr("kind-" + name),
+		Profile:           ptr("kubernetes"),
+		TrustProvider:     &trust_provider_proto.TrustProvider{Kind: ptr("kubernetes")},
+	}
+}
+
+func newAttestationPolicy(name string) *attestation_policy_proto.AttestationPolicy {
+	return &attestation_policy_proto.AttestationPolicy{
+		Name: name,
+		Policy: &attestation_policy_proto.AttestationPolicy_Kubernetes{
+			Kubernetes: &attestation_policy_proto.APKubernetes{
+				NamespaceSelector: &attestation_policy_proto.APLabelSelector{
+					MatchLabels: map[string]string{"app": name},
+				},
+			},
+		},
+	}
+}
+
+func newAPBinding(trustZoneID, policyID string, federatesWith ...string) *ap_binding_proto.APBinding {
+	binding := &ap_binding_proto.APBinding{
+		TrustZoneId: ptr(trustZoneID),
+		PolicyId:    ptr(policyID),
+	}
+	for _, remoteID := range federatesWith {
+		binding.Federations = append(binding.Federations, &ap_binding_proto.APBindingFederation{TrustZoneId: ptr(remoteID)})
+	}
+	return binding
+}
+
+func newFederation(trustZoneID, remoteTrustZoneID string) *federation_proto.Federation {
+	return &federation_proto.Federation{
+		TrustZoneId:       ptr(trustZoneID),
+		RemoteTrustZoneId: ptr(remoteTrustZoneID),
+	}
+}
+
+func addTrustZone(t *testing.T, ds datasource.DataSource, name string) *trust_zone_proto.TrustZone {
+	t.Helper()
+	tz, err := ds.AddTrustZone(newTrustZone(name))
+	require.NoError(t, err)
+	return tz
+}
+
+func addCluster(t *testing.T, ds datasource.DataSource, name, trustZoneID string) *clusterpb.Cluster {
+	t.Helper()
+	cluster, err := ds.AddCluster(newCluster(name, trustZoneID))
+	require.NoError(t, err)
+	return cluster
+}
+
+func addAttestationPolicy(t *testing.T, ds datasource.DataSource, name string) *attestation_policy_proto.AttestationPolicy {
+	t.Helper()
+	policy, err := ds.AddAttestationPolicy(newAttestationPolicy(name))
+	require.NoError(t, err)
+	return policy
+}
+
+func addAPBinding(t *testing.T, ds datasource.DataSource, trustZoneID, policyID string) *ap_binding_proto.APBinding {
+	t.Helper()
+	binding, err := ds.AddAPBinding(newAPBinding(trustZoneID, policyID))
+	require.NoError(t, err)
+	return binding
+}
+
+func addFederation(t *testing.T, ds datasource.DataSource, trustZoneID, remoteTrustZoneID string) *federation_proto.Federation {
+	t.Helper()
+	federation, err := ds.AddFederation(newFederation(trustZoneID, remoteTrustZoneID))
+	require.NoError(t, err)
+	return federation
+}
+
+func assertProtoEqual(t *testing.T, want, got proto.Message) {
+	t.Helper()
+	assert.True(t, proto.Equal(want, got), "want %v, got %v", want, got)
+}
+
+// assertIDs asserts that got contains exactly the resources in want, in any order.
+func assertIDs[T interface{ GetId() string }](t *testing.T, got []T, want ...T) {
+	t.Helper()
+	gotIDs := make([]string, 0, len(got))
+	for _, resource := range got {
+		gotIDs = append(gotIDs, resource.GetId())
+	}
+	wantIDs := make([]string, 0, len(want))
+	for _, resource := range want {
+		wantIDs = append(wantIDs, resource.GetId())
+	}
+	assert.ElementsMatch(t, wantIDs, gotIDs)
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sentinel errors that classify data source errors. Use errors.Is to check whether an error
+// returned by a data source is of a particular kind.
+// Errors of these kinds are transported over gRPC as statuses with the corresponding code.
+var (
+	// ErrNotFound indicates that a requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists indicates that a resource being added already exists.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrFailedPrecondition indicates that an operation was rejected because the data is not in
+	// the required state, e.g. deleting a trust zone that contains clusters.
+	ErrFailedPrecondition = errors.New("failed precondition")
+)
+
+// kindCodes maps each sentinel error to a gRPC status code.
+var kindCodes = map[error]codes.Code{
+	ErrNotFound:           codes.NotFound,
+	ErrAlreadyExists:      codes.AlreadyExists,
+	ErrFailedPrecondition: codes.FailedPrecondition,
+}
+
+// Error is a data source error of a particular kind. Its message is not prefixed by the kind,
+// allowing data sources to classify errors without changing their messages.
+type Error struct {
+	kind    error
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Unwrap returns the kind of the error, one of ErrNotFound, ErrAlreadyExists or
+// ErrFailedPrecondition.
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+// NotFoundf returns an error of kind ErrNotFound with a formatted message.
+func NotFoundf(format string, args ...any) error {
+	return &Error{kind: ErrNotFound, message: fmt.Sprintf(format, args...)}
+}
+
+// AlreadyExistsf returns an error of kind ErrAlreadyExists with a formatted message.
+func AlreadyExistsf(format string, args ...any) error {
+	return &Error{kind: ErrAlreadyExists, message: fmt.Sprintf(format, args...)}
+}
+
+// FailedPreconditionf returns an error of kind ErrFailedPrecondition with a formatted message.
+func FailedPreconditionf(format string, args ...any) error {
+	return &Error{kind: ErrFailedPrecondition, message: fmt.Sprintf(format, args...)}
+}
+
+// toGRPCError converts a data source error to a gRPC status error, with a code that reflects the
+// kind of the error. Errors without a kind are returned unchanged.
+func toGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	for kind, code := range kindCodes {
+		if errors.Is(err, kind) {
+			return status.Error(code, err.Error())
+		}
+	}
+	return err
+}
+
+// fromGRPCError converts a gRPC status error to a data source error of the kind corresponding to
+// its code. Errors with other codes are returned unchanged.
+func fromGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for kind, code := range kindCodes {
+		if st.Code() == code {
+			return &Error{kind: kind, message: st.Message()}
+		}
+	}
+	return err
+}
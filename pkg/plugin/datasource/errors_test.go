@@ -0,0 +1,148 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError(t *testing.T) {
+	err := datasource.NotFoundf("failed to find trust zone %s", "tz1")
+	assert.EqualError(t, err, "failed to find trust zone tz1")
+	assert.ErrorIs(t, err, datasource.ErrNotFound)
+	assert.NotErrorIs(t, err, datasource.ErrAlreadyExists)
+
+	wrapped := errors.Join(errors.New("context"), datasource.FailedPreconditionf("in use"))
+	assert.ErrorIs(t, wrapped, datasource.ErrFailedPrecondition)
+}
+
+func TestDataSourcePluginClientGRPC_errors(t *testing.T) {
+	duplicate := fixtures.TrustZone("tz1")
+	duplicate.Id = nil
+
+	tests := []struct {
+		name     string
+		call     func(ds datasource.DataSourceV2) error
+		wantKind error
+		wantErr  string
+	}{
+		{
+			name: "not found",
+			call: func(ds datasource.DataSourceV2) error {
+				_, err := ds.GetTrustZone(context.Background(), "invalid-tz")
+				return err
+			},
+			wantKind: datasource.ErrNotFound,
+			wantErr:  "failed to find trust zone invalid-tz in local config",
+		},
+		{
+			name: "already exists",
+			call: func(ds datasource.DataSourceV2) error {
+				_, err := ds.AddTrustZone(context.Background(), duplicate)
+				return err
+			},
+			wantKind: datasource.ErrAlreadyExists,
+			wantErr:  "trust zone tz1 already exists in local config",
+		},
+		{
+			name: "failed precondition",
+			call: func(ds datasource.DataSourceV2) error {
+				return ds.DestroyTrustZone(context.Background(), "tz1-id")
+			},
+			wantKind: datasource.ErrFailedPrecondition,
+			wantErr:  "one or more clusters exist in trust zone tz1-id in local config",
+		},
+		{
+			name: "untyped",
+			call: func(ds datasource.DataSourceV2) error {
+				_, err := ds.AddTrustZone(context.Background(), fixtures.TrustZone("tz3"))
+				return err
+			},
+			wantErr: "trust zone tz3-id should not have an ID set, this will be auto generated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lds, _ := newLocalDataSource(t)
+			client := newGRPCClient(t, lds, true)
+
+			for name, ds := range map[string]datasource.DataSourceV2{
+				"in-process": datasource.FromV1(lds),
+				"grpc":       client.V2(),
+			} {
+				err := tt.call(ds)
+				require.Error(t, err, name)
+				if tt.wantKind != nil {
+					assert.ErrorIs(t, err, tt.wantKind, name)
+					assert.EqualError(t, err, tt.wantErr, name)
+				} else {
+					assert.ErrorContains(t, err, tt.wantErr, name)
+				}
+			}
+		})
+	}
+}
+
+func TestDataSourcePluginClientGRPCV2_context(t *testing.T) {
+	lds, _ := newLocalDataSource(t)
+	client := newGRPCClient(t, lds, true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.V2().ListTrustZones(ctx)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	_, err = datasource.FromV1(lds).ListTrustZones(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGRPCServer_ImplV2(t *testing.T) {
+	lds, _ := newLocalDataSource(t)
+	client := newGRPCClient(t, nil, true, datasource.FromV1(lds))
+
+	trustZones, err := client.ListTrustZones()
+	require.NoError(t, err)
+	assert.Len(t, trustZones, 2)
+
+	_, err = client.GetTrustZone("invalid-tz")
+	assert.ErrorIs(t, err, datasource.ErrNotFound)
+}
+
+func TestAdapters(t *testing.T) {
+	lds, _ := newLocalDataSource(t)
+
+	// Adapting in both directions returns the original data source.
+	v2 := datasource.FromV1(lds)
+	assert.Same(t, lds, datasource.ToV1(context.Background(), v2))
+	wrapped := &wrappedV2{DataSourceV2: v2}
+	assert.Same(t, wrapped, datasource.FromV1(datasource.ToV1(context.Background(), wrapped)))
+
+	trustZones, err := datasource.ToV1(context.Background(), wrapped).ListTrustZones()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tz1", "tz2"}, trustZoneNames(trustZones))
+}
+
+// wrappedV2 hides the concrete type of a DataSourceV2.
+type wrappedV2 struct {
+	datasource.DataSourceV2
+}
+
+func trustZoneNames(trustZones []*trust_zone_proto.TrustZone) []string {
+	names := []string{}
+	for _, tz := range trustZones {
+		names = append(names, tz.GetName())
+	}
+	return names
+}
@@ -4,6 +4,8 @@
 package datasource
 
 import (
+	"context"
+
 	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
 	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
 	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
@@ -46,3 +48,41 @@ type DataSource interface {
 	DestroyFederation(id string) error
 	ListFederations(filter *datasourcepb.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error)
 }
+
+// DataSourceV2 is the context-aware interface for data sources.
+// Each method takes a context that bounds the call, allowing deadlines and cancellation to reach
+// the data source. Errors should be classified using ErrNotFound, ErrAlreadyExists and
+// ErrFailedPrecondition where applicable.
+// Use FromV1 to adapt a DataSource to this interface, and ToV1 to adapt in the other direction.
+type DataSourceV2 interface {
+	validator.Validator
+
+	AddTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error)
+	DestroyTrustZone(ctx context.Context, id string) error
+	GetTrustZone(ctx context.Context, id string) (*trust_zone_proto.TrustZone, error)
+	GetTrustZoneByName(ctx context.Context, name string) (*trust_zone_proto.TrustZone, error)
+	ListTrustZones(ctx context.Context) ([]*trust_zone_proto.TrustZone, error)
+	UpdateTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error)
+
+	AddCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error)
+	DestroyCluster(ctx context.Context, id string) error
+	GetCluster(ctx context.Context, id string) (*clusterpb.Cluster, error)
+	GetClusterByName(ctx context.Context, name, trustZoneID string) (*clusterpb.Cluster, error)
+	ListClusters(ctx context.Context, filter *datasourcepb.ListClustersRequest_Filter) ([]*clusterpb.Cluster, error)
+	UpdateCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error)
+
+	AddAttestationPolicy(ctx context.Context, policy *attestation_policy_proto.AttestationPolicy) (*attestation_policy_proto.AttestationPolicy, error)
+	DestroyAttestationPolicy(ctx context.Context, id string) error
+	GetAttestationPolicy(ctx context.Context, id string) (*attestation_policy_proto.AttestationPolicy, error)
+	GetAttestationPolicyByName(ctx context.Context, name string) (*attestation_policy_proto.AttestationPolicy, error)
+	ListAttestationPolicies(ctx context.Context) ([]*attestation_policy_proto.AttestationPolicy, error)
+
+	AddAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error)
+	DestroyAPBinding(ctx context.Context, id string) error
+	ListAPBindings(ctx context.Context, filter *datasourcepb.ListAPBindingsRequest_Filter) ([]*ap_binding_proto.APBinding, error)
+	UpdateAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error)
+
+	AddFederation(ctx context.Context, federation *federation_proto.Federation) (*federation_proto.Federation, error)
+	DestroyFederation(ctx context.Context, id string) error
+	ListFederations(ctx context.Context, filter *datasourcepb.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error)
+}
@@ -5,6 +5,7 @@ package datasource
 
 import (
 	"context"
+	"time"
 
 	ap_binding_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/ap_binding/v1alpha1"
 	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
@@ -22,437 +23,706 @@ const DataSourcePluginName = "data_source"
 // DataSourcePlugin implements the plugin.Plugin interface to provide the GRPC
 // server or client back to the plugin machinery. The server side should
 // proved the Impl field with a concrete implementation of the DataSource
-// interface.
+// interface, or the ImplV2 field with an implementation of the DataSourceV2 interface.
 type DataSourcePlugin struct {
 	go_plugin.Plugin
-	Impl DataSource
+	Impl   DataSource
+	ImplV2 DataSourceV2
 }
 
 func (dsp *DataSourcePlugin) GRPCClient(ctx context.Context, broker *go_plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
-	return &DataSourcePluginClientGRPC{ctx: ctx, client: cofidectl_proto.NewDataSourcePluginServiceClient(c)}, nil
+	return NewDataSourcePluginClientGRPCFromConn(ctx, c), nil
 }
 
 func (dsp *DataSourcePlugin) GRPCServer(broker *go_plugin.GRPCBroker, s *grpc.Server) error {
-	cofidectl_proto.RegisterDataSourcePluginServiceServer(s, &GRPCServer{Impl: dsp.Impl})
+	RegisterDataSourcePluginServer(s, &GRPCServer{Impl: dsp.Impl, ImplV2: dsp.ImplV2})
 	return nil
 }
 
-// Type check to ensure DataSourcePluginClientGRPC implements DataSource.
+// Type check to ensure DataSourcePluginClientGRPC implements DataSource and Transactor.
 var _ DataSource = &DataSourcePluginClientGRPC{}
+var _ Transactor = &DataSourcePluginClientGRPC{}
 
 // DataSourcePluginClientGRPC is used by clients (main application) to translate the
 // DataSource interface of plugins to GRPC calls.
 type DataSourcePluginClientGRPC struct {
 	ctx    context.Context
 	client cofidectl_proto.DataSourcePluginServiceClient
+	// conn is used for calls to the transaction service. If nil, transactions are not supported.
+	conn grpc.ClientConnInterface
 }
 
 func NewDataSourcePluginClientGRPC(ctx context.Context, client cofidectl_proto.DataSourcePluginServiceClient) *DataSourcePluginClientGRPC {
 	return &DataSourcePluginClientGRPC{ctx: ctx, client: client}
 }
 
+// NewDataSourcePluginClientGRPCFromConn returns a DataSourcePluginClientGRPC for a gRPC connection,
+// with support for transactions.
+func NewDataSourcePluginClientGRPCFromConn(ctx context.Context, conn grpc.ClientConnInterface) *DataSourcePluginClientGRPC {
+	return &DataSourcePluginClientGRPC{ctx: ctx, client: cofidectl_proto.NewDataSourcePluginServiceClient(conn), conn: conn}
+}
+
 func (c *DataSourcePluginClientGRPC) Validate(ctx context.Context) error {
-	_, err := c.client.Validate(ctx, &cofidectl_proto.ValidateRequest{})
-	return err
+	return c.V2().Validate(ctx)
 }
 
 func (c *DataSourcePluginClientGRPC) AddTrustZone(trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
-	resp, err := c.client.AddTrustZone(c.ctx, &cofidectl_proto.AddTrustZoneRequest{TrustZone: trustZone})
+	return c.V2().AddTrustZone(c.ctx, trustZone)
+}
+
+func (c *DataSourcePluginClientGRPC) DestroyTrustZone(id string) error {
+	return c.V2().DestroyTrustZone(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) GetTrustZone(id string) (*trust_zone_proto.TrustZone, error) {
+	return c.V2().GetTrustZone(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) GetTrustZoneByName(name string) (*trust_zone_proto.TrustZone, error) {
+	return c.V2().GetTrustZoneByName(c.ctx, name)
+}
+
+func (c *DataSourcePluginClientGRPC) ListTrustZones() ([]*trust_zone_proto.TrustZone, error) {
+	return c.V2().ListTrustZones(c.ctx)
+}
+
+func (c *DataSourcePluginClientGRPC) UpdateTrustZone(trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	return c.V2().UpdateTrustZone(c.ctx, trustZone)
+}
+
+func (c *DataSourcePluginClientGRPC) AddCluster(cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	return c.V2().AddCluster(c.ctx, cluster)
+}
+
+func (c *DataSourcePluginClientGRPC) DestroyCluster(id string) error {
+	return c.V2().DestroyCluster(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) GetCluster(id string) (*clusterpb.Cluster, error) {
+	return c.V2().GetCluster(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) GetClusterByName(name, trustZoneID string) (*clusterpb.Cluster, error) {
+	return c.V2().GetClusterByName(c.ctx, name, trustZoneID)
+}
+
+func (c *DataSourcePluginClientGRPC) ListClusters(filter *cofidectl_proto.ListClustersRequest_Filter) ([]*clusterpb.Cluster, error) {
+	return c.V2().ListClusters(c.ctx, filter)
+}
+
+func (c *DataSourcePluginClientGRPC) UpdateCluster(cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	return c.V2().UpdateCluster(c.ctx, cluster)
+}
+
+func (c *DataSourcePluginClientGRPC) AddAttestationPolicy(policy *attestation_policy_proto.AttestationPolicy) (*attestation_policy_proto.AttestationPolicy, error) {
+	return c.V2().AddAttestationPolicy(c.ctx, policy)
+}
+
+func (c *DataSourcePluginClientGRPC) DestroyAttestationPolicy(id string) error {
+	return c.V2().DestroyAttestationPolicy(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) GetAttestationPolicy(id string) (*attestation_policy_proto.AttestationPolicy, error) {
+	return c.V2().GetAttestationPolicy(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) GetAttestationPolicyByName(name string) (*attestation_policy_proto.AttestationPolicy, error) {
+	return c.V2().GetAttestationPolicyByName(c.ctx, name)
+}
+
+func (c *DataSourcePluginClientGRPC) ListAttestationPolicies() ([]*attestation_policy_proto.AttestationPolicy, error) {
+	return c.V2().ListAttestationPolicies(c.ctx)
+}
+
+func (c *DataSourcePluginClientGRPC) AddAPBinding(binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	return c.V2().AddAPBinding(c.ctx, binding)
+}
+
+func (c *DataSourcePluginClientGRPC) DestroyAPBinding(id string) error {
+	return c.V2().DestroyAPBinding(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) ListAPBindings(filter *cofidectl_proto.ListAPBindingsRequest_Filter) ([]*ap_binding_proto.APBinding, error) {
+	return c.V2().ListAPBindings(c.ctx, filter)
+}
+
+func (c *DataSourcePluginClientGRPC) UpdateAPBinding(binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	return c.V2().UpdateAPBinding(c.ctx, binding)
+}
+
+func (c *DataSourcePluginClientGRPC) AddFederation(federation *federation_proto.Federation) (*federation_proto.Federation, error) {
+	return c.V2().AddFederation(c.ctx, federation)
+}
+
+func (c *DataSourcePluginClientGRPC) DestroyFederation(id string) error {
+	return c.V2().DestroyFederation(c.ctx, id)
+}
+
+func (c *DataSourcePluginClientGRPC) ListFederations(filter *cofidectl_proto.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error) {
+	return c.V2().ListFederations(c.ctx, filter)
+}
+
+// Type check to ensure DataSourcePluginClientGRPCV2 implements DataSourceV2 and TransactorV2.
+var _ DataSourceV2 = &DataSourcePluginClientGRPCV2{}
+var _ TransactorV2 = &DataSourcePluginClientGRPCV2{}
+
+// DataSourcePluginClientGRPCV2 is used by clients (main application) to translate the
+// DataSourceV2 interface of plugins to GRPC calls.
+// Each call uses the context passed to the method. Errors with status codes NotFound,
+// AlreadyExists and FailedPrecondition are converted to the corresponding kinds of Error.
+type DataSourcePluginClientGRPCV2 struct {
+	client cofidectl_proto.DataSourcePluginServiceClient
+	// conn is used for calls to the transaction service. If nil, transactions are not supported.
+	conn grpc.ClientConnInterface
+}
+
+// NewDataSourcePluginClientGRPCV2FromConn returns a DataSourcePluginClientGRPCV2 for a gRPC
+// connection, with support for transactions.
+func NewDataSourcePluginClientGRPCV2FromConn(conn grpc.ClientConnInterface) *DataSourcePluginClientGRPCV2 {
+	return &DataSourcePluginClientGRPCV2{client: cofidectl_proto.NewDataSourcePluginServiceClient(conn), conn: conn}
+}
+
+// V2 returns a context-aware client that uses the same connection.
+func (c *DataSourcePluginClientGRPC) V2() *DataSourcePluginClientGRPCV2 {
+	return &DataSourcePluginClientGRPCV2{client: c.client, conn: c.conn}
+}
+
+func (c *DataSourcePluginClientGRPCV2) Validate(ctx context.Context) error {
+	_, err := c.client.Validate(ctx, &cofidectl_proto.ValidateRequest{})
+	return fromGRPCError(err)
+}
+
+func (c *DataSourcePluginClientGRPCV2) AddTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	resp, err := c.client.AddTrustZone(ctx, &cofidectl_proto.AddTrustZoneRequest{TrustZone: trustZone})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.TrustZone, nil
 }
 
-func (c *DataSourcePluginClientGRPC) DestroyTrustZone(id string) error {
-	_, err := c.client.DestroyTrustZone(c.ctx, &cofidectl_proto.DestroyTrustZoneRequest{Id: &id})
-	return err
+func (c *DataSourcePluginClientGRPCV2) DestroyTrustZone(ctx context.Context, id string) error {
+	_, err := c.client.DestroyTrustZone(ctx, &cofidectl_proto.DestroyTrustZoneRequest{Id: &id})
+	return fromGRPCError(err)
 }
 
-func (c *DataSourcePluginClientGRPC) GetTrustZone(id string) (*trust_zone_proto.TrustZone, error) {
-	resp, err := c.client.GetTrustZone(c.ctx, &cofidectl_proto.GetTrustZoneRequest{Id: &id})
+func (c *DataSourcePluginClientGRPCV2) GetTrustZone(ctx context.Context, id string) (*trust_zone_proto.TrustZone, error) {
+	resp, err := c.client.GetTrustZone(ctx, &cofidectl_proto.GetTrustZoneRequest{Id: &id})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.TrustZone, nil
 }
 
-func (c *DataSourcePluginClientGRPC) GetTrustZoneByName(name string) (*trust_zone_proto.TrustZone, error) {
-	resp, err := c.client.GetTrustZoneByName(c.ctx, &cofidectl_proto.GetTrustZoneByNameRequest{Name: &name})
+func (c *DataSourcePluginClientGRPCV2) GetTrustZoneByName(ctx context.Context, name string) (*trust_zone_proto.TrustZone, error) {
+	resp, err := c.client.GetTrustZoneByName(ctx, &cofidectl_proto.GetTrustZoneByNameRequest{Name: &name})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.TrustZone, nil
 }
 
-func (c *DataSourcePluginClientGRPC) ListTrustZones() ([]*trust_zone_proto.TrustZone, error) {
-	resp, err := c.client.ListTrustZones(c.ctx, &cofidectl_proto.ListTrustZonesRequest{})
+func (c *DataSourcePluginClientGRPCV2) ListTrustZones(ctx context.Context) ([]*trust_zone_proto.TrustZone, error) {
+	resp, err := c.client.ListTrustZones(ctx, &cofidectl_proto.ListTrustZonesRequest{})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.TrustZones, nil
 }
 
-func (c *DataSourcePluginClientGRPC) UpdateTrustZone(trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
-	resp, err := c.client.UpdateTrustZone(c.ctx, &cofidectl_proto.UpdateTrustZoneRequest{TrustZone: trustZone})
+func (c *DataSourcePluginClientGRPCV2) UpdateTrustZone(ctx context.Context, trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
+	resp, err := c.client.UpdateTrustZone(ctx, &cofidectl_proto.UpdateTrustZoneRequest{TrustZone: trustZone})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.TrustZone, nil
 }
 
-func (c *DataSourcePluginClientGRPC) AddCluster(cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
-	resp, err := c.client.AddCluster(c.ctx, &cofidectl_proto.AddClusterRequest{Cluster: cluster})
+func (c *DataSourcePluginClientGRPCV2) AddCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	resp, err := c.client.AddCluster(ctx, &cofidectl_proto.AddClusterRequest{Cluster: cluster})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Cluster, nil
 }
 
-func (c *DataSourcePluginClientGRPC) DestroyCluster(id string) error {
-	_, err := c.client.DestroyCluster(c.ctx, &cofidectl_proto.DestroyClusterRequest{Id: &id})
-	return err
+func (c *DataSourcePluginClientGRPCV2) DestroyCluster(ctx context.Context, id string) error {
+	_, err := c.client.DestroyCluster(ctx, &cofidectl_proto.DestroyClusterRequest{Id: &id})
+	return fromGRPCError(err)
 }
 
-func (c *DataSourcePluginClientGRPC) GetCluster(id string) (*clusterpb.Cluster, error) {
-	resp, err := c.client.GetCluster(c.ctx, &cofidectl_proto.GetClusterRequest{Id: &id})
+func (c *DataSourcePluginClientGRPCV2) GetCluster(ctx context.Context, id string) (*clusterpb.Cluster, error) {
+	resp, err := c.client.GetCluster(ctx, &cofidectl_proto.GetClusterRequest{Id: &id})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Cluster, nil
 }
 
-func (c *DataSourcePluginClientGRPC) GetClusterByName(name, trustZoneID string) (*clusterpb.Cluster, error) {
-	resp, err := c.client.GetClusterByName(c.ctx, &cofidectl_proto.GetClusterByNameRequest{Name: &name, TrustZoneId: &trustZoneID})
+func (c *DataSourcePluginClientGRPCV2) GetClusterByName(ctx context.Context, name, trustZoneID string) (*clusterpb.Cluster, error) {
+	resp, err := c.client.GetClusterByName(ctx, &cofidectl_proto.GetClusterByNameRequest{Name: &name, TrustZoneId: &trustZoneID})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Cluster, nil
 }
 
-func (c *DataSourcePluginClientGRPC) ListClusters(filter *cofidectl_proto.ListClustersRequest_Filter) ([]*clusterpb.Cluster, error) {
-	resp, err := c.client.ListClusters(c.ctx, &cofidectl_proto.ListClustersRequest{Filter: filter})
+func (c *DataSourcePluginClientGRPCV2) ListClusters(ctx context.Context, filter *cofidectl_proto.ListClustersRequest_Filter) ([]*clusterpb.Cluster, error) {
+	resp, err := c.client.ListClusters(ctx, &cofidectl_proto.ListClustersRequest{Filter: filter})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Clusters, nil
 }
 
-func (c *DataSourcePluginClientGRPC) UpdateCluster(cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
-	resp, err := c.client.UpdateCluster(c.ctx, &cofidectl_proto.UpdateClusterRequest{Cluster: cluster})
+func (c *DataSourcePluginClientGRPCV2) UpdateCluster(ctx context.Context, cluster *clusterpb.Cluster) (*clusterpb.Cluster, error) {
+	resp, err := c.client.UpdateCluster(ctx, &cofidectl_proto.UpdateClusterRequest{Cluster: cluster})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Cluster, nil
 }
 
-func (c *DataSourcePluginClientGRPC) AddAttestationPolicy(policy *attestation_policy_proto.AttestationPolicy) (*attestation_policy_proto.AttestationPolicy, error) {
-	resp, err := c.client.AddAttestationPolicy(c.ctx, &cofidectl_proto.AddAttestationPolicyRequest{Policy: policy})
+func (c *DataSourcePluginClientGRPCV2) AddAttestationPolicy(ctx context.Context, policy *attestation_policy_proto.AttestationPolicy) (*attestation_policy_proto.AttestationPolicy, error) {
+	resp, err := c.client.AddAttestationPolicy(ctx, &cofidectl_proto.AddAttestationPolicyRequest{Policy: policy})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Policy, nil
 }
 
-func (c *DataSourcePluginClientGRPC) DestroyAttestationPolicy(id string) error {
-	_, err := c.client.DestroyAttestationPolicy(c.ctx, &cofidectl_proto.DestroyAttestationPolicyRequest{Id: &id})
-	return err
+func (c *DataSourcePluginClientGRPCV2) DestroyAttestationPolicy(ctx context.Context, id string) error {
+	_, err := c.client.DestroyAttestationPolicy(ctx, &cofidectl_proto.DestroyAttestationPolicyRequest{Id: &id})
+	return fromGRPCError(err)
 }
 
-func (c *DataSourcePluginClientGRPC) GetAttestationPolicy(id string) (*attestation_policy_proto.AttestationPolicy, error) {
-	resp, err := c.client.GetAttestationPolicy(c.ctx, &cofidectl_proto.GetAttestationPolicyRequest{Id: &id})
+func (c *DataSourcePluginClientGRPCV2) GetAttestationPolicy(ctx context.Context, id string) (*attestation_policy_proto.AttestationPolicy, error) {
+	resp, err := c.client.GetAttestationPolicy(ctx, &cofidectl_proto.GetAttestationPolicyRequest{Id: &id})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Policy, nil
 }
 
-func (c *DataSourcePluginClientGRPC) GetAttestationPolicyByName(name string) (*attestation_policy_proto.AttestationPolicy, error) {
-	resp, err := c.client.GetAttestationPolicyByName(c.ctx, &cofidectl_proto.GetAttestationPolicyByNameRequest{Name: &name})
+func (c *DataSourcePluginClientGRPCV2) GetAttestationPolicyByName(ctx context.Context, name string) (*attestation_policy_proto.AttestationPolicy, error) {
+	resp, err := c.client.GetAttestationPolicyByName(ctx, &cofidectl_proto.GetAttestationPolicyByNameRequest{Name: &name})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Policy, nil
 }
 
-func (c *DataSourcePluginClientGRPC) ListAttestationPolicies() ([]*attestation_policy_proto.AttestationPolicy, error) {
-	resp, err := c.client.ListAttestationPolicies(c.ctx, &cofidectl_proto.ListAttestationPoliciesRequest{})
+func (c *DataSourcePluginClientGRPCV2) ListAttestationPolicies(ctx context.Context) ([]*attestation_policy_proto.AttestationPolicy, error) {
+	resp, err := c.client.ListAttestationPolicies(ctx, &cofidectl_proto.ListAttestationPoliciesRequest{})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Policies, nil
 }
 
-func (c *DataSourcePluginClientGRPC) AddAPBinding(binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
-	resp, err := c.client.AddAPBinding(c.ctx, &cofidectl_proto.AddAPBindingRequest{Binding: binding})
+func (c *DataSourcePluginClientGRPCV2) AddAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	resp, err := c.client.AddAPBinding(ctx, &cofidectl_proto.AddAPBindingRequest{Binding: binding})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Binding, nil
 }
 
-func (c *DataSourcePluginClientGRPC) DestroyAPBinding(id string) error {
-	_, err := c.client.DestroyAPBinding(c.ctx, &cofidectl_proto.DestroyAPBindingRequest{Id: &id})
-	return err
+func (c *DataSourcePluginClientGRPCV2) DestroyAPBinding(ctx context.Context, id string) error {
+	_, err := c.client.DestroyAPBinding(ctx, &cofidectl_proto.DestroyAPBindingRequest{Id: &id})
+	return fromGRPCError(err)
 }
 
-func (c *DataSourcePluginClientGRPC) ListAPBindings(filter *cofidectl_proto.ListAPBindingsRequest_Filter) ([]*ap_binding_proto.APBinding, error) {
-	resp, err := c.client.ListAPBindings(c.ctx, &cofidectl_proto.ListAPBindingsRequest{Filter: filter})
+func (c *DataSourcePluginClientGRPCV2) ListAPBindings(ctx context.Context, filter *cofidectl_proto.ListAPBindingsRequest_Filter) ([]*ap_binding_proto.APBinding, error) {
+	resp, err := c.client.ListAPBindings(ctx, &cofidectl_proto.ListAPBindingsRequest{Filter: filter})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Bindings, nil
 }
 
-func (c *DataSourcePluginClientGRPC) UpdateAPBinding(binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
-	resp, err := c.client.UpdateAPBinding(c.ctx, &cofidectl_proto.UpdateAPBindingRequest{Binding: binding})
+func (c *DataSourcePluginClientGRPCV2) UpdateAPBinding(ctx context.Context, binding *ap_binding_proto.APBinding) (*ap_binding_proto.APBinding, error) {
+	resp, err := c.client.UpdateAPBinding(ctx, &cofidectl_proto.UpdateAPBindingRequest{Binding: binding})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Binding, nil
 }
 
-func (c *DataSourcePluginClientGRPC) AddFederation(federation *federation_proto.Federation) (*federation_proto.Federation, error) {
-	resp, err := c.client.AddFederation(c.ctx, &cofidectl_proto.AddFederationRequest{Federation: federation})
+func (c *DataSourcePluginClientGRPCV2) AddFederation(ctx context.Context, federation *federation_proto.Federation) (*federation_proto.Federation, error) {
+	resp, err := c.client.AddFederation(ctx, &cofidectl_proto.AddFederationRequest{Federation: federation})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Federation, nil
 }
 
-func (c *DataSourcePluginClientGRPC) DestroyFederation(id string) error {
-	_, err := c.client.DestroyFederation(c.ctx, &cofidectl_proto.DestroyFederationRequest{Id: &id})
-	return err
+func (c *DataSourcePluginClientGRPCV2) DestroyFederation(ctx context.Context, id string) error {
+	_, err := c.client.DestroyFederation(ctx, &cofidectl_proto.DestroyFederationRequest{Id: &id})
+	return fromGRPCError(err)
 }
 
-func (c *DataSourcePluginClientGRPC) ListFederations(filter *cofidectl_proto.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error) {
-	resp, err := c.client.ListFederations(c.ctx, &cofidectl_proto.ListFederationsRequest{Filter: filter})
+func (c *DataSourcePluginClientGRPCV2) ListFederations(ctx context.Context, filter *cofidectl_proto.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error) {
+	resp, err := c.client.ListFederations(ctx, &cofidectl_proto.ListFederationsRequest{Filter: filter})
 	if err != nil {
-		return nil, err
+		return nil, fromGRPCError(err)
 	}
 
 	return resp.Federations, nil
 }
 
+// GRPCServer is used by plugins to serve a data source implementation over GRPC.
+// Errors of kinds ErrNotFound, ErrAlreadyExists and ErrFailedPrecondition are returned as
+// statuses with the corresponding codes.
 type GRPCServer struct {
 	cofidectl_proto.UnimplementedDataSourcePluginServiceServer
 	Impl DataSource
+	// ImplV2 is a context-aware data source implementation. If set, it is used instead of Impl.
+	ImplV2 DataSourceV2
+	// TransactionTimeout is how long a transaction may be idle before it is aborted.
+	// If zero, DefaultTransactionTimeout is used.
+	TransactionTimeout time.Duration
+	txns               transactions
 }
 
 func (s *GRPCServer) Validate(ctx context.Context, req *cofidectl_proto.ValidateRequest) (*cofidectl_proto.ValidateResponse, error) {
-	err := s.Impl.Validate(ctx)
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	err = ds.Validate(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.ValidateResponse{}, nil
 }
 
-func (s *GRPCServer) AddTrustZone(_ context.Context, req *cofidectl_proto.AddTrustZoneRequest) (*cofidectl_proto.AddTrustZoneResponse, error) {
-	trustZone, err := s.Impl.AddTrustZone(req.TrustZone)
+func (s *GRPCServer) AddTrustZone(ctx context.Context, req *cofidectl_proto.AddTrustZoneRequest) (*cofidectl_proto.AddTrustZoneResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	trustZone, err := ds.AddTrustZone(ctx, req.TrustZone)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.AddTrustZoneResponse{TrustZone: trustZone}, nil
 }
 
-func (s *GRPCServer) DestroyTrustZone(_ context.Context, req *cofidectl_proto.DestroyTrustZoneRequest) (*cofidectl_proto.DestroyTrustZoneResponse, error) {
-	err := s.Impl.DestroyTrustZone(req.GetId())
+func (s *GRPCServer) DestroyTrustZone(ctx context.Context, req *cofidectl_proto.DestroyTrustZoneRequest) (*cofidectl_proto.DestroyTrustZoneResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	err = ds.DestroyTrustZone(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.DestroyTrustZoneResponse{}, nil
 }
 
-func (s *GRPCServer) GetTrustZone(_ context.Context, req *cofidectl_proto.GetTrustZoneRequest) (*cofidectl_proto.GetTrustZoneResponse, error) {
-	trustZone, err := s.Impl.GetTrustZone(req.GetId())
+func (s *GRPCServer) GetTrustZone(ctx context.Context, req *cofidectl_proto.GetTrustZoneRequest) (*cofidectl_proto.GetTrustZoneResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	trustZone, err := ds.GetTrustZone(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.GetTrustZoneResponse{TrustZone: trustZone}, nil
 }
 
-func (s *GRPCServer) GetTrustZoneByName(_ context.Context, req *cofidectl_proto.GetTrustZoneByNameRequest) (*cofidectl_proto.GetTrustZoneByNameResponse, error) {
-	trustZone, err := s.Impl.GetTrustZoneByName(req.GetName())
+func (s *GRPCServer) GetTrustZoneByName(ctx context.Context, req *cofidectl_proto.GetTrustZoneByNameRequest) (*cofidectl_proto.GetTrustZoneByNameResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	trustZone, err := ds.GetTrustZoneByName(ctx, req.GetName())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.GetTrustZoneByNameResponse{TrustZone: trustZone}, nil
 }
 
-func (s *GRPCServer) ListTrustZones(_ context.Context, req *cofidectl_proto.ListTrustZonesRequest) (*cofidectl_proto.ListTrustZonesResponse, error) {
-	trustZones, err := s.Impl.ListTrustZones()
+func (s *GRPCServer) ListTrustZones(ctx context.Context, req *cofidectl_proto.ListTrustZonesRequest) (*cofidectl_proto.ListTrustZonesResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	trustZones, err := ds.ListTrustZones(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.ListTrustZonesResponse{TrustZones: trustZones}, nil
 }
 
-func (s *GRPCServer) UpdateTrustZone(_ context.Context, req *cofidectl_proto.UpdateTrustZoneRequest) (*cofidectl_proto.UpdateTrustZoneResponse, error) {
-	trustZone, err := s.Impl.UpdateTrustZone(req.TrustZone)
+func (s *GRPCServer) UpdateTrustZone(ctx context.Context, req *cofidectl_proto.UpdateTrustZoneRequest) (*cofidectl_proto.UpdateTrustZoneResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	trustZone, err := ds.UpdateTrustZone(ctx, req.TrustZone)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.UpdateTrustZoneResponse{TrustZone: trustZone}, nil
 }
 
-func (s *GRPCServer) AddCluster(_ context.Context, req *cofidectl_proto.AddClusterRequest) (*cofidectl_proto.AddClusterResponse, error) {
-	cluster, err := s.Impl.AddCluster(req.Cluster)
+func (s *GRPCServer) AddCluster(ctx context.Context, req *cofidectl_proto.AddClusterRequest) (*cofidectl_proto.AddClusterResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	cluster, err := ds.AddCluster(ctx, req.Cluster)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.AddClusterResponse{Cluster: cluster}, nil
 }
 
-func (s *GRPCServer) DestroyCluster(_ context.Context, req *cofidectl_proto.DestroyClusterRequest) (*cofidectl_proto.DestroyClusterResponse, error) {
-	err := s.Impl.DestroyCluster(req.GetId())
+func (s *GRPCServer) DestroyCluster(ctx context.Context, req *cofidectl_proto.DestroyClusterRequest) (*cofidectl_proto.DestroyClusterResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	err = ds.DestroyCluster(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.DestroyClusterResponse{}, nil
 }
 
-func (s *GRPCServer) GetCluster(_ context.Context, req *cofidectl_proto.GetClusterRequest) (*cofidectl_proto.GetClusterResponse, error) {
-	cluster, err := s.Impl.GetCluster(req.GetId())
+func (s *GRPCServer) GetCluster(ctx context.Context, req *cofidectl_proto.GetClusterRequest) (*cofidectl_proto.GetClusterResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	cluster, err := ds.GetCluster(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.GetClusterResponse{Cluster: cluster}, nil
 }
 
-func (s *GRPCServer) GetClusterByName(_ context.Context, req *cofidectl_proto.GetClusterByNameRequest) (*cofidectl_proto.GetClusterByNameResponse, error) {
-	cluster, err := s.Impl.GetClusterByName(req.GetName(), req.GetTrustZoneId())
+func (s *GRPCServer) GetClusterByName(ctx context.Context, req *cofidectl_proto.GetClusterByNameRequest) (*cofidectl_proto.GetClusterByNameResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	cluster, err := ds.GetClusterByName(ctx, req.GetName(), req.GetTrustZoneId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.GetClusterByNameResponse{Cluster: cluster}, nil
 }
 
-func (s *GRPCServer) ListClusters(_ context.Context, req *cofidectl_proto.ListClustersRequest) (*cofidectl_proto.ListClustersResponse, error) {
-	clusters, err := s.Impl.ListClusters(req.GetFilter())
+func (s *GRPCServer) ListClusters(ctx context.Context, req *cofidectl_proto.ListClustersRequest) (*cofidectl_proto.ListClustersResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	clusters, err := ds.ListClusters(ctx, req.GetFilter())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.ListClustersResponse{Clusters: clusters}, nil
 }
 
-func (s *GRPCServer) UpdateCluster(_ context.Context, req *cofidectl_proto.UpdateClusterRequest) (*cofidectl_proto.UpdateClusterResponse, error) {
-	cluster, err := s.Impl.UpdateCluster(req.Cluster)
+func (s *GRPCServer) UpdateCluster(ctx context.Context, req *cofidectl_proto.UpdateClusterRequest) (*cofidectl_proto.UpdateClusterResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	cluster, err := ds.UpdateCluster(ctx, req.Cluster)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.UpdateClusterResponse{Cluster: cluster}, nil
 }
 
-func (s *GRPCServer) AddAttestationPolicy(_ context.Context, req *cofidectl_proto.AddAttestationPolicyRequest) (*cofidectl_proto.AddAttestationPolicyResponse, error) {
-	policy, err := s.Impl.AddAttestationPolicy(req.Policy)
+func (s *GRPCServer) AddAttestationPolicy(ctx context.Context, req *cofidectl_proto.AddAttestationPolicyRequest) (*cofidectl_proto.AddAttestationPolicyResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	policy, err := ds.AddAttestationPolicy(ctx, req.Policy)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.AddAttestationPolicyResponse{Policy: policy}, nil
 }
 
-func (s *GRPCServer) DestroyAttestationPolicy(_ context.Context, req *cofidectl_proto.DestroyAttestationPolicyRequest) (*cofidectl_proto.DestroyAttestationPolicyResponse, error) {
-	err := s.Impl.DestroyAttestationPolicy(req.GetId())
+func (s *GRPCServer) DestroyAttestationPolicy(ctx context.Context, req *cofidectl_proto.DestroyAttestationPolicyRequest) (*cofidectl_proto.DestroyAttestationPolicyResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	err = ds.DestroyAttestationPolicy(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.DestroyAttestationPolicyResponse{}, nil
 }
 
-func (s *GRPCServer) GetAttestationPolicy(_ context.Context, req *cofidectl_proto.GetAttestationPolicyRequest) (*cofidectl_proto.GetAttestationPolicyResponse, error) {
-	policy, err := s.Impl.GetAttestationPolicy(req.GetId())
+func (s *GRPCServer) GetAttestationPolicy(ctx context.Context, req *cofidectl_proto.GetAttestationPolicyRequest) (*cofidectl_proto.GetAttestationPolicyResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	policy, err := ds.GetAttestationPolicy(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.GetAttestationPolicyResponse{Policy: policy}, nil
 }
 
-func (s *GRPCServer) GetAttestationPolicyByName(_ context.Context, req *cofidectl_proto.GetAttestationPolicyByNameRequest) (*cofidectl_proto.GetAttestationPolicyByNameResponse, error) {
-	policy, err := s.Impl.GetAttestationPolicyByName(req.GetName())
+func (s *GRPCServer) GetAttestationPolicyByName(ctx context.Context, req *cofidectl_proto.GetAttestationPolicyByNameRequest) (*cofidectl_proto.GetAttestationPolicyByNameResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	policy, err := ds.GetAttestationPolicyByName(ctx, req.GetName())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.GetAttestationPolicyByNameResponse{Policy: policy}, nil
 }
 
-func (s *GRPCServer) ListAttestationPolicies(_ context.Context, req *cofidectl_proto.ListAttestationPoliciesRequest) (*cofidectl_proto.ListAttestationPoliciesResponse, error) {
-	policies, err := s.Impl.ListAttestationPolicies()
+func (s *GRPCServer) ListAttestationPolicies(ctx context.Context, req *cofidectl_proto.ListAttestationPoliciesRequest) (*cofidectl_proto.ListAttestationPoliciesResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	policies, err := ds.ListAttestationPolicies(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.ListAttestationPoliciesResponse{Policies: policies}, nil
 }
 
-func (s *GRPCServer) AddAPBinding(_ context.Context, req *cofidectl_proto.AddAPBindingRequest) (*cofidectl_proto.AddAPBindingResponse, error) {
-	binding, err := s.Impl.AddAPBinding(req.Binding)
+func (s *GRPCServer) AddAPBinding(ctx context.Context, req *cofidectl_proto.AddAPBindingRequest) (*cofidectl_proto.AddAPBindingResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	binding, err := ds.AddAPBinding(ctx, req.Binding)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.AddAPBindingResponse{Binding: binding}, nil
 }
 
-func (s *GRPCServer) DestroyAPBinding(_ context.Context, req *cofidectl_proto.DestroyAPBindingRequest) (*cofidectl_proto.DestroyAPBindingResponse, error) {
-	err := s.Impl.DestroyAPBinding(req.GetId())
+func (s *GRPCServer) DestroyAPBinding(ctx context.Context, req *cofidectl_proto.DestroyAPBindingRequest) (*cofidectl_proto.DestroyAPBindingResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	err = ds.DestroyAPBinding(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.DestroyAPBindingResponse{}, nil
 }
 
-func (s *GRPCServer) ListAPBindings(_ context.Context, req *cofidectl_proto.ListAPBindingsRequest) (*cofidectl_proto.ListAPBindingsResponse, error) {
-	bindings, err := s.Impl.ListAPBindings(req.Filter)
+func (s *GRPCServer) ListAPBindings(ctx context.Context, req *cofidectl_proto.ListAPBindingsRequest) (*cofidectl_proto.ListAPBindingsResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	bindings, err := ds.ListAPBindings(ctx, req.Filter)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.ListAPBindingsResponse{Bindings: bindings}, nil
 }
 
-func (s *GRPCServer) UpdateAPBinding(_ context.Context, req *cofidectl_proto.UpdateAPBindingRequest) (*cofidectl_proto.UpdateAPBindingResponse, error) {
-	binding, err := s.Impl.UpdateAPBinding(req.Binding)
+func (s *GRPCServer) UpdateAPBinding(ctx context.Context, req *cofidectl_proto.UpdateAPBindingRequest) (*cofidectl_proto.UpdateAPBindingResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	binding, err := ds.UpdateAPBinding(ctx, req.Binding)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.UpdateAPBindingResponse{Binding: binding}, nil
 }
 
-func (s *GRPCServer) AddFederation(_ context.Context, req *cofidectl_proto.AddFederationRequest) (*cofidectl_proto.AddFederationResponse, error) {
-	federation, err := s.Impl.AddFederation(req.Federation)
+func (s *GRPCServer) AddFederation(ctx context.Context, req *cofidectl_proto.AddFederationRequest) (*cofidectl_proto.AddFederationResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	federation, err := ds.AddFederation(ctx, req.Federation)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.AddFederationResponse{Federation: federation}, nil
 }
 
-func (s *GRPCServer) DestroyFederation(_ context.Context, req *cofidectl_proto.DestroyFederationRequest) (*cofidectl_proto.DestroyFederationResponse, error) {
-	err := s.Impl.DestroyFederation(req.GetId())
+func (s *GRPCServer) DestroyFederation(ctx context.Context, req *cofidectl_proto.DestroyFederationRequest) (*cofidectl_proto.DestroyFederationResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	err = ds.DestroyFederation(ctx, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.DestroyFederationResponse{}, nil
 }
 
-func (s *GRPCServer) ListFederations(_ context.Context, req *cofidectl_proto.ListFederationsRequest) (*cofidectl_proto.ListFederationsResponse, error) {
-	federations, err := s.Impl.ListFederations(req.GetFilter())
+func (s *GRPCServer) ListFederations(ctx context.Context, req *cofidectl_proto.ListFederationsRequest) (*cofidectl_proto.ListFederationsResponse, error) {
+	ds, release, err := s.dataSource(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	federations, err := ds.ListFederations(ctx, req.GetFilter())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &cofidectl_proto.ListFederationsResponse{Federations: federations}, nil
 }
@@ -0,0 +1,91 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+var (
+	// ErrTransactionsNotSupported is returned by Begin when a data source does not support
+	// transactions.
+	ErrTransactionsNotSupported = errors.New("data source does not support transactions")
+	// ErrTransactionClosed is returned when using a transaction that has been committed or aborted.
+	// It is of kind ErrFailedPrecondition.
+	ErrTransactionClosed = FailedPreconditionf("transaction has already been committed or aborted")
+)
+
+// Transaction is a batch of data source mutations that are applied atomically on Commit, or
+// discarded on Abort.
+// Reads made through the Transaction observe its own uncommitted mutations.
+type Transaction interface {
+	DataSource
+
+	// Commit atomically applies all mutations made in the transaction.
+	Commit(ctx context.Context) error
+	// Abort discards all mutations made in the transaction.
+	// Aborting a committed or aborted transaction has no effect.
+	Abort(ctx context.Context) error
+}
+
+// Transactor is an optional interface that data sources may implement to support transactions.
+type Transactor interface {
+	// Begin starts a new transaction. It returns ErrTransactionsNotSupported if the data source
+	// does not support transactions.
+	Begin(ctx context.Context) (Transaction, error)
+}
+
+// TransactionV2 is the context-aware equivalent of Transaction.
+type TransactionV2 interface {
+	DataSourceV2
+	// Commit atomically applies all mutations made in the transaction.
+	Commit(ctx context.Context) error
+	// Abort discards all mutations made in the transaction.
+	// Aborting a committed or aborted transaction has no effect.
+	Abort(ctx context.Context) error
+}
+
+// TransactorV2 is the context-aware equivalent of Transactor.
+type TransactorV2 interface {
+	// Begin starts a new transaction. It returns ErrTransactionsNotSupported if the data source
+	// does not support transactions.
+	Begin(ctx context.Context) (TransactionV2, error)
+}
+
+// WithTransaction calls fn with a transaction on ds, committing it if fn returns nil or aborting
+// it otherwise.
+// If ds does not support transactions, fn is called with ds directly, and mutations made before
+// an error are not rolled back.
+func WithTransaction(ctx context.Context, ds DataSourceV2, fn func(ds DataSourceV2) error) error {
+	tx, err := begin(ctx, ds)
+	if errors.Is(err, ErrTransactionsNotSupported) {
+		slog.Debug("Data source does not support transactions, applying changes individually")
+		return fn(ds)
+	} else if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if abortErr := tx.Abort(ctx); abortErr != nil {
+			slog.Error("Failed to abort transaction", "error", abortErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func begin(ctx context.Context, ds DataSourceV2) (TransactionV2, error) {
+	transactor, ok := ds.(TransactorV2)
+	if !ok {
+		return nil, ErrTransactionsNotSupported
+	}
+	return transactor.Begin(ctx)
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	cofidectl_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	dspb "github.com/cofide/cofidectl/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TransactionIDMetadataKey is the gRPC metadata key that associates a DataSourcePluginService call
+// with a transaction begun using the DataSourceTransactionService.
+const TransactionIDMetadataKey = "cofidectl-transaction-id"
+
+// DefaultTransactionTimeout is how long a GRPCServer keeps an idle transaction open before
+// aborting it, when GRPCServer.TransactionTimeout is not set.
+const DefaultTransactionTimeout = 5 * time.Minute
+
+// RegisterDataSourcePluginServer registers the data source plugin service, transaction service
+// and capabilities service with a gRPC server.
+func RegisterDataSourcePluginServer(s grpc.ServiceRegistrar, srv *GRPCServer) {
+	cofidectl_proto.RegisterDataSourcePluginServiceServer(s, srv)
+	dspb.RegisterDataSourceTransactionServiceServer(s, srv)
+	dspb.RegisterDataSourceCapabilitiesServiceServer(s, srv)
+}
+
+// Begin implements Transactor.
+// It returns ErrTransactionsNotSupported if the plugin does not support transactions.
+func (c *DataSourcePluginClientGRPC) Begin(ctx context.Context) (Transaction, error) {
+	tx, err := c.V2().Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toV1Transaction(ctx, tx), nil
+}
+
+// Begin implements TransactorV2.
+// It returns ErrTransactionsNotSupported if the plugin does not support transactions.
+func (c *DataSourcePluginClientGRPCV2) Begin(ctx context.Context) (TransactionV2, error) {
+	if c.conn == nil {
+		return nil, ErrTransactionsNotSupported
+	}
+
+	client := dspb.NewDataSourceTransactionServiceClient(c.conn)
+	resp, err := client.Begin(ctx, &dspb.BeginRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, ErrTransactionsNotSupported
+	} else if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	txConn := &transactionConn{ClientConnInterface: c.conn, id: resp.GetTransactionId()}
+	return &transactionClientGRPC{
+		DataSourcePluginClientGRPCV2: &DataSourcePluginClientGRPCV2{client: cofidectl_proto.NewDataSourcePluginServiceClient(txConn)},
+		client:                       client,
+		id:                           resp.GetTransactionId(),
+	}, nil
+}
+
+// transactionConn is a gRPC connection that performs each call within a transaction, by sending
+// the transaction ID as metadata.
+type transactionConn struct {
+	grpc.ClientConnInterface
+	id string
+}
+
+func (c *transactionConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, TransactionIDMetadataKey, c.id)
+	return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+}
+
+// transactionClientGRPC is a TransactionV2 that performs DataSourceV2 calls over gRPC within a
+// transaction.
+type transactionClientGRPC struct {
+	*DataSourcePluginClientGRPCV2
+	client dspb.DataSourceTransactionServiceClient
+	id     string
+}
+
+// Begin returns an error, since nested transactions are not supported.
+func (t *transactionClientGRPC) Begin(_ context.Context) (TransactionV2, error) {
+	return nil, errors.New("nested transactions are not supported")
+}
+
+func (t *transactionClientGRPC) Commit(ctx context.Context) error {
+	_, err := t.client.Commit(ctx, &dspb.CommitRequest{TransactionId: &t.id})
+	return fromGRPCError(err)
+}
+
+func (t *transactionClientGRPC) Abort(ctx context.Context) error {
+	_, err := t.client.Abort(ctx, &dspb.AbortRequest{TransactionId: &t.id})
+	return fromGRPCError(err)
+}
+
+var _ dspb.DataSourceTransactionServiceServer = &GRPCServer{}
+
+// transactions tracks the open transactions of a GRPCServer.
+type transactions struct {
+	mu   sync.Mutex
+	open map[string]*openTransaction
+}
+
+// openTransaction is a transaction begun by a client. Its timer aborts the transaction if it is
+// idle for the server's transaction timeout, so that transactions abandoned by a client that
+// disconnects without committing or aborting are not kept open indefinitely.
+type openTransaction struct {
+	tx    TransactionV2
+	timer *time.Timer
+	// inUse is the number of calls using the transaction. The timer is stopped while it is
+	// non-zero.
+	inUse int
+}
+
+func (s *GRPCServer) Begin(ctx context.Context, _ *dspb.BeginRequest) (*dspb.BeginResponse, error) {
+	transactor, ok := s.implV2().(TransactorV2)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, ErrTransactionsNotSupported.Error())
+	}
+	tx, err := transactor.Begin(ctx)
+	if errors.Is(err, ErrTransactionsNotSupported) {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	} else if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	id := uuid.NewString()
+	s.txns.mu.Lock()
+	defer s.txns.mu.Unlock()
+	if s.txns.open == nil {
+		s.txns.open = map[string]*openTransaction{}
+	}
+	open := &openTransaction{tx: tx}
+	open.timer = time.AfterFunc(s.transactionTimeout(), func() { s.expireTransaction(id, open) })
+	s.txns.open[id] = open
+	return &dspb.BeginResponse{TransactionId: &id}, nil
+}
+
+func (s *GRPCServer) Commit(ctx context.Context, req *dspb.CommitRequest) (*dspb.CommitResponse, error) {
+	tx, err := s.closeTransaction(req.GetTransactionId())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &dspb.CommitResponse{}, nil
+}
+
+func (s *GRPCServer) Abort(ctx context.Context, req *dspb.AbortRequest) (*dspb.AbortResponse, error) {
+	tx, err := s.closeTransaction(req.GetTransactionId())
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Abort(ctx); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &dspb.AbortResponse{}, nil
+}
+
+// closeTransaction removes an open transaction, returning it.
+func (s *GRPCServer) closeTransaction(id string) (TransactionV2, error) {
+	s.txns.mu.Lock()
+	defer s.txns.mu.Unlock()
+	open, ok := s.txns.open[id]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "unknown transaction %s", id)
+	}
+	delete(s.txns.open, id)
+	open.timer.Stop()
+	return open.tx, nil
+}
+
+// expireTransaction aborts an open transaction that has been idle for the transaction timeout.
+// It does nothing if the transaction has since been closed or is in use.
+func (s *GRPCServer) expireTransaction(id string, open *openTransaction) {
+	s.txns.mu.Lock()
+	if s.txns.open[id] != open || open.inUse > 0 {
+		s.txns.mu.Unlock()
+		return
+	}
+	delete(s.txns.open, id)
+	s.txns.mu.Unlock()
+
+	slog.Warn("Aborting expired transaction", "transaction_id", id)
+	if err := open.tx.Abort(context.Background()); err != nil {
+		slog.Error("Failed to abort expired transaction", "transaction_id", id, "error", err)
+	}
+}
+
+// transactionTimeout returns how long an open transaction may be idle before it is aborted.
+func (s *GRPCServer) transactionTimeout() time.Duration {
+	if s.TransactionTimeout > 0 {
+		return s.TransactionTimeout
+	}
+	return DefaultTransactionTimeout
+}
+
+// implV2 returns the server's data source implementation as a DataSourceV2.
+func (s *GRPCServer) implV2() DataSourceV2 {
+	if s.ImplV2 != nil {
+		return s.ImplV2
+	}
+	return FromV1(s.Impl)
+}
+
+// dataSource returns the DataSourceV2 to use for a call: the transaction identified by the call's
+// metadata if present, or the server's implementation otherwise.
+// The returned release function must be called when the call has finished using the data source.
+func (s *GRPCServer) dataSource(ctx context.Context) (DataSourceV2, func(), error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(TransactionIDMetadataKey)
+	if len(ids) == 0 {
+		return s.implV2(), func() {}, nil
+	}
+
+	s.txns.mu.Lock()
+	defer s.txns.mu.Unlock()
+	open, ok := s.txns.open[ids[0]]
+	if !ok {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "unknown transaction %s", ids[0])
+	}
+	open.timer.Stop()
+	open.inUse++
+	release := func() {
+		s.txns.mu.Lock()
+		defer s.txns.mu.Unlock()
+		open.inUse--
+		if open.inUse == 0 {
+			open.timer.Reset(s.transactionTimeout())
+		}
+	}
+	return open.tx, release, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package datasource_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	cofidectl_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newLocalDataSource(t *testing.T) (*local.LocalDataSource, config.Loader) {
+	cfg := &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+			fixtures.TrustZone("tz2"),
+		},
+		Clusters: []*clusterpb.Cluster{
+			fixtures.Cluster("local1"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+	loader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	lds, err := local.NewLocalDataSource(loader)
+	require.NoError(t, err)
+	return lds, loader
+}
+
+// newGRPCClient serves ds, or dsV2 if provided, over an in-memory gRPC connection, returning a
+// client. If registerTransactions is false, only the DataSourcePluginService is registered, as
+// for a plugin that predates transactions.
+func newGRPCClient(t *testing.T, ds datasource.DataSource, registerTransactions bool, dsV2 ...datasource.DataSourceV2) *datasource.DataSourcePluginClientGRPC {
+	dsServer := &datasource.GRPCServer{Impl: ds}
+	if len(dsV2) > 0 {
+		dsServer.ImplV2 = dsV2[0]
+	}
+	return serveGRPC(t, dsServer, registerTransactions)
+}
+
+// serveGRPC serves dsServer over an in-memory gRPC connection, returning a client.
+func serveGRPC(t *testing.T, dsServer *datasource.GRPCServer, registerTransactions bool) *datasource.DataSourcePluginClientGRPC {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	if registerTransactions {
+		datasource.RegisterDataSourcePluginServer(server, dsServer)
+	} else {
+		cofidectl_proto.RegisterDataSourcePluginServiceServer(server, dsServer)
+	}
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return datasource.NewDataSourcePluginClientGRPCFromConn(context.Background(), conn)
+}
+
+func TestWithTransaction(t *testing.T) {
+	tests := []struct {
+		name    string
+		grpc    bool
+		fnErr   error
+		wantTZs int
+	}{
+		{name: "local commit", wantTZs: 1},
+		{name: "local abort", fnErr: errors.New("fake error"), wantTZs: 2},
+		{name: "grpc commit", grpc: true, wantTZs: 1},
+		{name: "grpc abort", grpc: true, fnErr: errors.New("fake error"), wantTZs: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lds, loader := newLocalDataSource(t)
+			ctx := context.Background()
+			ds := datasource.FromV1(lds)
+			if tt.grpc {
+				ds = newGRPCClient(t, lds, true).V2()
+			}
+
+			err := datasource.WithTransaction(ctx, ds, func(tx datasource.DataSourceV2) error {
+				if err := tx.DestroyCluster(ctx, "local1-id"); err != nil {
+					return err
+				}
+				// The transaction observes its own mutations.
+				if err := tx.DestroyTrustZone(ctx, "tz1-id"); err != nil {
+					return err
+				}
+				return tt.fnErr
+			})
+			if tt.fnErr != nil {
+				assert.ErrorIs(t, err, tt.fnErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			trustZones, err := ds.ListTrustZones(ctx)
+			require.NoError(t, err)
+			assert.Len(t, trustZones, tt.wantTZs)
+			gotConfig, err := loader.Read()
+			require.NoError(t, err)
+			assert.Len(t, gotConfig.TrustZones, tt.wantTZs)
+		})
+	}
+}
+
+func TestWithTransaction_NotSupported(t *testing.T) {
+	ctx := context.Background()
+	lds, _ := newLocalDataSource(t)
+	client := newGRPCClient(t, lds, false).V2()
+
+	_, err := client.Begin(ctx)
+	require.ErrorIs(t, err, datasource.ErrTransactionsNotSupported)
+
+	// Mutations are applied individually, so are not rolled back on error.
+	fakeErr := errors.New("fake error")
+	err = datasource.WithTransaction(ctx, client, func(ds datasource.DataSourceV2) error {
+		if err := ds.DestroyCluster(ctx, "local1-id"); err != nil {
+			return err
+		}
+		return fakeErr
+	})
+	require.ErrorIs(t, err, fakeErr)
+	clusters, err := client.ListClusters(ctx, &cofidectl_proto.ListClustersRequest_Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, clusters)
+}
+
+func TestTransaction_V1Client(t *testing.T) {
+	ctx := context.Background()
+	lds, loader := newLocalDataSource(t)
+	client := newGRPCClient(t, lds, true)
+
+	tx, err := client.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.DestroyCluster("local1-id"))
+	require.NoError(t, tx.DestroyTrustZone("tz1-id"))
+	require.NoError(t, tx.Commit(ctx))
+
+	gotConfig, err := loader.Read()
+	require.NoError(t, err)
+	assert.Len(t, gotConfig.TrustZones, 1)
+}
+
+func TestTransaction_GRPCErrors(t *testing.T) {
+	ctx := context.Background()
+	lds, loader := newLocalDataSource(t)
+	client := newGRPCClient(t, lds, true).V2()
+
+	tx1, err := client.Begin(ctx)
+	require.NoError(t, err)
+	tx2, err := client.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx1.DestroyCluster(ctx, "local1-id"))
+	require.NoError(t, tx2.DestroyTrustZone(ctx, "tz2-id"))
+	require.NoError(t, tx1.Commit(ctx))
+
+	// The error kind is preserved over gRPC.
+	err = tx2.Commit(ctx)
+	assert.ErrorIs(t, err, datasource.ErrFailedPrecondition)
+	assert.ErrorContains(t, err, local.ErrTransactionConflict.Error())
+
+	err = tx1.Commit(ctx)
+	assert.ErrorIs(t, err, datasource.ErrFailedPrecondition)
+	assert.ErrorContains(t, err, "unknown transaction")
+
+	gotConfig, err := loader.Read()
+	require.NoError(t, err)
+	assert.Len(t, gotConfig.TrustZones, 2)
+	assert.Empty(t, gotConfig.Clusters)
+}
+
+func TestTransaction_Expired(t *testing.T) {
+	ctx := context.Background()
+	lds, loader := newLocalDataSource(t)
+	client := serveGRPC(t, &datasource.GRPCServer{Impl: lds, TransactionTimeout: 10 * time.Millisecond}, true).V2()
+
+	tx, err := client.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.DestroyCluster(ctx, "local1-id"))
+
+	// The idle transaction is aborted, as if the client had disconnected.
+	time.Sleep(100 * time.Millisecond)
+	_, err = tx.ListClusters(ctx, &cofidectl_proto.ListClustersRequest_Filter{})
+	assert.ErrorIs(t, err, datasource.ErrFailedPrecondition)
+	err = tx.Commit(ctx)
+	assert.ErrorIs(t, err, datasource.ErrFailedPrecondition)
+
+	gotConfig, err := loader.Read()
+	require.NoError(t, err)
+	assert.Len(t, gotConfig.Clusters, 1)
+
+	// A new transaction can still be committed.
+	err = datasource.WithTransaction(ctx, client, func(tx datasource.DataSourceV2) error {
+		return tx.DestroyCluster(ctx, "local1-id")
+	})
+	require.NoError(t, err)
+	gotConfig, err = loader.Read()
+	require.NoError(t, err)
+	assert.Empty(t, gotConfig.Clusters)
+}
@@ -0,0 +1,376 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxSignatureSize is the maximum size of a signature file read from a plugin tarball.
+const maxSignatureSize = 4096
+
+// maxManifestSize is the maximum size of a CLI plugin manifest read from a plugin tarball.
+const maxManifestSize = 65536
+
+// Plugin statuses reported by ListPlugins.
+const (
+	// PluginStatusVerified indicates that the plugin binary matches its lockfile entry.
+	PluginStatusVerified = "verified"
+	// PluginStatusModified indicates that the plugin binary does not match its lockfile entry.
+	PluginStatusModified = "modified"
+	// PluginStatusUnlocked indicates that the plugin binary has no lockfile entry.
+	PluginStatusUnlocked = "unlocked"
+	// PluginStatusMissing indicates that a lockfile entry has no plugin binary.
+	PluginStatusMissing = "missing"
+)
+
+// InstalledPlugin describes a plugin in a plugin directory.
+type InstalledPlugin struct {
+	Name string
+	Path string
+	// Locked is the lockfile entry for the plugin, or nil if it has none.
+	Locked *LockedPlugin
+	// Status is one of the PluginStatus* constants.
+	Status string
+}
+
+// InstallPlugin installs a plugin binary into pluginDir and records its checksum in the
+// lockfile. The source may be a plugin binary or a tarball (.tar, .tar.gz or .tgz) containing
+// one. If name is empty, the base name of the binary is used. A detached signature and CLI plugin
+// manifest next to the binary, or in the tarball, are installed with it.
+func InstallPlugin(pluginDir, source, name string) (*InstalledPlugin, error) {
+	if err := os.MkdirAll(pluginDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create plugin directory: %w", err)
+	}
+
+	var installed *InstalledPlugin
+	var err error
+	if isTarball(source) {
+		installed, err = installFromTarball(pluginDir, source, name)
+	} else {
+		installed, err = installFromFile(pluginDir, source, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lockFile, err := ReadLockFile(pluginDir)
+	if err != nil {
+		return nil, err
+	}
+	lockFile.Plugins[installed.Name] = installed.Locked
+	if err := lockFile.Write(pluginDir); err != nil {
+		return nil, err
+	}
+	return installed, nil
+}
+
+func installFromFile(pluginDir, source, name string) (*InstalledPlugin, error) {
+	if name == "" {
+		name = filepath.Base(source)
+	}
+	if err := validatePluginName(name); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin: %w", err)
+	}
+	defer f.Close()
+
+	installed, err := writePlugin(pluginDir, name, source, f)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := os.ReadFile(source + SignatureExtension)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read plugin signature: %w", err)
+	}
+	if err := writeSignature(pluginDir, name, signature); err != nil {
+		return nil, err
+	}
+
+	manifest, err := os.ReadFile(source + ManifestExtension)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read plugin manifest: %w", err)
+	}
+	if err := writeManifest(pluginDir, name, manifest); err != nil {
+		return nil, err
+	}
+	return installed, nil
+}
+
+func installFromTarball(pluginDir, source, name string) (*InstalledPlugin, error) {
+	f, err := os.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin tarball: %w", err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if !strings.HasSuffix(source, ".tar") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read plugin tarball: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	// Install the regular file with the requested name, its signature and manifest, or the only
+	// executable if no name was requested.
+	tr := tar.NewReader(r)
+	var candidates []string
+	var installed *InstalledPlugin
+	var signature, manifest []byte
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read plugin tarball: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		base := filepath.Base(header.Name)
+		switch {
+		case name == "":
+			if header.FileInfo().Mode()&0111 != 0 && validatePluginName(base) == nil {
+				candidates = append(candidates, base)
+			}
+		case base == name:
+			if err := validatePluginName(name); err != nil {
+				return nil, err
+			}
+			if installed, err = writePlugin(pluginDir, name, source, tr); err != nil {
+				return nil, err
+			}
+		case base == name+SignatureExtension:
+			if signature, err = io.ReadAll(io.LimitReader(tr, maxSignatureSize)); err != nil {
+				return nil, fmt.Errorf("failed to read plugin tarball: %w", err)
+			}
+		case base == name+ManifestExtension:
+			if manifest, err = io.ReadAll(io.LimitReader(tr, maxManifestSize)); err != nil {
+				return nil, fmt.Errorf("failed to read plugin tarball: %w", err)
+			}
+		}
+	}
+
+	if installed != nil {
+		if err := writeSignature(pluginDir, name, signature); err != nil {
+			return nil, err
+		}
+		if err := writeManifest(pluginDir, name, manifest); err != nil {
+			return nil, err
+		}
+		return installed, nil
+	}
+	if name != "" {
+		return nil, fmt.Errorf("plugin tarball %s does not contain a file named %s", source, name)
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("plugin tarball %s does not contain an executable file", source)
+	case 1:
+		// Read the tarball again to install the only executable.
+		return installFromTarball(pluginDir, source, candidates[0])
+	default:
+		return nil, fmt.Errorf("plugin tarball %s contains multiple executable files, specify one of: %s", source, strings.Join(candidates, ", "))
+	}
+}
+
+// writeSignature writes the detached signature of the named plugin to pluginDir. If signature is
+// nil, any existing signature is removed, since it cannot match the newly installed plugin.
+func writeSignature(pluginDir, name string, signature []byte) error {
+	path := filepath.Join(pluginDir, name+SignatureExtension)
+	if signature == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove plugin signature: %w", err)
+		}
+		return nil
+	}
+	if err := writeFileAtomic(path, signature, 0644); err != nil {
+		return fmt.Errorf("failed to write plugin signature: %w", err)
+	}
+	return nil
+}
+
+// writeManifest writes the CLI plugin manifest of the named plugin to pluginDir. If manifest is
+// nil, any existing manifest is removed.
+func writeManifest(pluginDir, name string, manifest []byte) error {
+	path := filepath.Join(pluginDir, name+ManifestExtension)
+	if manifest == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove plugin manifest: %w", err)
+		}
+		return nil
+	}
+	if err := writeFileAtomic(path, manifest, 0644); err != nil {
+		return fmt.Errorf("failed to write plugin manifest: %w", err)
+	}
+	return nil
+}
+
+// writePlugin writes the plugin binary from r to pluginDir, returning its lockfile entry.
+func writePlugin(pluginDir, name, source string, r io.Reader) (*InstalledPlugin, error) {
+	path := filepath.Join(pluginDir, name)
+	f, err := os.CreateTemp(pluginDir, "."+name+".tmp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create plugin: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), r); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write plugin: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write plugin: %w", err)
+	}
+	if err := os.Chmod(f.Name(), 0755); err != nil {
+		return nil, fmt.Errorf("failed to write plugin: %w", err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return nil, fmt.Errorf("failed to write plugin: %w", err)
+	}
+
+	if absSource, err := filepath.Abs(source); err == nil {
+		source = absSource
+	}
+	return &InstalledPlugin{
+		Name: name,
+		Path: path,
+		Locked: &LockedPlugin{
+			SHA256:      hex.EncodeToString(h.Sum(nil)),
+			Source:      source,
+			InstalledAt: time.Now().UTC(),
+		},
+		Status: PluginStatusVerified,
+	}, nil
+}
+
+// RemovePlugin removes the named plugin binary from pluginDir, along with its signature, manifest
+// and lockfile entry.
+func RemovePlugin(pluginDir, name string) error {
+	if err := validatePluginName(name); err != nil {
+		return err
+	}
+
+	lockFile, err := ReadLockFile(pluginDir)
+	if err != nil {
+		return err
+	}
+	_, locked := lockFile.Plugins[name]
+
+	err = os.Remove(filepath.Join(pluginDir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		if !locked {
+			return fmt.Errorf("plugin %s is not installed", name)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to remove plugin: %w", err)
+	}
+
+	if err := writeSignature(pluginDir, name, nil); err != nil {
+		return err
+	}
+	if err := writeManifest(pluginDir, name, nil); err != nil {
+		return err
+	}
+
+	if locked {
+		delete(lockFile.Plugins, name)
+		return lockFile.Write(pluginDir)
+	}
+	return nil
+}
+
+// ListPlugins returns the plugins in pluginDir and in its lockfile, sorted by name.
+// A missing plugin directory is treated as empty.
+func ListPlugins(pluginDir string) ([]*InstalledPlugin, error) {
+	lockFile, err := ReadLockFile(pluginDir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(pluginDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read plugin directory: %w", err)
+	}
+
+	plugins := []*InstalledPlugin{}
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || validatePluginName(name) != nil {
+			continue
+		}
+		seen[name] = true
+
+		installed := &InstalledPlugin{
+			Name:   name,
+			Path:   filepath.Join(pluginDir, name),
+			Locked: lockFile.Plugins[name],
+			Status: PluginStatusUnlocked,
+		}
+		if installed.Locked != nil {
+			checksum, err := fileSHA256(installed.Path)
+			if err != nil {
+				return nil, err
+			}
+			installed.Status = PluginStatusModified
+			if checksum == installed.Locked.SHA256 {
+				installed.Status = PluginStatusVerified
+			}
+		}
+		plugins = append(plugins, installed)
+	}
+
+	for name, locked := range lockFile.Plugins {
+		if !seen[name] {
+			plugins = append(plugins, &InstalledPlugin{
+				Name:   name,
+				Path:   filepath.Join(pluginDir, name),
+				Locked: locked,
+				Status: PluginStatusMissing,
+			})
+		}
+	}
+
+	slices.SortFunc(plugins, func(a, b *InstalledPlugin) int { return strings.Compare(a.Name, b.Name) })
+	return plugins, nil
+}
+
+// validatePluginName returns an error if name cannot be used as a plugin binary name.
+func validatePluginName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid plugin name %q", name)
+	}
+	if name == LockFileName || strings.HasPrefix(name, ".") || strings.HasSuffix(name, SignatureExtension) || strings.HasSuffix(name, ManifestExtension) {
+		return fmt.Errorf("invalid plugin name %q", name)
+	}
+	return nil
+}
+
+func isTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar") || strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestPlugin(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0755))
+	return path
+}
+
+func writeTestTarball(t *testing.T, path string, files map[string]string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		mode := int64(0644)
+		if filepath.Ext(name) == "" {
+			mode = 0755
+		}
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: mode, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+}
+
+func checksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestInstallPlugin_binary(t *testing.T) {
+	pluginDir := filepath.Join(t.TempDir(), "plugins")
+	source := writeTestPlugin(t, t.TempDir(), "cofidectl-test", "binary")
+
+	installed, err := InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+	assert.Equal(t, "cofidectl-test", installed.Name)
+	assert.Equal(t, filepath.Join(pluginDir, "cofidectl-test"), installed.Path)
+	assert.Equal(t, checksum("binary"), installed.Locked.SHA256)
+	assert.Equal(t, source, installed.Locked.Source)
+	assert.Equal(t, PluginStatusVerified, installed.Status)
+
+	info, err := os.Stat(installed.Path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	lockFile, err := ReadLockFile(pluginDir)
+	require.NoError(t, err)
+	assert.Equal(t, installed.Locked.SHA256, lockFile.Plugins["cofidectl-test"].SHA256)
+	assert.NoError(t, VerifyPlugin(pluginDir, "cofidectl-test"))
+}
+
+func TestInstallPlugin_rename(t *testing.T) {
+	pluginDir := t.TempDir()
+	source := writeTestPlugin(t, t.TempDir(), "plugin-linux-amd64", "binary")
+
+	installed, err := InstallPlugin(pluginDir, source, "my-plugin")
+	require.NoError(t, err)
+	assert.Equal(t, "my-plugin", installed.Name)
+	assert.FileExists(t, filepath.Join(pluginDir, "my-plugin"))
+}
+
+func TestInstallPlugin_replace(t *testing.T) {
+	pluginDir := t.TempDir()
+	sourceDir := t.TempDir()
+	source := writeTestPlugin(t, sourceDir, "my-plugin", "v1")
+	_, err := InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+
+	writeTestPlugin(t, sourceDir, "my-plugin", "v2")
+	installed, err := InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+	assert.Equal(t, checksum("v2"), installed.Locked.SHA256)
+	assert.NoError(t, VerifyPlugin(pluginDir, "my-plugin"))
+}
+
+func TestInstallPlugin_tarball(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		want     string
+		wantName string
+		wantErr  string
+	}{
+		{
+			name:     "single executable",
+			files:    map[string]string{"dist/my-plugin": "binary", "dist/README.md": "readme"},
+			wantName: "my-plugin",
+		},
+		{
+			name:     "named binary",
+			files:    map[string]string{"dist/my-plugin": "binary", "dist/other-plugin": "other"},
+			want:     "my-plugin",
+			wantName: "my-plugin",
+		},
+		{
+			name:    "named binary not found",
+			files:   map[string]string{"dist/other-plugin": "other"},
+			want:    "my-plugin",
+			wantErr: "does not contain a file named my-plugin",
+		},
+		{
+			name:    "multiple executables",
+			files:   map[string]string{"dist/my-plugin": "binary", "dist/other-plugin": "other"},
+			wantErr: "contains multiple executable files, specify one of:",
+		},
+		{
+			name:    "no executables",
+			files:   map[string]string{"dist/README.md": "readme"},
+			wantErr: "does not contain an executable file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pluginDir := t.TempDir()
+			source := filepath.Join(t.TempDir(), "plugin.tar.gz")
+			writeTestTarball(t, source, tt.files)
+
+			installed, err := InstallPlugin(pluginDir, source, tt.want)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, installed.Name)
+			assert.Equal(t, checksum(tt.files["dist/"+tt.wantName]), installed.Locked.SHA256)
+			assert.NoError(t, VerifyPlugin(pluginDir, tt.wantName))
+		})
+	}
+}
+
+func TestInstallPlugin_invalidName(t *testing.T) {
+	source := writeTestPlugin(t, t.TempDir(), "my-plugin", "binary")
+	for _, name := range []string{"../my-plugin", LockFileName, ".hidden"} {
+		_, err := InstallPlugin(t.TempDir(), source, name)
+		assert.ErrorContains(t, err, "invalid plugin name")
+	}
+}
+
+func TestVerifyPlugin(t *testing.T) {
+	pluginDir := t.TempDir()
+	source := writeTestPlugin(t, t.TempDir(), "my-plugin", "binary")
+	_, err := InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+	writeTestPlugin(t, pluginDir, "unlocked-plugin", "binary")
+
+	assert.NoError(t, VerifyPlugin(pluginDir, "my-plugin"))
+	assert.NoError(t, VerifyPlugin(pluginDir, "unlocked-plugin"))
+
+	writeTestPlugin(t, pluginDir, "my-plugin", "tampered")
+	err = VerifyPlugin(pluginDir, "my-plugin")
+	assert.ErrorIs(t, err, ErrPluginModified)
+}
+
+func TestListPlugins(t *testing.T) {
+	pluginDir := t.TempDir()
+	sourceDir := t.TempDir()
+	for _, name := range []string{"verified", "modified", "missing"} {
+		_, err := InstallPlugin(pluginDir, writeTestPlugin(t, sourceDir, name, name), "")
+		require.NoError(t, err)
+	}
+	writeTestPlugin(t, pluginDir, "modified", "tampered")
+	require.NoError(t, os.Remove(filepath.Join(pluginDir, "missing")))
+	writeTestPlugin(t, pluginDir, "unlocked", "unlocked")
+	require.NoError(t, os.Mkdir(filepath.Join(pluginDir, "subdir"), 0700))
+
+	plugins, err := ListPlugins(pluginDir)
+	require.NoError(t, err)
+	got := map[string]string{}
+	names := []string{}
+	for _, p := range plugins {
+		got[p.Name] = p.Status
+		names = append(names, p.Name)
+	}
+	assert.Equal(t, []string{"missing", "modified", "unlocked", "verified"}, names)
+	assert.Equal(t, map[string]string{
+		"missing":  PluginStatusMissing,
+		"modified": PluginStatusModified,
+		"unlocked": PluginStatusUnlocked,
+		"verified": PluginStatusVerified,
+	}, got)
+}
+
+func TestListPlugins_missingDir(t *testing.T) {
+	plugins, err := ListPlugins(filepath.Join(t.TempDir(), "plugins"))
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+}
+
+func TestRemovePlugin(t *testing.T) {
+	pluginDir := t.TempDir()
+	_, err := InstallPlugin(pluginDir, writeTestPlugin(t, t.TempDir(), "my-plugin", "binary"), "")
+	require.NoError(t, err)
+
+	require.NoError(t, RemovePlugin(pluginDir, "my-plugin"))
+	assert.NoFileExists(t, filepath.Join(pluginDir, "my-plugin"))
+	lockFile, err := ReadLockFile(pluginDir)
+	require.NoError(t, err)
+	assert.Empty(t, lockFile.Plugins)
+
+	err = RemovePlugin(pluginDir, "my-plugin")
+	assert.ErrorContains(t, err, "plugin my-plugin is not installed")
+}
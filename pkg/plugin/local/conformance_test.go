@@ -0,0 +1,21 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"testing"
+
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource/conformance"
+)
+
+func TestLocalDataSource_conformance(t *testing.T) {
+	newDataSource := func(t *testing.T) datasource.DataSource {
+		lds, _ := buildLocalDataSource(t, config.NewConfig())
+		return lds
+	}
+	conformance.Run(t, newDataSource)
+	conformance.RunGRPC(t, newDataSource)
+}
@@ -33,10 +33,17 @@ func generateId() (*string, error) {
 }
 
 var _ datasource.DataSource = (*LocalDataSource)(nil)
+var _ datasource.Transactor = (*LocalDataSource)(nil)
 
 type LocalDataSource struct {
 	loader config.Loader
 	config *config.Config
+	// generation is incremented on each write of the config, allowing transactions to detect
+	// conflicting writes.
+	generation uint64
+	// tx is set when the LocalDataSource belongs to a transaction, and defers writes until the
+	// transaction is committed.
+	tx *localTransaction
 }
 
 func NewLocalDataSource(loader config.Loader) (*LocalDataSource, error) {
@@ -78,7 +85,17 @@ func (lds *LocalDataSource) loadState() error {
 }
 
 func (lds *LocalDataSource) updateDataFile() error {
-	return lds.loader.Write(lds.config)
+	if lds.tx != nil {
+		if lds.tx.closed {
+			return datasource.ErrTransactionClosed
+		}
+		return nil
+	}
+	if err := lds.loader.Write(lds.config); err != nil {
+		return err
+	}
+	lds.generation++
+	return nil
 }
 
 func (lds *LocalDataSource) AddTrustZone(trustZone *trust_zone_proto.TrustZone) (*trust_zone_proto.TrustZone, error) {
@@ -98,7 +115,7 @@ func (lds *LocalDataSource) AddTrustZone(trustZone *trust_zone_proto.TrustZone)
 	trustZone.Id = id
 
 	if _, ok := lds.config.GetTrustZoneByName(trustZone.Name); ok {
-		return nil, fmt.Errorf("trust zone %s already exists in local config", trustZone.Name)
+		return nil, datasource.AlreadyExistsf("trust zone %s already exists in local config", trustZone.Name)
 	}
 
 	lds.config.TrustZones = append(lds.config.TrustZones, trustZone)
@@ -112,7 +129,7 @@ func (lds *LocalDataSource) AddTrustZone(trustZone *trust_zone_proto.TrustZone)
 func (lds *LocalDataSource) DestroyTrustZone(id string) error {
 	// Fail if any clusters exist in the trust zone.
 	if len(lds.config.GetClustersByTrustZone(id)) > 0 {
-		return fmt.Errorf("one or more clusters exist in trust zone %s in local config", id)
+		return datasource.FailedPreconditionf("one or more clusters exist in trust zone %s in local config", id)
 	}
 
 	// Explicitly remove any attestation policy bindings that reference this trust zone.
@@ -140,13 +157,13 @@ func (lds *LocalDataSource) DestroyTrustZone(id string) error {
 			return nil
 		}
 	}
-	return fmt.Errorf("failed to find trust zone %s in local config", id)
+	return datasource.NotFoundf("failed to find trust zone %s in local config", id)
 }
 
 func (lds *LocalDataSource) GetTrustZone(id string) (*trust_zone_proto.TrustZone, error) {
 	trustZone, ok := lds.config.GetTrustZoneByID(id)
 	if !ok {
-		return nil, fmt.Errorf("failed to find trust zone %s in local config", id)
+		return nil, datasource.NotFoundf("failed to find trust zone %s in local config", id)
 	}
 
 	return proto.CloneTrustZone(trustZone)
@@ -155,7 +172,7 @@ func (lds *LocalDataSource) GetTrustZone(id string) (*trust_zone_proto.TrustZone
 func (lds *LocalDataSource) GetTrustZoneByName(name string) (*trust_zone_proto.TrustZone, error) {
 	trustZone, ok := lds.config.GetTrustZoneByName(name)
 	if !ok {
-		return nil, fmt.Errorf("failed to find trust zone %s in local config", name)
+		return nil, datasource.NotFoundf("failed to find trust zone %s in local config", name)
 	}
 
 	return proto.CloneTrustZone(trustZone)
@@ -195,7 +212,7 @@ func (lds *LocalDataSource) UpdateTrustZone(trustZone *trust_zone_proto.TrustZon
 		}
 	}
 
-	return nil, fmt.Errorf("failed to find trust zone %s in local config", trustZone.GetId())
+	return nil, datasource.NotFoundf("failed to find trust zone %s in local config", trustZone.GetId())
 }
 
 func validateTrustZoneUpdate(current, new *trust_zone_proto.TrustZone) error {
@@ -231,7 +248,7 @@ func (lds *LocalDataSource) AddCluster(cluster *clusterpb.Cluster) (*clusterpb.C
 	cluster.Id = id
 
 	if _, ok := lds.config.GetClusterByName(name, trustZoneID); ok {
-		return nil, fmt.Errorf("cluster %s already exists in trust zone %s in local config", name, trustZoneID)
+		return nil, datasource.AlreadyExistsf("cluster %s already exists in trust zone %s in local config", name, trustZoneID)
 	}
 
 	lds.config.Clusters = append(lds.config.Clusters, cluster)
@@ -251,13 +268,13 @@ func (lds *LocalDataSource) DestroyCluster(id string) error {
 			return nil
 		}
 	}
-	return fmt.Errorf("failed to find cluster %s in local config", id)
+	return datasource.NotFoundf("failed to find cluster %s in local config", id)
 }
 
 func (lds *LocalDataSource) GetCluster(id string) (*clusterpb.Cluster, error) {
 	cluster, ok := lds.config.GetClusterByID(id)
 	if !ok {
-		return nil, fmt.Errorf("failed to find cluster %s in local config", id)
+		return nil, datasource.NotFoundf("failed to find cluster %s in local config", id)
 	}
 
 	return proto.CloneCluster(cluster)
@@ -266,7 +283,7 @@ func (lds *LocalDataSource) GetCluster(id string) (*clusterpb.Cluster, error) {
 func (lds *LocalDataSource) GetClusterByName(name, trustZoneID string) (*clusterpb.Cluster, error) {
 	cluster, ok := lds.config.GetClusterByName(name, trustZoneID)
 	if !ok {
-		return nil, fmt.Errorf("failed to find cluster %s in trust zone %s in local config", name, trustZoneID)
+		return nil, datasource.NotFoundf("failed to find cluster %s in trust zone %s in local config", name, trustZoneID)
 	}
 
 	return proto.CloneCluster(cluster)
@@ -319,7 +336,7 @@ func (lds *LocalDataSource) UpdateCluster(cluster *clusterpb.Cluster) (*clusterp
 		}
 	}
 
-	return nil, fmt.Errorf("failed to find cluster %s in trust zone %s in local config", id, trustZoneId)
+	return nil, datasource.NotFoundf("failed to find cluster %s in trust zone %s in local config", id, trustZoneId)
 }
 
 func validateClusterUpdate(current, new *clusterpb.Cluster) error {
@@ -379,11 +396,11 @@ func (lds *LocalDataSource) AddAttestationPolicy(policy *attestation_policy_prot
 	policy.Id = id
 
 	if _, ok := lds.config.GetAttestationPolicyByID(policy.GetId()); ok {
-		return nil, fmt.Errorf("attestation policy %s already exists in local config", policy.GetId())
+		return nil, datasource.AlreadyExistsf("attestation policy %s already exists in local config", policy.GetId())
 	}
 
 	if _, ok := lds.config.GetAttestationPolicyByName(policy.Name); ok {
-		return nil, fmt.Errorf("attestation policy %s already exists in local config", policy.Name)
+		return nil, datasource.AlreadyExistsf("attestation policy %s already exists in local config", policy.Name)
 	}
 
 	lds.config.AttestationPolicies = append(lds.config.AttestationPolicies, policy)
@@ -397,7 +414,7 @@ func (lds *LocalDataSource) DestroyAttestationPolicy(id string) error {
 	// Fail if the policy is bound to any trust zones.
 	for _, binding := range lds.config.APBindings {
 		if binding.GetPolicyId() == id {
-			return fmt.Errorf("attestation policy %s is bound to trust zone %s in local config", id, *binding.TrustZoneId)
+			return datasource.FailedPreconditionf("attestation policy %s is bound to trust zone %s in local config", id, *binding.TrustZoneId)
 		}
 	}
 	for i, policy := range lds.config.AttestationPolicies {
@@ -409,14 +426,14 @@ func (lds *LocalDataSource) DestroyAttestationPolicy(id string) error {
 			return nil
 		}
 	}
-	return fmt.Errorf("failed to find attestation policy %s in local config", id)
+	return datasource.NotFoundf("failed to find attestation policy %s in local config", id)
 }
 
 func (lds *LocalDataSource) GetAttestationPolicy(id string) (*attestation_policy_proto.AttestationPolicy, error) {
 	if policy, ok := lds.config.GetAttestationPolicyByID(id); ok {
 		return proto.CloneAttestationPolicy(policy)
 	} else {
-		return nil, fmt.Errorf("failed to find attestation policy %s in local config", id)
+		return nil, datasource.NotFoundf("failed to find attestation policy %s in local config", id)
 	}
 }
 
@@ -424,7 +441,7 @@ func (lds *LocalDataSource) GetAttestationPolicyByName(name string) (*attestatio
 	if policy, ok := lds.config.GetAttestationPolicyByName(name); ok {
 		return proto.CloneAttestationPolicy(policy)
 	} else {
-		return nil, fmt.Errorf("failed to find attestation policy %s in local config", name)
+		return nil, datasource.NotFoundf("failed to find attestation policy %s in local config", name)
 	}
 }
 
@@ -458,17 +475,17 @@ func (lds *LocalDataSource) AddAPBinding(binding *ap_binding_proto.APBinding) (*
 
 	_, ok := lds.config.GetTrustZoneByID(binding.GetTrustZoneId())
 	if !ok {
-		return nil, fmt.Errorf("failed to find trust zone %s in local config", binding.GetTrustZoneId())
+		return nil, datasource.NotFoundf("failed to find trust zone %s in local config", binding.GetTrustZoneId())
 	}
 
 	_, ok = lds.config.GetAttestationPolicyByID(binding.GetPolicyId())
 	if !ok {
-		return nil, fmt.Errorf("failed to find attestation policy %s in local config", binding.GetPolicyId())
+		return nil, datasource.NotFoundf("failed to find attestation policy %s in local config", binding.GetPolicyId())
 	}
 
 	for _, apb := range lds.config.APBindings {
 		if apb.GetPolicyId() == binding.GetPolicyId() && apb.GetTrustZoneId() == binding.GetTrustZoneId() {
-			return nil, fmt.Errorf("attestation policy %s is already bound to trust zone %s", binding.GetPolicyId(), binding.GetTrustZoneId())
+			return nil, datasource.AlreadyExistsf("attestation policy %s is already bound to trust zone %s", binding.GetPolicyId(), binding.GetTrustZoneId())
 		}
 	}
 
@@ -511,7 +528,7 @@ func (lds *LocalDataSource) DestroyAPBinding(id string) error {
 		}
 	}
 
-	return fmt.Errorf("failed to find attestation policy binding %s in local config", id)
+	return datasource.NotFoundf("failed to find attestation policy binding %s in local config", id)
 }
 
 func (lds *LocalDataSource) ListAPBindings(filter *datasourcepb.ListAPBindingsRequest_Filter) ([]*ap_binding_proto.APBinding, error) {
@@ -519,7 +536,7 @@ func (lds *LocalDataSource) ListAPBindings(filter *datasourcepb.ListAPBindingsRe
 		// Validate that the trust zone exists in the local config.
 		_, ok := lds.config.GetTrustZoneByID(filter.GetTrustZoneId())
 		if !ok {
-			return nil, fmt.Errorf("failed to find trust zone %s in local config", filter.GetTrustZoneId())
+			return nil, datasource.NotFoundf("failed to find trust zone %s in local config", filter.GetTrustZoneId())
 		}
 	}
 	bindings := []*ap_binding_proto.APBinding{}
@@ -584,7 +601,7 @@ func (lds *LocalDataSource) UpdateAPBinding(binding *ap_binding_proto.APBinding)
 		}
 	}
 
-	return nil, fmt.Errorf("failed to find attestation policy binding %s in local config", id)
+	return nil, datasource.NotFoundf("failed to find attestation policy binding %s in local config", id)
 }
 
 func validateAPBindingUpdate(current, new *ap_binding_proto.APBinding) error {
@@ -623,12 +640,12 @@ func (lds *LocalDataSource) AddFederation(federationProto *federation_proto.Fede
 
 	_, ok := lds.config.GetTrustZoneByID(federationProto.GetTrustZoneId())
 	if !ok {
-		return nil, fmt.Errorf("failed to find trust zone %s in local config", federationProto.GetTrustZoneId())
+		return nil, datasource.NotFoundf("failed to find trust zone %s in local config", federationProto.GetTrustZoneId())
 	}
 
 	_, ok = lds.config.GetTrustZoneByID(federationProto.GetRemoteTrustZoneId())
 	if !ok {
-		return nil, fmt.Errorf("failed to find trust zone %s in local config", federationProto.GetRemoteTrustZoneId())
+		return nil, datasource.NotFoundf("failed to find trust zone %s in local config", federationProto.GetRemoteTrustZoneId())
 	}
 
 	if federationProto.GetTrustZoneId() == federationProto.GetRemoteTrustZoneId() {
@@ -645,7 +662,7 @@ func (lds *LocalDataSource) AddFederation(federationProto *federation_proto.Fede
 	}
 	for _, federation := range federations {
 		if federation.GetTrustZoneId() == federationProto.GetTrustZoneId() && federation.GetRemoteTrustZoneId() == federationProto.GetRemoteTrustZoneId() {
-			return nil, fmt.Errorf("federation already exists between %s and %s", federationProto.GetTrustZoneId(), federationProto.GetRemoteTrustZoneId())
+			return nil, datasource.AlreadyExistsf("federation already exists between %s and %s", federationProto.GetTrustZoneId(), federationProto.GetRemoteTrustZoneId())
 		}
 	}
 
@@ -667,7 +684,7 @@ func (lds *LocalDataSource) DestroyFederation(id string) error {
 			return nil
 		}
 	}
-	return fmt.Errorf("failed to find federation %s in local config", id)
+	return datasource.NotFoundf("failed to find federation %s in local config", id)
 }
 
 func (lds *LocalDataSource) ListFederations(filter *datasourcepb.ListFederationsRequest_Filter) ([]*federation_proto.Federation, error) {
@@ -0,0 +1,65 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+)
+
+// ErrTransactionConflict is returned by Commit when the config has been written since the
+// transaction began. It is of kind datasource.ErrFailedPrecondition.
+var ErrTransactionConflict = datasource.FailedPreconditionf("local config was modified during the transaction")
+
+var _ datasource.Transaction = (*localTransaction)(nil)
+
+// localTransaction is a datasource.Transaction that applies mutations to a copy of the config,
+// writing it once on Commit.
+type localTransaction struct {
+	*LocalDataSource
+	parent     *LocalDataSource
+	generation uint64
+	closed     bool
+}
+
+// Begin starts a transaction. Mutations made through the transaction are written to the config
+// in a single write when it is committed.
+func (lds *LocalDataSource) Begin(_ context.Context) (datasource.Transaction, error) {
+	if lds.tx != nil {
+		return nil, errors.New("nested transactions are not supported")
+	}
+
+	tx := &localTransaction{parent: lds, generation: lds.generation}
+	tx.LocalDataSource = &LocalDataSource{
+		loader: lds.loader,
+		config: lds.config.Clone(),
+		tx:     tx,
+	}
+	return tx, nil
+}
+
+func (tx *localTransaction) Commit(_ context.Context) error {
+	if tx.closed {
+		return datasource.ErrTransactionClosed
+	}
+	tx.closed = true
+
+	if tx.parent.generation != tx.generation {
+		return ErrTransactionConflict
+	}
+	if err := tx.parent.loader.Write(tx.config); err != nil {
+		return err
+	}
+	// Clone the config so that later use of the closed transaction cannot affect the parent.
+	tx.parent.config = tx.config.Clone()
+	tx.parent.generation++
+	return nil
+}
+
+func (tx *localTransaction) Abort(_ context.Context) error {
+	tx.closed = true
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingLoader is a config.Loader that counts writes.
+type countingLoader struct {
+	*config.MemoryLoader
+	writes int
+}
+
+func (cl *countingLoader) Write(cfg *config.Config) error {
+	cl.writes++
+	return cl.MemoryLoader.Write(cfg)
+}
+
+func buildTransactionDataSource(t *testing.T) (*LocalDataSource, *countingLoader) {
+	cfg := &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+			fixtures.TrustZone("tz2"),
+		},
+		Clusters: []*clusterpb.Cluster{
+			fixtures.Cluster("local1"),
+			fixtures.Cluster("local2"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+	memoryLoader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	loader := &countingLoader{MemoryLoader: memoryLoader}
+	lds, err := NewLocalDataSource(loader)
+	require.NoError(t, err)
+	return lds, loader
+}
+
+func TestLocalDataSource_Transaction_Commit(t *testing.T) {
+	lds, loader := buildTransactionDataSource(t)
+	ctx := context.Background()
+
+	tx, err := lds.Begin(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, tx.DestroyCluster("local1-id"))
+	require.NoError(t, tx.DestroyTrustZone("tz1-id"))
+
+	// Reads through the transaction observe its mutations, but the data source is unchanged.
+	_, err = tx.GetTrustZone("tz1-id")
+	assert.Error(t, err)
+	_, err = lds.GetTrustZone("tz1-id")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, loader.writes)
+
+	require.NoError(t, tx.Commit(ctx))
+	assert.Equal(t, 1, loader.writes)
+
+	_, err = lds.GetTrustZone("tz1-id")
+	assert.Error(t, err)
+	gotConfig := readConfig(t, loader)
+	assert.Len(t, gotConfig.TrustZones, 1)
+	assert.Len(t, gotConfig.Clusters, 1)
+
+	// The transaction cannot be used once committed.
+	assert.ErrorIs(t, tx.Commit(ctx), datasource.ErrTransactionClosed)
+	assert.ErrorContains(t, tx.DestroyCluster("local2-id"), datasource.ErrTransactionClosed.Error())
+	_, err = lds.GetCluster("local2-id")
+	assert.NoError(t, err)
+}
+
+func TestLocalDataSource_Transaction_Abort(t *testing.T) {
+	lds, loader := buildTransactionDataSource(t)
+	ctx := context.Background()
+
+	tx, err := lds.Begin(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, tx.DestroyCluster("local1-id"))
+	require.NoError(t, tx.Abort(ctx))
+
+	assert.Equal(t, 0, loader.writes)
+	_, err = lds.GetCluster("local1-id")
+	assert.NoError(t, err)
+	assert.ErrorIs(t, tx.Commit(ctx), datasource.ErrTransactionClosed)
+}
+
+func TestLocalDataSource_Transaction_Conflict(t *testing.T) {
+	lds, loader := buildTransactionDataSource(t)
+	ctx := context.Background()
+
+	tx, err := lds.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.DestroyCluster("local1-id"))
+
+	// A write outside the transaction causes the commit to fail.
+	require.NoError(t, lds.DestroyCluster("local2-id"))
+
+	err = tx.Commit(ctx)
+	assert.ErrorIs(t, err, ErrTransactionConflict)
+	assert.Equal(t, 1, loader.writes)
+	_, err = lds.GetCluster("local1-id")
+	assert.NoError(t, err)
+}
+
+func TestLocalDataSource_Transaction_Nested(t *testing.T) {
+	lds, _ := buildTransactionDataSource(t)
+
+	tx, err := lds.Begin(context.Background())
+	require.NoError(t, err)
+	_, err = tx.(datasource.Transactor).Begin(context.Background())
+	assert.EqualError(t, err, "nested transactions are not supported")
+}
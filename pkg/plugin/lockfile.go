@@ -0,0 +1,135 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// LockFileName is the name of the plugin lockfile in the plugin directory.
+	LockFileName = "plugins.lock"
+)
+
+// ErrPluginModified is returned when the checksum of a plugin binary does not match its
+// lockfile entry.
+var ErrPluginModified = errors.New("plugin binary does not match its recorded checksum")
+
+// LockFile records the plugins installed in a plugin directory.
+type LockFile struct {
+	Plugins map[string]*LockedPlugin `json:"plugins"`
+}
+
+// LockedPlugin is a lockfile entry for an installed plugin.
+type LockedPlugin struct {
+	// SHA256 is the hex-encoded SHA-256 checksum of the plugin binary.
+	SHA256 string `json:"sha256"`
+	// Source is the path the plugin was installed from.
+	Source      string    `json:"source,omitempty"`
+	InstalledAt time.Time `json:"installed_at"`
+}
+
+// ReadLockFile reads the lockfile in pluginDir. A missing lockfile is treated as empty.
+func ReadLockFile(pluginDir string) (*LockFile, error) {
+	lockFile := &LockFile{Plugins: map[string]*LockedPlugin{}}
+	data, err := os.ReadFile(filepath.Join(pluginDir, LockFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return lockFile, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read plugin lockfile: %w", err)
+	}
+
+	if err := json.Unmarshal(data, lockFile); err != nil {
+		return nil, fmt.Errorf("failed to parse plugin lockfile: %w", err)
+	}
+	if lockFile.Plugins == nil {
+		lockFile.Plugins = map[string]*LockedPlugin{}
+	}
+	return lockFile, nil
+}
+
+// Write writes the lockfile to pluginDir, replacing any existing lockfile.
+func (lf *LockFile) Write(pluginDir string) error {
+	data, err := json.MarshalIndent(lf, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal plugin lockfile: %w", err)
+	}
+	if err := writeFileAtomic(filepath.Join(pluginDir, LockFileName), append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write plugin lockfile: %w", err)
+	}
+	return nil
+}
+
+// VerifyPlugin checks the named plugin binary in pluginDir against its lockfile entry.
+// Plugins without a lockfile entry, e.g. those copied into the plugin directory manually, are
+// allowed with a warning. Use `cofidectl plugin install` to record them.
+func VerifyPlugin(pluginDir, name string) error {
+	lockFile, err := ReadLockFile(pluginDir)
+	if err != nil {
+		return err
+	}
+
+	locked, ok := lockFile.Plugins[name]
+	if !ok {
+		slog.Warn("Plugin is not recorded in the plugin lockfile, skipping checksum verification", "plugin", name)
+		return nil
+	}
+
+	checksum, err := fileSHA256(filepath.Join(pluginDir, name))
+	if err != nil {
+		return err
+	}
+	if checksum != locked.SHA256 {
+		return fmt.Errorf("%w: %s has checksum %s, expected %s", ErrPluginModified, name, checksum, locked.SHA256)
+	}
+	return nil
+}
+
+// fileSHA256 returns the hex-encoded SHA-256 checksum of the file at path.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory as path, then renames it
+// over path, so that readers never see a partially written file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hclog "github.com/hashicorp/go-hclog"
+	go_plugin "github.com/hashicorp/go-plugin"
+)
+
+// PluginInfo describes the cofidectl plugins dispensed by a gRPC plugin.
+type PluginInfo struct {
+	Path string
+	// PluginTypes lists the cofidectl plugin types dispensed by the gRPC plugin, using the names
+	// datasource.DataSourcePluginName and provision.ProvisionPluginName.
+	PluginTypes []string
+	// Capabilities contains the capabilities of each dispensed plugin type.
+	Capabilities map[string]*plugin.Capabilities
+}
+
+// InspectGRPCPlugin launches the gRPC plugin at pluginPath, completes the plugin handshake and
+// reports which cofidectl plugin types it dispenses, and their capabilities. The plugin is verified against the
+// signature policy before it is launched, and the plugin process is stopped before returning.
+func (pm *PluginManager) InspectGRPCPlugin(ctx context.Context, pluginPath string) (*PluginInfo, error) {
+	policy, err := pm.getSignaturePolicy()
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Verify(pluginPath); err != nil {
+		return nil, err
+	}
+	return inspectGRPCPlugin(ctx, pm.newPluginLogger(filepath.Base(pluginPath)), pluginPath)
+}
+
+func inspectGRPCPlugin(ctx context.Context, logger hclog.Logger, pluginPath string) (*PluginInfo, error) {
+	client := go_plugin.NewClient(&go_plugin.ClientConfig{
+		Cmd:             exec.Command(pluginPath, plugin.PluginServeArgs...),
+		HandshakeConfig: plugin.HandshakeConfig,
+		Plugins: map[string]go_plugin.Plugin{
+			datasource.DataSourcePluginName: &datasource.DataSourcePlugin{},
+			provision.ProvisionPluginName:   &provision.ProvisionPlugin{},
+		},
+		AllowedProtocols: []go_plugin.Protocol{go_plugin.ProtocolGRPC},
+		Logger:           logger,
+		AutoMTLS:         true,
+	})
+	defer client.Kill()
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete the plugin handshake: %w", err)
+	}
+	grpcClient, ok := rpcClient.(*go_plugin.GRPCClient)
+	if !ok {
+		return nil, fmt.Errorf("plugin does not use the gRPC protocol")
+	}
+
+	probes := []struct {
+		name         string
+		validate     func(conn grpc.ClientConnInterface) error
+		capabilities func(ctx context.Context, conn grpc.ClientConnInterface) (*plugin.Capabilities, error)
+	}{
+		{
+			name: datasource.DataSourcePluginName,
+			validate: func(conn grpc.ClientConnInterface) error {
+				_, err := datasourcepb.NewDataSourcePluginServiceClient(conn).Validate(ctx, &datasourcepb.ValidateRequest{})
+				return err
+			},
+			capabilities: datasource.GetCapabilitiesGRPC,
+		},
+		{
+			name: provision.ProvisionPluginName,
+			validate: func(conn grpc.ClientConnInterface) error {
+				_, err := provisionpb.NewProvisionPluginServiceClient(conn).Validate(ctx, &provisionpb.ValidateRequest{})
+				return err
+			},
+			capabilities: provision.GetCapabilitiesGRPC,
+		},
+	}
+
+	// A plugin that does not dispense a plugin type does not register its gRPC service.
+	// Other errors indicate that the service is registered but failed validation.
+	info := &PluginInfo{Path: pluginPath, PluginTypes: []string{}, Capabilities: map[string]*plugin.Capabilities{}}
+	for _, probe := range probes {
+		if err := probe.validate(grpcClient.Conn); status.Code(err) != codes.Unimplemented {
+			info.PluginTypes = append(info.PluginTypes, probe.name)
+			capabilities, err := probe.capabilities(ctx, grpcClient.Conn)
+			if err != nil {
+				return nil, err
+			}
+			info.Capabilities[probe.name] = capabilities
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspectGRPCPlugin_notAPlugin(t *testing.T) {
+	pluginPath := filepath.Join(t.TempDir(), "cofidectl-not-a-plugin")
+	require.NoError(t, os.WriteFile(pluginPath, []byte("#!/bin/sh\necho usage\nexit 1\n"), 0755))
+
+	_, err := inspectGRPCPlugin(context.Background(), hclog.NewNullLogger(), pluginPath)
+	assert.ErrorContains(t, err, "failed to complete the plugin handshake")
+}
+
+func TestInspectGRPCPlugin(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test that builds the test plugin in short mode")
+	}
+	pluginPath := filepath.Join(t.TempDir(), "cofidectl-test-plugin")
+	build := exec.Command("go", "build", "-o", pluginPath, "../../../cmd/cofidectl-test-plugin")
+	out, err := build.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	// The test plugin serves the local data source, which requires a config file.
+	dir := t.TempDir()
+	require.NoError(t, config.NewFileLoader(filepath.Join(dir, "cofide.yaml")).Write(config.NewConfig()))
+	t.Chdir(dir)
+
+	info, err := inspectGRPCPlugin(context.Background(), hclog.NewNullLogger(), pluginPath)
+	require.NoError(t, err)
+	assert.Equal(t, pluginPath, info.Path)
+	assert.Equal(t, []string{datasource.DataSourcePluginName, provision.ProvisionPluginName}, info.PluginTypes)
+	assert.Equal(t, map[string]*plugin.Capabilities{
+		datasource.DataSourcePluginName: {APIVersions: []string{datasource.APIVersion}, Features: []string{datasource.FeatureTransactions}},
+		provision.ProvisionPluginName:   {APIVersions: []string{provision.APIVersion}, Features: []string{provision.FeatureStatus}},
+	}, info.Capabilities)
+}
@@ -7,12 +7,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"maps"
 	"os"
 	"os/exec"
+	"slices"
+	"strings"
+	"sync"
 
 	pluginspb "github.com/cofide/cofidectl-sdk/gen/go/proto/plugins/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/audit"
 	"github.com/cofide/cofidectl/internal/pkg/config"
 	"github.com/cofide/cofidectl/internal/pkg/proto"
 	"github.com/cofide/cofidectl/pkg/plugin"
@@ -20,16 +25,19 @@ import (
 	"github.com/cofide/cofidectl/pkg/plugin/local"
 	"github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/cofide/cofidectl/pkg/plugin/provision/spirehelm"
+	"github.com/cofide/cofidectl/pkg/plugin/provision/spiremanifests"
 	"github.com/cofide/cofidectl/pkg/plugin/validator"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	hclog "github.com/hashicorp/go-hclog"
 	go_plugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
 )
 
 const (
-	LocalDSPluginName            = "local"
-	SpireHelmProvisionPluginName = "spire-helm"
+	LocalDSPluginName                 = "local"
+	SpireHelmProvisionPluginName      = "spire-helm"
+	SpireManifestsProvisionPluginName = "spire-manifests"
 )
 
 // PluginManager provides an interface for loading and managing `DataSource` plugins based on configuration.
@@ -38,10 +46,17 @@ type PluginManager struct {
 	defaultLoader    *defaultPluginLoader
 	loaders          []PluginLoader
 	grpcPluginLoader grpcPluginLoader
-	source           datasource.DataSource
-	provision        provision.Provision
-	clients          map[string]*go_plugin.Client
-	logLevel         hclog.Level
+	// grpcPluginInspector inspects the named gRPC plugin without validating it.
+	grpcPluginInspector func(ctx context.Context, pluginName string) (*PluginInfo, error)
+	source              datasource.DataSourceV2
+	provision           provision.Provision
+	clients             map[string]*go_plugin.Client
+	clientsMu           sync.Mutex
+	logLevel            hclog.Level
+	logOutput           io.Writer
+	logJSON             bool
+	auditLog            *audit.Log
+	signaturePolicy     *plugin.SignaturePolicy
 }
 
 // PluginLoader is an interface that allows loading custom in-process plugins.
@@ -71,6 +86,18 @@ type grpcPlugin struct {
 	client    *go_plugin.Client
 	source    datasource.DataSource
 	provision provision.Provision
+	// conn is the connection to the plugin process. If nil, the plugin is not restarted if it
+	// crashes.
+	conn grpc.ClientConnInterface
+	// exited returns whether the plugin process has exited.
+	exited func() bool
+	// stderr retains the last lines of the plugin's stderr output.
+	stderr *tailWriter
+}
+
+// hasExited returns whether the plugin process is known to have exited.
+func (gp *grpcPlugin) hasExited() bool {
+	return gp.exited != nil && gp.exited()
 }
 
 // NewManager returns a new plugin manager.
@@ -82,17 +109,39 @@ func NewManager(configLoader config.Loader, customLoader PluginLoader) *PluginMa
 		loaders = append(loaders, customLoader)
 	}
 	loaders = append(loaders, defaultLoader)
-	return &PluginManager{
-		configLoader:     configLoader,
-		defaultLoader:    defaultLoader,
-		loaders:          loaders,
-		grpcPluginLoader: loadGRPCPlugin,
-		clients:          map[string]*go_plugin.Client{},
+	pm := &PluginManager{
+		configLoader:  configLoader,
+		defaultLoader: defaultLoader,
+		loaders:       loaders,
+		clients:       map[string]*go_plugin.Client{},
+		logOutput:     os.Stderr,
+	}
+	pm.grpcPluginLoader = func(ctx context.Context, logger hclog.Logger, pluginName string, pluginCfg *pluginspb.Plugins) (*grpcPlugin, error) {
+		resolved, err := pm.FindPlugin(pluginName)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := pm.getSignaturePolicy()
+		if err != nil {
+			return nil, err
+		}
+		return loadGRPCPlugin(ctx, logger, policy, resolved, pluginCfg)
 	}
+	pm.grpcPluginInspector = func(ctx context.Context, pluginName string) (*PluginInfo, error) {
+		resolved, err := pm.FindPlugin(pluginName)
+		if err != nil {
+			return nil, err
+		}
+		if err := plugin.VerifyPlugin(resolved.Dir.Path, pluginName); err != nil {
+			return nil, err
+		}
+		return pm.InspectGRPCPlugin(ctx, resolved.Path)
+	}
+	return pm
 }
 
 // UpdateConfigLoader replaces the config loader used to read and write the config file.
-// Any cached plugin instances are cleared so that subsequent calls to GetDataSource and
+// Any cached plugin instances are cleared so that subsequent calls to GetDataSourceV2 and
 // GetProvision re-initialize with the new loader.
 func (pm *PluginManager) UpdateConfigLoader(loader config.Loader) {
 	pm.configLoader = loader
@@ -101,6 +150,79 @@ func (pm *PluginManager) UpdateConfigLoader(loader config.Loader) {
 	pm.provision = nil
 }
 
+// SetAuditLog sets the audit log used to record data source mutations. If log is nil, mutations
+// are not recorded. It should be called before the data source is loaded.
+func (pm *PluginManager) SetAuditLog(log *audit.Log) {
+	pm.auditLog = log
+}
+
+// GetAuditLog returns the audit log, or nil if auditing is disabled.
+func (pm *PluginManager) GetAuditLog() *audit.Log {
+	return pm.auditLog
+}
+
+// withAudit returns ds wrapped to record mutations in the audit log, if one has been set.
+func (pm *PluginManager) withAudit(ds datasource.DataSourceV2) datasource.DataSourceV2 {
+	if pm.auditLog == nil {
+		return ds
+	}
+	return audit.NewDataSource(ds, pm.auditLog)
+}
+
+// SetSignaturePolicy sets the policy used to verify gRPC plugin signatures before executing them.
+// If it is not set, plugins must be signed by a key in the default trusted keys directory.
+func (pm *PluginManager) SetSignaturePolicy(policy *plugin.SignaturePolicy) {
+	pm.signaturePolicy = policy
+}
+
+// getSignaturePolicy returns the signature policy, or the default policy if none has been set.
+func (pm *PluginManager) getSignaturePolicy() (*plugin.SignaturePolicy, error) {
+	if pm.signaturePolicy != nil {
+		return pm.signaturePolicy, nil
+	}
+	return plugin.NewSignaturePolicy(false)
+}
+
+// GetPluginDirs returns the plugin search path, including any directories in the plugins.path
+// setting of the config file. The setting is ignored if the config file does not exist or cannot
+// be read, so that a missing or invalid config file does not prevent plugins from being found.
+func (pm *PluginManager) GetPluginDirs() ([]plugin.PluginDir, error) {
+	var configPath []string
+	var configDir string
+	if exists, err := pm.configLoader.Exists(); err != nil {
+		slog.Warn("Failed to check for config file, ignoring plugins.path", "error", err)
+	} else if exists {
+		if cfg, err := pm.configLoader.Read(); err != nil {
+			slog.Warn("Failed to read config file, ignoring plugins.path", "error", err)
+		} else {
+			configPath = cfg.PluginPath
+		}
+	}
+	if dirLoader, ok := pm.configLoader.(config.DirLoader); ok {
+		configDir = dirLoader.Dir()
+	}
+	return plugin.GetPluginDirs(configPath, configDir)
+}
+
+// FindPlugin returns the first plugin with the specified name in the plugin search path.
+func (pm *PluginManager) FindPlugin(name string) (*plugin.ResolvedPlugin, error) {
+	dirs, err := pm.GetPluginDirs()
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := plugin.FindPlugin(dirs, name)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("Resolved plugin", "plugin", name, "path", resolved.Path, "source", resolved.Dir.Source)
+	return resolved, nil
+}
+
+// GetConfigLoader returns the config loader used to read and write the config file.
+func (pm *PluginManager) GetConfigLoader() config.Loader {
+	return pm.configLoader
+}
+
 // Init initialises the configuration for the specified plugins.
 func (pm *PluginManager) Init(ctx context.Context, plugins *pluginspb.Plugins, pluginConfig map[string]*structpb.Struct) error {
 	if plugins == nil {
@@ -135,6 +257,14 @@ func (pm *PluginManager) Init(ctx context.Context, plugins *pluginspb.Plugins, p
 		if pluginConfig != nil {
 			cfg.PluginConfig = pluginConfig
 		}
+
+		// Validate the plugin config before writing the config file, so that an invalid config
+		// file is not left behind.
+		if len(pluginConfig) > 0 {
+			if err := pm.validatePluginConfigs(ctx, cfg, plugins.GetDataSource(), plugins.GetProvision()); err != nil {
+				return err
+			}
+		}
 		if err := pm.configLoader.Write(cfg); err != nil {
 			return err
 		}
@@ -143,16 +273,29 @@ func (pm *PluginManager) Init(ctx context.Context, plugins *pluginspb.Plugins, p
 	return nil
 }
 
-// GetDataSource returns the data source plugin, loading it if necessary.
-func (pm *PluginManager) GetDataSource(ctx context.Context) (datasource.DataSource, error) {
+// GetDataSourceV2 returns the context-aware DataSourceV2 interface for the data source plugin,
+// loading it if necessary. Each call made through the returned data source uses the context
+// passed to the method, allowing deadlines and cancellation to reach gRPC plugins.
+func (pm *PluginManager) GetDataSourceV2(ctx context.Context) (datasource.DataSourceV2, error) {
 	if pm.source != nil {
 		return pm.source, nil
 	}
 	return pm.loadDataSource(ctx)
 }
 
+// GetDataSource returns the data source plugin as a DataSource, loading it if necessary. Calls
+// made through the returned data source use ctx. It is intended for APIs that accept a
+// DataSource, such as provision plugins; otherwise GetDataSourceV2 should be used.
+func (pm *PluginManager) GetDataSource(ctx context.Context) (datasource.DataSource, error) {
+	source, err := pm.GetDataSourceV2(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return datasource.ToV1(ctx, source), nil
+}
+
 // loadDataSource loads the data source plugin, which may be an in-process or gRPC plugin.
-func (pm *PluginManager) loadDataSource(ctx context.Context) (datasource.DataSource, error) {
+func (pm *PluginManager) loadDataSource(ctx context.Context) (datasource.DataSourceV2, error) {
 	if pm.source != nil {
 		return nil, errors.New("data source has already been loaded")
 	}
@@ -177,7 +320,7 @@ func (pm *PluginManager) loadDataSource(ctx context.Context) (datasource.DataSou
 			if err := ds.Validate(ctx); err != nil {
 				return nil, err
 			}
-			pm.source = ds
+			pm.source = pm.withAudit(datasource.FromV1(ds))
 			return pm.source, nil
 		}
 	}
@@ -188,6 +331,16 @@ func (pm *PluginManager) loadDataSource(ctx context.Context) (datasource.DataSou
 	return pm.source, nil
 }
 
+// GetDataSourceCapabilities returns the capabilities of the data source plugin, loading it if
+// necessary.
+func (pm *PluginManager) GetDataSourceCapabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	source, err := pm.GetDataSourceV2(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return datasource.GetCapabilities(ctx, source)
+}
+
 // GetProvision returns the provision plugin, loading it if necessary.
 func (pm *PluginManager) GetProvision(ctx context.Context) (provision.Provision, error) {
 	if pm.provision != nil {
@@ -196,6 +349,51 @@ func (pm *PluginManager) GetProvision(ctx context.Context) (provision.Provision,
 	return pm.loadProvision(ctx)
 }
 
+// GetProvisionCapabilities returns the capabilities of the provision plugin, loading it if
+// necessary.
+func (pm *PluginManager) GetProvisionCapabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	impl, err := pm.GetProvision(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return provision.GetCapabilities(ctx, impl)
+}
+
+// RequireProvisionFeature returns an error wrapping plugin.ErrNotSupported if the provision
+// plugin does not support an optional feature.
+func (pm *PluginManager) RequireProvisionFeature(ctx context.Context, feature string) error {
+	capabilities, err := pm.GetProvisionCapabilities(ctx)
+	if err != nil {
+		return err
+	}
+	if capabilities.HasFeature(feature) {
+		return nil
+	}
+	cfg, err := pm.readConfig()
+	if err != nil {
+		return err
+	}
+	return &plugin.UnsupportedError{Plugin: cfg.Plugins.GetProvision(), Feature: feature}
+}
+
+// GetStatusReporter returns the provision plugin's StatusReporter, loading the plugin if
+// necessary. It returns an error wrapping plugin.ErrNotSupported if the provision plugin does not
+// support status queries.
+func (pm *PluginManager) GetStatusReporter(ctx context.Context) (provision.StatusReporter, error) {
+	if err := pm.RequireProvisionFeature(ctx, provision.FeatureStatus); err != nil {
+		return nil, err
+	}
+	impl, err := pm.GetProvision(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reporter, ok := impl.(provision.StatusReporter)
+	if !ok {
+		return nil, provision.ErrStatusNotSupported
+	}
+	return reporter, nil
+}
+
 // loadProvision loads the provision plugin, which may be an in-process or gRPC plugin.
 func (pm *PluginManager) loadProvision(ctx context.Context) (provision.Provision, error) {
 	if pm.provision != nil {
@@ -238,27 +436,58 @@ func (pm *PluginManager) loadProvision(ctx context.Context) (provision.Provision
 // all cofidectl plugins configured to use this gRPC plugin will be loaded in a single plugin
 // client and server process.
 func (pm *PluginManager) loadGRPCPlugin(ctx context.Context, pluginName string, pluginCfg *pluginspb.Plugins) error {
-	logger := hclog.New(&hclog.LoggerOptions{
-		Name:   "plugin",
-		Output: os.Stdout,
-		Level:  pm.logLevel,
-	})
-
-	grpcPlugin, err := pm.grpcPluginLoader(ctx, logger, pluginName, pluginCfg)
+	loaded, err := pm.grpcPluginLoader(ctx, pm.newPluginLogger(pluginName), pluginName, pluginCfg)
 	if err != nil {
 		return err
 	}
 
-	if grpcPlugin.source != nil {
-		pm.source = grpcPlugin.source
+	var source datasource.DataSourceV2
+	if loaded.source != nil {
+		source = datasource.FromV1(loaded.source)
 	}
-	if grpcPlugin.provision != nil {
-		pm.provision = grpcPlugin.provision
+	provision := loaded.provision
+	if loaded.conn != nil {
+		// Route calls through the plugin process, so that the plugin is relaunched if it crashes.
+		launch := func(ctx context.Context) (*grpcPlugin, error) {
+			return pm.grpcPluginLoader(ctx, pm.newPluginLogger(pluginName), pluginName, pluginCfg)
+		}
+		onRestart := func(restarted *grpcPlugin) {
+			pm.clientsMu.Lock()
+			defer pm.clientsMu.Unlock()
+			pm.clients[pluginName] = restarted.client
+		}
+		process := newPluginProcess(pluginName, loaded, launch, onRestart)
+		if source != nil {
+			source = datasource.NewDataSourcePluginClientGRPCV2FromConn(process)
+		}
+		if provision != nil {
+			provision = &restartingProvision{process: process}
+		}
+	}
+
+	if source != nil {
+		pm.source = pm.withAudit(source)
 	}
-	pm.clients[pluginName] = grpcPlugin.client
+	if provision != nil {
+		pm.provision = provision
+	}
+	pm.clientsMu.Lock()
+	defer pm.clientsMu.Unlock()
+	pm.clients[pluginName] = loaded.client
 	return nil
 }
 
+// newPluginLogger returns a logger for a gRPC plugin client at the manager's log level. Each line
+// is tagged with the name of the plugin.
+func (pm *PluginManager) newPluginLogger(pluginName string) hclog.Logger {
+	return hclog.New(&hclog.LoggerOptions{
+		Name:       "plugin",
+		Output:     pm.logOutput,
+		Level:      pm.logLevel,
+		JSONFormat: pm.logJSON,
+	}).With("plugin", pluginName)
+}
+
 func newDefaultPluginLoader(configLoader config.Loader) *defaultPluginLoader {
 	return &defaultPluginLoader{configLoader: configLoader}
 }
@@ -277,22 +506,39 @@ func (dpl *defaultPluginLoader) LoadDataSource(_ context.Context, name string) (
 }
 
 // LoadProvision implements PluginLoader.
-// It loads the spire-helm provision plugin if requested.
+// It loads the spire-helm or spire-manifests provision plugin if requested.
 func (dpl *defaultPluginLoader) LoadProvision(_ context.Context, name string) (provision.Provision, error) {
-	if name == SpireHelmProvisionPluginName {
+	switch name {
+	case SpireHelmProvisionPluginName:
 		spireHelm := spirehelm.NewSpireHelm(nil, nil)
 		return spireHelm, nil
+	case SpireManifestsProvisionPluginName:
+		cfg, err := dpl.configLoader.Read()
+		if err != nil {
+			return nil, err
+		}
+		manifestsConfig, err := spiremanifests.ParseConfig(cfg.PluginConfig[name])
+		if err != nil {
+			return nil, err
+		}
+		return spiremanifests.NewSpireManifests(manifestsConfig, nil), nil
 	}
 	return nil, nil
 }
 
-// loadGRPCPlugin is the default grpcPluginLoader.
-func loadGRPCPlugin(ctx context.Context, logger hclog.Logger, pluginName string, plugins *pluginspb.Plugins) (*grpcPlugin, error) {
-	pluginPath, err := plugin.GetPluginPath(pluginName)
-	if err != nil {
+// loadGRPCPlugin loads a gRPC plugin binary resolved from the plugin search path, after
+// verifying it against the plugin lockfile and the signature policy. It is used by the default
+// grpcPluginLoader.
+func loadGRPCPlugin(ctx context.Context, logger hclog.Logger, policy *plugin.SignaturePolicy, resolved *plugin.ResolvedPlugin, plugins *pluginspb.Plugins) (*grpcPlugin, error) {
+	pluginName := resolved.Name
+	if err := plugin.VerifyPlugin(resolved.Dir.Path, pluginName); err != nil {
+		return nil, err
+	}
+	if err := policy.Verify(resolved.Path); err != nil {
 		return nil, err
 	}
 
+	stderr := newTailWriter(stderrTailLines)
 	pluginSet := map[string]go_plugin.Plugin{}
 	if plugins.GetDataSource() == pluginName {
 		pluginSet[datasource.DataSourcePluginName] = &datasource.DataSourcePlugin{}
@@ -301,7 +547,7 @@ func loadGRPCPlugin(ctx context.Context, logger hclog.Logger, pluginName string,
 		pluginSet[provision.ProvisionPluginName] = &provision.ProvisionPlugin{}
 	}
 
-	cmd := exec.Command(pluginPath, plugin.PluginServeArgs...)
+	cmd := exec.Command(resolved.Path, plugin.PluginServeArgs...)
 	client := go_plugin.NewClient(&go_plugin.ClientConfig{
 		Cmd:              cmd,
 		HandshakeConfig:  plugin.HandshakeConfig,
@@ -309,6 +555,7 @@ func loadGRPCPlugin(ctx context.Context, logger hclog.Logger, pluginName string,
 		AllowedProtocols: []go_plugin.Protocol{go_plugin.ProtocolGRPC},
 		Logger:           logger,
 		AutoMTLS:         true,
+		Stderr:           stderr,
 	})
 
 	grpcPlugin, err := startGRPCPlugin(ctx, client, pluginName, plugins)
@@ -316,6 +563,7 @@ func loadGRPCPlugin(ctx context.Context, logger hclog.Logger, pluginName string,
 		client.Kill()
 		return nil, err
 	}
+	grpcPlugin.stderr = stderr
 	return grpcPlugin, nil
 }
 
@@ -329,9 +577,12 @@ func startGRPCPlugin(ctx context.Context, client *go_plugin.Client, pluginName s
 		return nil, fmt.Errorf("failed to ping the gRPC client: %w", err)
 	}
 
-	grpcPlugin := &grpcPlugin{client: client}
+	grpcPlugin := &grpcPlugin{client: client, exited: client.Exited}
+	if c, ok := grpcClient.(*go_plugin.GRPCClient); ok {
+		grpcPlugin.conn = c.Conn
+	}
 	if plugins.GetDataSource() == pluginName {
-		source, err := dispensePlugin[datasource.DataSource](ctx, grpcClient, datasource.DataSourcePluginName)
+		source, err := dispensePlugin[datasource.DataSource](ctx, grpcClient, datasource.DataSourcePluginName, pluginName, datasource.APIVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -339,7 +590,7 @@ func startGRPCPlugin(ctx context.Context, client *go_plugin.Client, pluginName s
 	}
 
 	if plugins.GetProvision() == pluginName {
-		provision, err := dispensePlugin[provision.Provision](ctx, grpcClient, provision.ProvisionPluginName)
+		provision, err := dispensePlugin[provision.Provision](ctx, grpcClient, provision.ProvisionPluginName, pluginName, provision.APIVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -348,23 +599,49 @@ func startGRPCPlugin(ctx context.Context, client *go_plugin.Client, pluginName s
 	return grpcPlugin, nil
 }
 
-// dispensePlugin dispenses a gRPC plugin from a client, ensuring that it implements the specified interface T.
-func dispensePlugin[T validator.Validator](ctx context.Context, grpcClient go_plugin.ClientProtocol, name string) (T, error) {
+// dispensePlugin dispenses a gRPC plugin from a client, ensuring that it implements the specified
+// interface T and supports apiVersion.
+func dispensePlugin[T validator.Validator](ctx context.Context, grpcClient go_plugin.ClientProtocol, name, pluginName, apiVersion string) (T, error) {
 	var zero T
 	raw, err := grpcClient.Dispense(name)
 	if err != nil {
 		return zero, fmt.Errorf("failed to dispense an instance of the gRPC %s plugin: %w", name, err)
 	}
 
-	plugin, ok := raw.(T)
+	dispensed, ok := raw.(T)
 	if !ok {
-		return zero, fmt.Errorf("gRPC %s plugin (%T) does not implement plugin interface ", name, plugin)
+		return zero, fmt.Errorf("gRPC %s plugin (%T) does not implement plugin interface ", name, dispensed)
 	}
 
-	if err := plugin.Validate(ctx); err != nil {
+	if err := checkAPIVersion(ctx, dispensed, name, pluginName, apiVersion); err != nil {
 		return zero, err
 	}
-	return plugin, nil
+
+	if err := dispensed.Validate(ctx); err != nil {
+		return zero, err
+	}
+	return dispensed, nil
+}
+
+// checkAPIVersion returns an error wrapping plugin.ErrNotSupported if a plugin reports that it
+// does not support apiVersion, so that incompatible plugins fail fast rather than on the first
+// unimplemented call.
+func checkAPIVersion(ctx context.Context, impl any, name, pluginName, apiVersion string) error {
+	reporter, ok := impl.(plugin.CapabilityReporter)
+	if !ok {
+		return nil
+	}
+	capabilities, err := reporter.Capabilities(ctx)
+	if err != nil {
+		return err
+	}
+	if !capabilities.SupportsAPIVersion(apiVersion) {
+		return &plugin.UnsupportedError{
+			Plugin:  pluginName,
+			Feature: fmt.Sprintf("the %s plugin API version %s (supported versions: %s)", name, apiVersion, strings.Join(capabilities.APIVersions, ", ")),
+		}
+	}
+	return nil
 }
 
 func (pm *PluginManager) readConfig() (*config.Config, error) {
@@ -381,6 +658,8 @@ func (pm *PluginManager) readConfig() (*config.Config, error) {
 }
 
 func (pm *PluginManager) Shutdown() {
+	pm.clientsMu.Lock()
+	defer pm.clientsMu.Unlock()
 	for name, client := range pm.clients {
 		if client != nil {
 			client.Kill()
@@ -391,6 +670,129 @@ func (pm *PluginManager) Shutdown() {
 	pm.provision = nil
 }
 
+// ValidatePluginConfig validates configuration for the named plugin against the configuration
+// schemas that it reports, if it is the data source or provision plugin. The configuration is
+// validated before it is written, so the plugin's schema is retrieved without validating the
+// plugin's current configuration.
+func (pm *PluginManager) ValidatePluginConfig(ctx context.Context, pluginName string, pluginConfig *structpb.Struct) error {
+	cfg, err := pm.readConfig()
+	if err != nil {
+		return err
+	}
+	cfg = cfg.Clone()
+	if pluginConfig != nil {
+		cfg.PluginConfig[pluginName] = pluginConfig
+	} else {
+		delete(cfg.PluginConfig, pluginName)
+	}
+	return pm.validatePluginConfigs(ctx, cfg, pluginName)
+}
+
+// ValidatePluginConfigs validates the configuration of the data source and provision plugins in
+// the config file against the configuration schemas that they report.
+func (pm *PluginManager) ValidatePluginConfigs(ctx context.Context) error {
+	cfg, err := pm.readConfig()
+	if err != nil {
+		return err
+	}
+	return pm.validatePluginConfigs(ctx, cfg, cfg.Plugins.GetDataSource(), cfg.Plugins.GetProvision())
+}
+
+// validatePluginConfigs validates the configuration of the named plugins in cfg, which need not
+// have been written to the config file, against the configuration schemas that they report.
+func (pm *PluginManager) validatePluginConfigs(ctx context.Context, cfg *config.Config, pluginNames ...string) error {
+	// In-process plugins may read their configuration when loaded, so load them from cfg.
+	configLoader, err := config.NewMemoryLoader(cfg)
+	if err != nil {
+		return err
+	}
+	loaders := make([]PluginLoader, 0, len(pm.loaders))
+	for _, loader := range pm.loaders {
+		if loader == PluginLoader(pm.defaultLoader) {
+			loader = newDefaultPluginLoader(configLoader)
+		}
+		loaders = append(loaders, loader)
+	}
+
+	var errs []error
+	for _, pluginName := range slices.Compact(pluginNames) {
+		if pluginName == "" {
+			continue
+		}
+		schemas, err := pm.getConfigSchemas(ctx, loaders, cfg.Plugins, pluginName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, schema := range schemas {
+			if schema == "" {
+				continue
+			}
+			if err := plugin.ValidateConfig(schema, cfg.PluginConfig[pluginName]); err != nil {
+				errs = append(errs, fmt.Errorf("invalid plugin config for %s: %w", pluginName, err))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getConfigSchemas returns the configuration schemas reported by the named plugin for each of
+// its roles in plugins. In-process plugins are loaded using loaders, and gRPC plugins are
+// inspected through the capabilities service. Plugins are not validated, since validation may
+// fail due to the configuration being checked.
+func (pm *PluginManager) getConfigSchemas(ctx context.Context, loaders []PluginLoader, plugins *pluginspb.Plugins, pluginName string) ([]string, error) {
+	isDataSource := plugins.GetDataSource() == pluginName
+	isProvision := plugins.GetProvision() == pluginName
+
+	schemas := []string{}
+	for _, loader := range loaders {
+		if isDataSource {
+			ds, err := loader.LoadDataSource(ctx, pluginName)
+			if err != nil {
+				return nil, err
+			}
+			if ds != nil {
+				capabilities, err := datasource.GetCapabilities(ctx, ds)
+				if err != nil {
+					return nil, err
+				}
+				schemas = append(schemas, capabilities.ConfigSchema)
+				isDataSource = false
+			}
+		}
+		if isProvision {
+			impl, err := loader.LoadProvision(ctx, pluginName)
+			if err != nil {
+				return nil, err
+			}
+			if impl != nil {
+				capabilities, err := provision.GetCapabilities(ctx, impl)
+				if err != nil {
+					return nil, err
+				}
+				schemas = append(schemas, capabilities.ConfigSchema)
+				isProvision = false
+			}
+		}
+	}
+	if !isDataSource && !isProvision {
+		return schemas, nil
+	}
+
+	info, err := pm.grpcPluginInspector(ctx, pluginName)
+	if err != nil {
+		return nil, err
+	}
+	if capabilities, ok := info.Capabilities[datasource.DataSourcePluginName]; ok && isDataSource {
+		schemas = append(schemas, capabilities.ConfigSchema)
+	}
+	if capabilities, ok := info.Capabilities[provision.ProvisionPluginName]; ok && isProvision {
+		schemas = append(schemas, capabilities.ConfigSchema)
+	}
+	return schemas, nil
+}
+
 // GetPluginConfig returns a `Struct` message containing per-plugin configuration from the config file.
 func (pm *PluginManager) GetPluginConfig(pluginName string) (*structpb.Struct, error) {
 	cfg, err := pm.configLoader.Read()
@@ -422,6 +824,12 @@ func (pm *PluginManager) SetPluginConfig(pluginName string, pluginConfig *struct
 	return pm.configLoader.Write(cfg)
 }
 
+// SetLogOutput sets the destination of gRPC plugin logs, and whether they are formatted as JSON.
+func (pm *PluginManager) SetLogOutput(w io.Writer, json bool) {
+	pm.logOutput = w
+	pm.logJSON = json
+}
+
 // SetLogLevel sets the log level for gRPC plugins.
 func (pm *PluginManager) SetLogLevel(level slog.Level) {
 	pm.logLevel = hclog.LevelFromString(level.String())
@@ -4,19 +4,27 @@
 package manager
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
 	"testing"
 
 	pluginspb "github.com/cofide/cofidectl-sdk/gen/go/proto/plugins/v1alpha1"
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/audit"
 	"github.com/cofide/cofidectl/internal/pkg/config"
 	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
 	"github.com/cofide/cofidectl/internal/pkg/utils"
+	"github.com/cofide/cofidectl/pkg/plugin"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 	"github.com/cofide/cofidectl/pkg/plugin/local"
 	"github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/cofide/cofidectl/pkg/plugin/provision/spirehelm"
+	"github.com/cofide/cofidectl/pkg/plugin/provision/spiremanifests"
 	hclog "github.com/hashicorp/go-hclog"
 	go_plugin "github.com/hashicorp/go-plugin"
 	"github.com/stretchr/testify/assert"
@@ -60,17 +68,58 @@ func (fpl *fakePluginLoader) LoadDataSource(_ context.Context, name string) (dat
 		}
 		return ds, nil
 	}
+	if name == "fake-schema" {
+		ds, err := local.NewLocalDataSource(fpl.configLoader)
+		if err != nil {
+			return nil, err
+		}
+		return &fakeSchemaDataSource{LocalDataSource: ds}, nil
+	}
+	if name == "fake-rejecting" {
+		ds, err := local.NewLocalDataSource(fpl.configLoader)
+		if err != nil {
+			return nil, err
+		}
+		return &fakeRejectingDataSource{fakeSchemaDataSource{LocalDataSource: ds}}, nil
+	}
 	return nil, nil
 }
 
+// fakeSchemaDataSource is a data source plugin that declares a configuration schema.
+type fakeSchemaDataSource struct {
+	*local.LocalDataSource
+}
+
+func (f *fakeSchemaDataSource) ConfigSchema() string {
+	return "endpoint: string"
+}
+
+// fakeRejectingDataSource is a data source plugin that declares a configuration schema, and
+// fails validation.
+type fakeRejectingDataSource struct {
+	fakeSchemaDataSource
+}
+
+func (f *fakeRejectingDataSource) Validate(_ context.Context) error {
+	return errors.New("invalid config")
+}
+
 func (dpl *fakePluginLoader) LoadProvision(_ context.Context, name string) (provision.Provision, error) {
 	if name == "fake-spire-helm" {
 		spireHelm := spirehelm.NewSpireHelm(nil, nil)
 		return spireHelm, nil
 	}
+	if name == "fake-basic" {
+		return &basicProvision{Provision: spirehelm.NewSpireHelm(nil, nil)}, nil
+	}
 	return nil, nil
 }
 
+// basicProvision is a provision plugin that implements no optional interfaces.
+type basicProvision struct {
+	provision.Provision
+}
+
 func TestManager_Init_success(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -124,7 +173,7 @@ func TestManager_Init_success(t *testing.T) {
 			m := NewManager(configLoader, nil)
 			// Mock out the gRPC plugin loader function.
 			m.grpcPluginLoader = func(_ context.Context, _ hclog.Logger, _ string, _ *pluginspb.Plugins) (*grpcPlugin, error) {
-				return &grpcPlugin{nil, newFakeGRPCDataSource(t, configLoader), newFakeGRPCProvision()}, nil
+				return &grpcPlugin{source: newFakeGRPCDataSource(t, configLoader), provision: newFakeGRPCProvision()}, nil
 			}
 
 			err = m.Init(context.Background(), tt.plugins, tt.pluginConfig)
@@ -260,21 +309,21 @@ func TestManager_GetDataSource_success(t *testing.T) {
 					if tt.config.Plugins.GetProvision() == tt.config.Plugins.GetDataSource() {
 						provision = newFakeGRPCProvision()
 					}
-					return &grpcPlugin{client, ds, provision}, nil
+					return &grpcPlugin{client: client, source: ds, provision: provision}, nil
 				}
 			}
 
-			got, err := m.GetDataSource(context.Background())
+			got, err := m.GetDataSourceV2(context.Background())
 			require.Nil(t, err)
 
-			want := tt.want(configLoader)
+			want := datasource.FromV1(tt.want(configLoader))
 			assert.Equal(t, want, got)
 			assert.Equal(t, want, m.source)
 			assert.Same(t, client, m.clients[tt.config.Plugins.GetDataSource()])
 
-			got2, err := m.GetDataSource(context.Background())
+			got2, err := m.GetDataSourceV2(context.Background())
 			require.Nil(t, err)
-			assert.Same(t, got, got2, "second GetDataSource() should return a cached copy")
+			assert.Same(t, got, got2, "second GetDataSourceV2() should return a cached copy")
 
 			if tt.config.Plugins.GetProvision() == tt.config.Plugins.GetDataSource() {
 				got, err := m.GetProvision(context.Background())
@@ -311,17 +360,17 @@ func TestManager_GetDataSource_withPluginLoader(t *testing.T) {
 			m := NewManager(configLoader, pluginLoader)
 
 			m.grpcPluginLoader = nil
-			got, err := m.GetDataSource(context.Background())
+			got, err := m.GetDataSourceV2(context.Background())
 			require.Nil(t, err)
 
-			want := tt.want(configLoader)
+			want := datasource.FromV1(tt.want(configLoader))
 			assert.Equal(t, want, got)
 			assert.Equal(t, want, m.source)
 			assert.Empty(t, m.clients)
 
-			got2, err := m.GetDataSource(context.Background())
+			got2, err := m.GetDataSourceV2(context.Background())
 			require.Nil(t, err)
-			assert.Same(t, got, got2, "second GetDataSource() should return a cached copy")
+			assert.Same(t, got, got2, "second GetDataSourceV2() should return a cached copy")
 		})
 	}
 }
@@ -403,7 +452,7 @@ func TestManager_GetProvision_success(t *testing.T) {
 					if tt.config.Plugins.GetDataSource() == tt.config.Plugins.GetProvision() {
 						source = newFakeGRPCDataSource(t, configLoader)
 					}
-					return &grpcPlugin{client, source, provision}, nil
+					return &grpcPlugin{client: client, source: source, provision: provision}, nil
 				}
 			}
 
@@ -419,15 +468,37 @@ func TestManager_GetProvision_success(t *testing.T) {
 			assert.Same(t, got, got2, "second GetProvision() should return a cached copy")
 
 			if tt.config.Plugins.GetDataSource() == tt.config.Plugins.GetProvision() {
-				got, err := m.GetDataSource(context.Background())
+				got, err := m.GetDataSourceV2(context.Background())
 				require.Nil(t, err, err)
-				assert.Equal(t, source, got)
-				assert.Equal(t, source, m.source)
+				assert.Equal(t, datasource.FromV1(source), got)
+				assert.Equal(t, datasource.FromV1(source), m.source)
 			}
 		})
 	}
 }
 
+func TestManager_GetProvision_spireManifests(t *testing.T) {
+	pluginConfig, err := structpb.NewStruct(map[string]any{"output_dir": "out"})
+	require.NoError(t, err)
+	configLoader, err := config.NewMemoryLoader(&config.Config{
+		Plugins:      &pluginspb.Plugins{DataSource: fixtures.StringPtr(LocalDSPluginName), Provision: fixtures.StringPtr(SpireManifestsProvisionPluginName)},
+		PluginConfig: map[string]*structpb.Struct{SpireManifestsProvisionPluginName: pluginConfig},
+	})
+	require.NoError(t, err)
+	m := NewManager(configLoader, nil)
+
+	got, err := m.GetProvision(context.Background())
+	require.NoError(t, err)
+	manifestsConfig, err := spiremanifests.ParseConfig(pluginConfig)
+	require.NoError(t, err)
+	assert.Equal(t, spiremanifests.NewSpireManifests(manifestsConfig, nil), got)
+	assert.Empty(t, m.clients)
+
+	capabilities, err := m.GetProvisionCapabilities(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, capabilities.ConfigSchema)
+}
+
 func TestManager_GetProvision_withPluginLoader(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -525,7 +596,7 @@ func TestManager_Shutdown(t *testing.T) {
 			// Mock out the gRPC plugin loader function.
 			client := &go_plugin.Client{}
 			m.grpcPluginLoader = func(_ context.Context, _ hclog.Logger, _ string, _ *pluginspb.Plugins) (*grpcPlugin, error) {
-				return &grpcPlugin{client, newFakeGRPCDataSource(t, configLoader), nil}, nil
+				return &grpcPlugin{client: client, source: newFakeGRPCDataSource(t, configLoader)}, nil
 			}
 
 			_, err = m.GetDataSource(context.Background())
@@ -612,10 +683,10 @@ func TestManager_UpdateConfigLoader_beforeFirstLoad(t *testing.T) {
 	m := NewManager(loaderA, nil)
 	m.UpdateConfigLoader(loaderB)
 
-	ds, err := m.GetDataSource(context.Background())
+	ds, err := m.GetDataSourceV2(context.Background())
 	require.Nil(t, err)
 
-	trustZones, err := ds.ListTrustZones()
+	trustZones, err := ds.ListTrustZones(context.Background())
 	require.Nil(t, err)
 	require.Len(t, trustZones, 1)
 	assert.Equal(t, "tz2", trustZones[0].Name)
@@ -643,15 +714,112 @@ func TestManager_UpdateConfigLoader_invalidatesCache(t *testing.T) {
 	// Swap to loaderB — the cached instance must be invalidated.
 	m.UpdateConfigLoader(loaderB)
 
-	ds, err := m.GetDataSource(context.Background())
+	ds, err := m.GetDataSourceV2(context.Background())
 	require.Nil(t, err)
 
-	trustZones, err := ds.ListTrustZones()
+	trustZones, err := ds.ListTrustZones(context.Background())
 	require.Nil(t, err)
 	require.Len(t, trustZones, 1)
 	assert.Equal(t, "tz2", trustZones[0].Name)
 }
 
+func TestManager_FindPlugin(t *testing.T) {
+	t.Setenv(plugin.PluginPathEnvVar, "")
+	configDir := t.TempDir()
+	configLoader, err := config.NewMemoryLoader(&config.Config{
+		Plugins:    GetDefaultPlugins(),
+		PluginPath: []string{configDir},
+	})
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(filepath.Join(configDir, "cofidectl-test"), []byte("binary"), 0755))
+
+	m := NewManager(configLoader, nil)
+	dirs, err := m.GetPluginDirs()
+	require.Nil(t, err)
+	require.Len(t, dirs, 2)
+	assert.Equal(t, plugin.PluginDir{Path: configDir, Source: plugin.PluginDirSourceConfig}, dirs[0])
+	assert.Equal(t, plugin.PluginDirSourceDefault, dirs[1].Source)
+
+	resolved, err := m.FindPlugin("cofidectl-test")
+	require.Nil(t, err)
+	assert.Equal(t, filepath.Join(configDir, "cofidectl-test"), resolved.Path)
+
+	_, err = m.FindPlugin("cofidectl-missing")
+	assert.ErrorIs(t, err, plugin.ErrPluginNotFound)
+}
+
+func TestManager_GetPluginDirs_configFile(t *testing.T) {
+	t.Setenv(plugin.PluginPathEnvVar, "")
+	configDir := t.TempDir()
+	configFile := filepath.Join(configDir, "cofide.yaml")
+	configLoader := config.NewFileLoader(configFile)
+	require.Nil(t, configLoader.Write(&config.Config{
+		Plugins:    GetDefaultPlugins(),
+		PluginPath: []string{"plugins"},
+	}))
+
+	// Relative directories are resolved against the directory of the config file.
+	m := NewManager(configLoader, nil)
+	dirs, err := m.GetPluginDirs()
+	require.Nil(t, err)
+	require.Len(t, dirs, 2)
+	assert.Equal(t, plugin.PluginDir{Path: filepath.Join(configDir, "plugins"), Source: plugin.PluginDirSourceConfig}, dirs[0])
+
+	// An invalid config file is ignored.
+	require.Nil(t, os.WriteFile(configFile, []byte("plugins: 123"), 0600))
+	dirs, err = m.GetPluginDirs()
+	require.Nil(t, err)
+	require.Len(t, dirs, 1)
+	assert.Equal(t, plugin.PluginDirSourceDefault, dirs[0].Source)
+}
+
+func TestManager_GetDataSourceV2_passesContext(t *testing.T) {
+	configLoader, err := config.NewMemoryLoader(&config.Config{Plugins: fixtures.Plugins("plugins1")})
+	require.Nil(t, err)
+
+	m := NewManager(configLoader, nil)
+	conn := &fakeProcessConn{}
+	m.grpcPluginLoader = func(_ context.Context, _ hclog.Logger, _ string, _ *pluginspb.Plugins) (*grpcPlugin, error) {
+		loaded := newFakeProcessPlugin(conn)
+		loaded.client = &go_plugin.Client{}
+		loaded.source = newFakeGRPCDataSource(t, configLoader)
+		return loaded, nil
+	}
+
+	ds, err := m.GetDataSourceV2(context.Background())
+	require.Nil(t, err)
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	_, err = ds.ListTrustZones(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"/proto.cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/ListTrustZones"}, conn.methods)
+	assert.Equal(t, "value", conn.ctx.Value(key{}), "call should use the caller's context")
+}
+
+func TestManager_SetAuditLog(t *testing.T) {
+	configLoader, err := config.NewMemoryLoader(&config.Config{
+		Plugins:    GetDefaultPlugins(),
+		TrustZones: []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1")},
+	})
+	require.Nil(t, err)
+
+	m := NewManager(configLoader, nil)
+	auditLog := audit.NewLog(filepath.Join(t.TempDir(), "audit.log"), []string{"cofidectl"})
+	m.SetAuditLog(auditLog)
+	assert.Same(t, auditLog, m.GetAuditLog())
+
+	ds, err := m.GetDataSourceV2(context.Background())
+	require.Nil(t, err)
+	assert.IsType(t, &audit.DataSource{}, ds)
+
+	require.Nil(t, ds.DestroyTrustZone(context.Background(), "tz1-id"))
+	records, err := audit.Read(auditLog.Path(), nil)
+	require.Nil(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "tz1", records[0].Name)
+}
+
 func TestManager_SetPluginConfig(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -701,3 +869,257 @@ func fakeLocalPluginConfig(t *testing.T) *structpb.Struct {
 	require.Nil(t, err, err)
 	return s
 }
+
+// fakeClientProtocol is a go_plugin.ClientProtocol that dispenses fixed plugins.
+type fakeClientProtocol struct {
+	plugins map[string]any
+}
+
+func (f *fakeClientProtocol) Close() error { return nil }
+func (f *fakeClientProtocol) Ping() error  { return nil }
+func (f *fakeClientProtocol) Dispense(name string) (any, error) {
+	if p, ok := f.plugins[name]; ok {
+		return p, nil
+	}
+	return nil, errors.New("unknown plugin")
+}
+
+// fakeVersionedProvision is a provision plugin that reports the API versions it supports.
+type fakeVersionedProvision struct {
+	spirehelm.SpireHelm
+	apiVersions []string
+}
+
+func (f *fakeVersionedProvision) Capabilities(_ context.Context) (*plugin.Capabilities, error) {
+	return &plugin.Capabilities{APIVersions: f.apiVersions}, nil
+}
+
+func Test_dispensePlugin_apiVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		apiVersions []string
+		wantErr     string
+	}{
+		{
+			name:        "supported",
+			apiVersions: []string{"v1alpha1", provision.APIVersion},
+		},
+		{
+			name:        "unsupported",
+			apiVersions: []string{"v1alpha1"},
+			wantErr:     "plugin my-plugin does not support the provision plugin API version v1alpha2 (supported versions: v1alpha1)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClientProtocol{plugins: map[string]any{
+				provision.ProvisionPluginName: &fakeVersionedProvision{apiVersions: tt.apiVersions},
+			}}
+			_, err := dispensePlugin[provision.Provision](context.Background(), client, provision.ProvisionPluginName, "my-plugin", provision.APIVersion)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, plugin.ErrNotSupported)
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_GetDataSourceCapabilities(t *testing.T) {
+	configLoader, err := config.NewMemoryLoader(&config.Config{Plugins: &pluginspb.Plugins{DataSource: fixtures.StringPtr(LocalDSPluginName)}})
+	require.NoError(t, err)
+	m := NewManager(configLoader, nil)
+
+	capabilities, err := m.GetDataSourceCapabilities(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{datasource.APIVersion}, capabilities.APIVersions)
+	assert.True(t, capabilities.HasFeature(datasource.FeatureTransactions))
+}
+
+func TestManager_GetStatusReporter(t *testing.T) {
+	tests := []struct {
+		name          string
+		provisionName string
+		wantErr       string
+	}{
+		{
+			name:          "supported",
+			provisionName: "fake-spire-helm",
+		},
+		{
+			name:          "not supported",
+			provisionName: "fake-basic",
+			wantErr:       "plugin fake-basic does not support status",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoader, err := config.NewMemoryLoader(&config.Config{
+				Plugins: &pluginspb.Plugins{DataSource: fixtures.StringPtr("fake-local"), Provision: fixtures.StringPtr(tt.provisionName)},
+			})
+			require.NoError(t, err)
+			m := NewManager(configLoader, newFakePluginLoader(configLoader))
+
+			reporter, err := m.GetStatusReporter(context.Background())
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, plugin.ErrNotSupported)
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, reporter)
+			}
+		})
+	}
+}
+
+func TestManager_ValidatePluginConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		pluginName   string
+		pluginConfig map[string]any
+		wantErr      string
+	}{
+		{
+			name:         "valid",
+			pluginName:   "fake-schema",
+			pluginConfig: map[string]any{"endpoint": "https://example.com"},
+		},
+		{
+			name:         "invalid",
+			pluginName:   "fake-schema",
+			pluginConfig: map[string]any{"endpoint": 1},
+			wantErr:      "invalid plugin config for fake-schema: endpoint: conflicting values string and 1 (mismatched types string and int)",
+		},
+		{
+			name:         "missing",
+			pluginName:   "fake-schema",
+			pluginConfig: nil,
+			wantErr:      "invalid plugin config for fake-schema: endpoint: incomplete value string",
+		},
+		{
+			name:         "not a configured plugin",
+			pluginName:   "other",
+			pluginConfig: map[string]any{"endpoint": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoader, err := config.NewMemoryLoader(&config.Config{
+				Plugins: &pluginspb.Plugins{DataSource: fixtures.StringPtr("fake-schema")},
+			})
+			require.NoError(t, err)
+			m := NewManager(configLoader, newFakePluginLoader(configLoader))
+			m.grpcPluginLoader = nil
+
+			var pluginConfig *structpb.Struct
+			if tt.pluginConfig != nil {
+				pluginConfig, err = structpb.NewStruct(tt.pluginConfig)
+				require.NoError(t, err)
+			}
+			err = m.ValidatePluginConfig(context.Background(), tt.pluginName, pluginConfig)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.pluginName != "fake-schema" {
+				return
+			}
+			if pluginConfig != nil {
+				require.NoError(t, m.SetPluginConfig(tt.pluginName, pluginConfig))
+			}
+			err = m.ValidatePluginConfigs(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_Init_invalidPluginConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		plugins      *pluginspb.Plugins
+		pluginConfig map[string]any
+		wantErr      string
+	}{
+		{
+			name:         "in-process",
+			plugins:      &pluginspb.Plugins{DataSource: fixtures.StringPtr(LocalDSPluginName), Provision: fixtures.StringPtr(SpireManifestsProvisionPluginName)},
+			pluginConfig: map[string]any{SpireManifestsProvisionPluginName: map[string]any{"wrapper": "other"}},
+			wantErr:      "invalid plugin config for spire-manifests",
+		},
+		{
+			name:         "gRPC",
+			plugins:      fixtures.Plugins("plugins1"),
+			pluginConfig: map[string]any{"fake-datasource": map[string]any{"endpoint": 1}},
+			wantErr:      "invalid plugin config for fake-datasource: endpoint: conflicting values string and 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoader, err := config.NewMemoryLoader(nil)
+			require.NoError(t, err)
+			m := NewManager(configLoader, nil)
+			m.grpcPluginLoader = func(_ context.Context, _ hclog.Logger, _ string, _ *pluginspb.Plugins) (*grpcPlugin, error) {
+				return nil, errors.New("gRPC plugins should not be loaded")
+			}
+			m.grpcPluginInspector = func(_ context.Context, _ string) (*PluginInfo, error) {
+				return &PluginInfo{Capabilities: map[string]*plugin.Capabilities{
+					datasource.DataSourcePluginName: {ConfigSchema: "endpoint?: string"},
+				}}, nil
+			}
+
+			pluginConfig := map[string]*structpb.Struct{}
+			for name, value := range tt.pluginConfig {
+				pluginConfig[name], err = structpb.NewStruct(value.(map[string]any))
+				require.NoError(t, err)
+			}
+			err = m.Init(context.Background(), tt.plugins, pluginConfig)
+			assert.ErrorContains(t, err, tt.wantErr)
+
+			exists, err := configLoader.Exists()
+			require.NoError(t, err)
+			assert.False(t, exists, "config should not be written")
+		})
+	}
+}
+
+func TestManager_ValidatePluginConfig_pluginRejectsConfig(t *testing.T) {
+	configLoader, err := config.NewMemoryLoader(&config.Config{
+		Plugins: &pluginspb.Plugins{DataSource: fixtures.StringPtr("fake-rejecting")},
+	})
+	require.NoError(t, err)
+	m := NewManager(configLoader, newFakePluginLoader(configLoader))
+	m.grpcPluginLoader = nil
+
+	// The schema is checked although the plugin rejects its current config.
+	pluginConfig, err := structpb.NewStruct(map[string]any{"endpoint": 1})
+	require.NoError(t, err)
+	err = m.ValidatePluginConfig(context.Background(), "fake-rejecting", pluginConfig)
+	assert.ErrorContains(t, err, "invalid plugin config for fake-rejecting: endpoint")
+
+	pluginConfig, err = structpb.NewStruct(map[string]any{"endpoint": "https://example.com"})
+	require.NoError(t, err)
+	assert.NoError(t, m.ValidatePluginConfig(context.Background(), "fake-rejecting", pluginConfig))
+}
+
+func TestManager_newPluginLogger(t *testing.T) {
+	configLoader, err := config.NewMemoryLoader(&config.Config{})
+	require.NoError(t, err)
+	m := NewManager(configLoader, nil)
+	m.SetLogLevel(slog.LevelDebug)
+
+	var buf bytes.Buffer
+	m.SetLogOutput(&buf, true)
+	m.newPluginLogger("my-plugin").Debug("Test message")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "Test message", record["@message"])
+	assert.Equal(t, "my-plugin", record["plugin"])
+}
@@ -0,0 +1,372 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxPluginRestarts is the maximum number of times that a crashed gRPC plugin is relaunched.
+	maxPluginRestarts = 3
+	// crashGracePeriod is how long to wait for a plugin process to exit after a call fails with
+	// an Unavailable status, before assuming that the plugin has not crashed.
+	crashGracePeriod = 2 * time.Second
+	// stderrTailLines is the number of lines of plugin stderr output included in crash errors.
+	stderrTailLines = 20
+	// maxStderrLineLength is the maximum length of a line of plugin stderr output that is retained.
+	maxStderrLineLength = 4096
+)
+
+// ErrPluginCrashed is wrapped by errors returned when a gRPC plugin process exits during a call.
+var ErrPluginCrashed = errors.New("plugin crashed")
+
+// CrashedError is returned when a gRPC plugin process exits during a call that cannot safely be
+// retried. It wraps ErrPluginCrashed and the error returned by the call.
+type CrashedError struct {
+	// Plugin is the name of the plugin.
+	Plugin string
+	// Stderr is the tail of the plugin's stderr output.
+	Stderr string
+	// Err is the error returned by the call.
+	Err error
+}
+
+func (e *CrashedError) Error() string {
+	msg := fmt.Sprintf("plugin %s crashed: %v", e.Plugin, e.Err)
+	if e.Stderr != "" {
+		msg += "\nplugin stderr:\n" + e.Stderr
+	}
+	return msg
+}
+
+func (e *CrashedError) Unwrap() []error {
+	return []error{ErrPluginCrashed, e.Err}
+}
+
+// pluginProcess tracks the process of a gRPC plugin, relaunching it with the same configuration
+// if it exits. It implements grpc.ClientConnInterface, routing calls to the current process, so
+// that clients created from it survive a restart. Calls that only read data are retried if the
+// plugin crashes during the call. Other calls fail with a CrashedError, and the plugin is
+// relaunched on the next call.
+type pluginProcess struct {
+	name string
+	// launch starts a new plugin process.
+	launch func(ctx context.Context) (*grpcPlugin, error)
+	// onRestart is called with the new plugin after a restart.
+	onRestart func(*grpcPlugin)
+
+	mu       sync.Mutex
+	current  *grpcPlugin
+	restarts int
+}
+
+var _ grpc.ClientConnInterface = (*pluginProcess)(nil)
+
+func newPluginProcess(name string, current *grpcPlugin, launch func(ctx context.Context) (*grpcPlugin, error), onRestart func(*grpcPlugin)) *pluginProcess {
+	return &pluginProcess{name: name, current: current, launch: launch, onRestart: onRestart}
+}
+
+// get returns the current plugin, relaunching it first if its process has exited.
+func (p *pluginProcess) get(ctx context.Context) (*grpcPlugin, error) {
+	p.mu.Lock()
+	current := p.current
+	p.mu.Unlock()
+	if !current.hasExited() {
+		return current, nil
+	}
+	return p.restart(ctx, current)
+}
+
+// restart relaunches the plugin if crashed is still the current plugin, returning the new
+// current plugin.
+func (p *pluginProcess) restart(ctx context.Context, crashed *grpcPlugin) (*grpcPlugin, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.current != crashed {
+		// Another call has already restarted the plugin.
+		return p.current, nil
+	}
+	if p.restarts >= maxPluginRestarts {
+		return nil, fmt.Errorf("plugin %s has crashed and has already been restarted %d times", p.name, p.restarts)
+	}
+
+	slog.Warn("Restarting crashed plugin", "plugin", p.name)
+	if crashed.client != nil {
+		// Release any resources held by the client of the exited process.
+		crashed.client.Kill()
+	}
+	restarted, err := p.launch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restart crashed plugin %s: %w", p.name, err)
+	}
+	p.restarts++
+	p.current = restarted
+	if p.onRestart != nil {
+		p.onRestart(restarted)
+	}
+	return restarted, nil
+}
+
+// checkCrash returns a CrashedError if the process of a plugin has exited after a call returned
+// err. If wait is true, or the call failed with an Unavailable status, it waits briefly for the
+// process to exit, since the connection may be closed before the exit is observed.
+func (p *pluginProcess) checkCrash(gp *grpcPlugin, err error, wait bool) *CrashedError {
+	if gp.exited == nil {
+		return nil
+	}
+	if wait || status.Code(err) == codes.Unavailable {
+		deadline := time.Now().Add(crashGracePeriod)
+		for !gp.exited() && time.Now().Before(deadline) {
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+	if !gp.exited() {
+		return nil
+	}
+	crashed := &CrashedError{Plugin: p.name, Err: err}
+	if gp.stderr != nil {
+		crashed.Stderr = gp.stderr.String()
+	}
+	return crashed
+}
+
+// call calls fn with the current plugin. If the plugin crashes during the call, it is relaunched
+// and the call is retried if retryable is true. Otherwise a CrashedError is returned.
+func (p *pluginProcess) call(ctx context.Context, retryable bool, fn func(*grpcPlugin) error) error {
+	current, err := p.get(ctx)
+	if err != nil {
+		return err
+	}
+	err = fn(current)
+	if err == nil {
+		return nil
+	}
+	crashed := p.checkCrash(current, err, false)
+	if crashed == nil {
+		return err
+	}
+	if !retryable {
+		return crashed
+	}
+
+	restarted, restartErr := p.restart(ctx, current)
+	if restartErr != nil {
+		return errors.Join(crashed, restartErr)
+	}
+	slog.Debug("Retrying plugin call after restart", "plugin", p.name)
+	return fn(restarted)
+}
+
+// Invoke implements grpc.ClientConnInterface. Calls to methods that the plugin does not implement,
+// such as those added after the plugin was built, fail with a plugin.UnsupportedError.
+func (p *pluginProcess) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	err := p.call(ctx, isRetryableMethod(ctx, method), func(gp *grpcPlugin) error {
+		return gp.conn.Invoke(ctx, method, args, reply, opts...)
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return &plugin.UnsupportedError{Plugin: p.name, Feature: methodName(method), Err: err}
+	}
+	return err
+}
+
+// NewStream implements grpc.ClientConnInterface. Streams are not retried.
+func (p *pluginProcess) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	current, err := p.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return current.conn.NewStream(ctx, desc, method, opts...)
+}
+
+// isRetryableMethod returns whether a gRPC method only reads data, and may therefore be retried
+// in a relaunched plugin. Calls within a transaction are not retried, since the transaction does
+// not exist in the relaunched plugin.
+func isRetryableMethod(ctx context.Context, method string) bool {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get(datasource.TransactionIDMetadataKey)) > 0 {
+		return false
+	}
+	name := methodName(method)
+	return name == "Validate" || strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
+
+// methodName returns the name of a gRPC method without its service name.
+func methodName(method string) string {
+	return method[strings.LastIndex(method, "/")+1:]
+}
+
+// restartingProvision is a provision.Provision that calls the provision plugin of the current
+// process of a gRPC plugin. Deploy and TearDown are not retried if the plugin crashes.
+type restartingProvision struct {
+	process *pluginProcess
+}
+
+var _ provision.Provision = (*restartingProvision)(nil)
+var _ plugin.CapabilityReporter = (*restartingProvision)(nil)
+var _ provision.StatusReporter = (*restartingProvision)(nil)
+
+func (r *restartingProvision) Validate(ctx context.Context) error {
+	return r.process.call(ctx, true, func(gp *grpcPlugin) error {
+		return gp.provision.Validate(ctx)
+	})
+}
+
+func (r *restartingProvision) Deploy(ctx context.Context, ds datasource.DataSource, opts *provision.DeployOpts) (<-chan *provisionpb.Status, error) {
+	var statusCh <-chan *provisionpb.Status
+	var current *grpcPlugin
+	err := r.process.call(ctx, false, func(gp *grpcPlugin) error {
+		var err error
+		current = gp
+		statusCh, err = gp.provision.Deploy(ctx, ds, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.watchStatus(current, statusCh), nil
+}
+
+func (r *restartingProvision) TearDown(ctx context.Context, ds datasource.DataSource, opts *provision.TearDownOpts) (<-chan *provisionpb.Status, error) {
+	var statusCh <-chan *provisionpb.Status
+	var current *grpcPlugin
+	err := r.process.call(ctx, false, func(gp *grpcPlugin) error {
+		var err error
+		current = gp
+		statusCh, err = gp.provision.TearDown(ctx, ds, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.watchStatus(current, statusCh), nil
+}
+
+func (r *restartingProvision) GetHelmValues(ctx context.Context, ds datasource.DataSource, opts *provision.GetHelmValuesOpts) (map[string]any, error) {
+	var values map[string]any
+	err := r.process.call(ctx, true, func(gp *grpcPlugin) error {
+		var err error
+		values, err = gp.provision.GetHelmValues(ctx, ds, opts)
+		return err
+	})
+	return values, err
+}
+
+// IsDeployed implements provision.StatusReporter.
+func (r *restartingProvision) IsDeployed(ctx context.Context, ds datasource.DataSource, opts *provision.IsDeployedOpts) (bool, error) {
+	var deployed bool
+	err := r.process.call(ctx, true, func(gp *grpcPlugin) error {
+		reporter, ok := gp.provision.(provision.StatusReporter)
+		if !ok {
+			return provision.ErrStatusNotSupported
+		}
+		var err error
+		deployed, err = reporter.IsDeployed(ctx, ds, opts)
+		return err
+	})
+	return deployed, err
+}
+
+// GetStatus implements provision.StatusReporter.
+func (r *restartingProvision) GetStatus(ctx context.Context, ds datasource.DataSource, opts *provision.GetStatusOpts) (*provision.ClusterStatus, error) {
+	var clusterStatus *provision.ClusterStatus
+	err := r.process.call(ctx, true, func(gp *grpcPlugin) error {
+		reporter, ok := gp.provision.(provision.StatusReporter)
+		if !ok {
+			return provision.ErrStatusNotSupported
+		}
+		var err error
+		clusterStatus, err = reporter.GetStatus(ctx, ds, opts)
+		return err
+	})
+	return clusterStatus, err
+}
+
+// Capabilities implements plugin.CapabilityReporter.
+func (r *restartingProvision) Capabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	var capabilities *plugin.Capabilities
+	err := r.process.call(ctx, true, func(gp *grpcPlugin) error {
+		var err error
+		capabilities, err = provision.GetCapabilities(ctx, gp.provision)
+		return err
+	})
+	return capabilities, err
+}
+
+// watchStatus forwards the Status messages of a Deploy or TearDown stream. If the stream ends
+// with an error because the plugin crashed, the error is replaced with a CrashedError.
+func (r *restartingProvision) watchStatus(gp *grpcPlugin, statusCh <-chan *provisionpb.Status) <-chan *provisionpb.Status {
+	watchedCh := make(chan *provisionpb.Status)
+	go func() {
+		defer close(watchedCh)
+		for st := range statusCh {
+			if st.GetError() != "" {
+				if crashed := r.process.checkCrash(gp, errors.New(st.GetError()), true); crashed != nil {
+					st = provision.StatusError(st.GetStage(), st.GetMessage(), crashed)
+				}
+			}
+			watchedCh <- st
+		}
+	}()
+	return watchedCh
+}
+
+// tailWriter is an io.Writer that retains the last lines written to it.
+type tailWriter struct {
+	mu       sync.Mutex
+	maxLines int
+	lines    []string
+	partial  []byte
+}
+
+func newTailWriter(maxLines int) *tailWriter {
+	return &tailWriter{maxLines: maxLines}
+}
+
+func (w *tailWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, c := range b {
+		if c == '\n' {
+			w.addLine(string(w.partial))
+			w.partial = w.partial[:0]
+		} else if len(w.partial) < maxStderrLineLength {
+			w.partial = append(w.partial, c)
+		}
+	}
+	return len(b), nil
+}
+
+func (w *tailWriter) addLine(line string) {
+	w.lines = append(w.lines, line)
+	if len(w.lines) > w.maxLines {
+		w.lines = w.lines[len(w.lines)-w.maxLines:]
+	}
+}
+
+// String returns the retained lines.
+func (w *tailWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	lines := w.lines
+	if len(w.partial) > 0 {
+		lines = append(lines[:len(lines):len(lines)], string(w.partial))
+	}
+	return strings.Join(lines, "\n")
+}
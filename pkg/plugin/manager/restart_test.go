@@ -0,0 +1,187 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeProcessConn is a connection to a fake plugin process, which crashes on the first call if
+// crash is true, and otherwise returns err.
+type fakeProcessConn struct {
+	crash   bool
+	err     error
+	exited  bool
+	methods []string
+	// ctx is the context of the last call.
+	ctx context.Context
+}
+
+func (c *fakeProcessConn) Invoke(ctx context.Context, method string, _ any, _ any, _ ...grpc.CallOption) error {
+	c.methods = append(c.methods, method)
+	c.ctx = ctx
+	if c.crash {
+		c.exited = true
+		return status.Error(codes.Unavailable, "error reading from server: EOF")
+	}
+	return c.err
+}
+
+func (c *fakeProcessConn) NewStream(_ context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newFakeProcessPlugin(conn *fakeProcessConn) *grpcPlugin {
+	stderr := newTailWriter(stderrTailLines)
+	_, _ = fmt.Fprintln(stderr, "panic: oops")
+	return &grpcPlugin{conn: conn, exited: func() bool { return conn.exited }, stderr: stderr}
+}
+
+// newFakeProcess returns a pluginProcess whose first process crashes, and records the processes
+// that it launches.
+func newFakeProcess(crashing *fakeProcessConn, launched *[]*fakeProcessConn) *pluginProcess {
+	launch := func(_ context.Context) (*grpcPlugin, error) {
+		conn := &fakeProcessConn{}
+		*launched = append(*launched, conn)
+		return newFakeProcessPlugin(conn), nil
+	}
+	return newPluginProcess("my-plugin", newFakeProcessPlugin(crashing), launch, nil)
+}
+
+func Test_pluginProcess_retry(t *testing.T) {
+	crashing := &fakeProcessConn{crash: true}
+	var launched []*fakeProcessConn
+	process := newFakeProcess(crashing, &launched)
+
+	err := process.Invoke(context.Background(), "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/ListTrustZones", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, launched, 1)
+	assert.Equal(t, []string{"/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/ListTrustZones"}, launched[0].methods)
+	assert.Equal(t, 1, process.restarts)
+}
+
+func Test_pluginProcess_crash(t *testing.T) {
+	crashing := &fakeProcessConn{crash: true}
+	var launched []*fakeProcessConn
+	process := newFakeProcess(crashing, &launched)
+
+	err := process.Invoke(context.Background(), "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/AddTrustZone", nil, nil)
+	require.ErrorIs(t, err, ErrPluginCrashed)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.EqualError(t, err, "plugin my-plugin crashed: rpc error: code = Unavailable desc = error reading from server: EOF\nplugin stderr:\npanic: oops")
+	assert.Empty(t, launched, "non-retryable call should not be retried")
+
+	// The plugin is relaunched on the next call.
+	err = process.Invoke(context.Background(), "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/AddCluster", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, launched, 1)
+	assert.Equal(t, []string{"/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/AddCluster"}, launched[0].methods)
+}
+
+func Test_pluginProcess_unimplemented(t *testing.T) {
+	unimplemented := status.Error(codes.Unimplemented, "unknown method UpdateAttestationPolicy")
+	process := newPluginProcess("my-plugin", newFakeProcessPlugin(&fakeProcessConn{err: unimplemented}), nil, nil)
+
+	err := process.Invoke(context.Background(), "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/UpdateAttestationPolicy", nil, nil)
+	require.ErrorIs(t, err, plugin.ErrNotSupported)
+	assert.EqualError(t, err, "plugin my-plugin does not support UpdateAttestationPolicy")
+	// Clients that handle an Unimplemented status themselves still see it.
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func Test_pluginProcess_noCrash(t *testing.T) {
+	conn := &fakeProcessConn{}
+	restarts := 0
+	process := newPluginProcess("my-plugin", newFakeProcessPlugin(conn), nil, func(*grpcPlugin) { restarts++ })
+
+	wantErr := status.Error(codes.NotFound, "not found")
+	err := process.call(context.Background(), true, func(*grpcPlugin) error { return wantErr })
+	assert.Equal(t, wantErr, err)
+	assert.Equal(t, 0, restarts)
+}
+
+func Test_pluginProcess_maxRestarts(t *testing.T) {
+	launch := func(_ context.Context) (*grpcPlugin, error) {
+		return newFakeProcessPlugin(&fakeProcessConn{crash: true}), nil
+	}
+	restarts := 0
+	process := newPluginProcess("my-plugin", newFakeProcessPlugin(&fakeProcessConn{crash: true}), launch, func(*grpcPlugin) { restarts++ })
+
+	for range maxPluginRestarts + 1 {
+		err := process.Invoke(context.Background(), "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/DestroyCluster", nil, nil)
+		require.ErrorIs(t, err, ErrPluginCrashed)
+	}
+	err := process.Invoke(context.Background(), "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/DestroyCluster", nil, nil)
+	assert.EqualError(t, err, "plugin my-plugin has crashed and has already been restarted 3 times")
+	assert.Equal(t, maxPluginRestarts, restarts)
+}
+
+func Test_isRetryableMethod(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   bool
+	}{
+		{name: "validate", ctx: context.Background(), method: "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/Validate", want: true},
+		{name: "get", ctx: context.Background(), method: "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/GetTrustZone", want: true},
+		{name: "list", ctx: context.Background(), method: "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/ListClusters", want: true},
+		{name: "capabilities", ctx: context.Background(), method: "/cofidectl.datasource_plugin.v1alpha2.DataSourceCapabilitiesService/GetCapabilities", want: true},
+		{name: "add", ctx: context.Background(), method: "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/AddTrustZone", want: false},
+		{name: "begin", ctx: context.Background(), method: "/cofidectl.datasource_plugin.v1alpha2.DataSourceTransactionService/Begin", want: false},
+		{
+			name:   "transaction",
+			ctx:    metadata.AppendToOutgoingContext(context.Background(), datasource.TransactionIDMetadataKey, "tx"),
+			method: "/cofidectl.datasource_plugin.v1alpha2.DataSourcePluginService/ListClusters",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryableMethod(tt.ctx, tt.method))
+		})
+	}
+}
+
+func Test_restartingProvision_watchStatus(t *testing.T) {
+	conn := &fakeProcessConn{}
+	gp := newFakeProcessPlugin(conn)
+	r := &restartingProvision{process: newPluginProcess("my-plugin", gp, nil, nil)}
+
+	statusCh := make(chan *provisionpb.Status, 2)
+	statusCh <- provision.StatusOk("Deploying", "Installing")
+	statusCh <- provision.StatusError("Deploying", "Error", errors.New("connection lost"))
+	close(statusCh)
+	conn.exited = true
+
+	var statuses []*provisionpb.Status
+	for st := range r.watchStatus(gp, statusCh) {
+		statuses = append(statuses, st)
+	}
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "Installing", statuses[0].GetMessage())
+	assert.Equal(t, "plugin my-plugin crashed: connection lost\nplugin stderr:\npanic: oops", statuses[1].GetError())
+}
+
+func Test_tailWriter(t *testing.T) {
+	w := newTailWriter(2)
+	_, _ = w.Write([]byte("one\ntwo\nthr"))
+	assert.Equal(t, "one\ntwo\nthr", w.String())
+	_, _ = w.Write([]byte("ee\n"))
+	assert.Equal(t, "two\nthree", w.String())
+}
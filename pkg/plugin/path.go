@@ -0,0 +1,116 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// PluginPathEnvVar is a list of directories to search for plugins, separated by the OS path
+	// list separator.
+	PluginPathEnvVar = "COFIDECTL_PLUGIN_PATH"
+)
+
+// Sources of plugin directories in the plugin search path.
+const (
+	PluginDirSourceEnv     = PluginPathEnvVar
+	PluginDirSourceConfig  = "plugins.path"
+	PluginDirSourceDefault = "default"
+)
+
+// ErrPluginNotFound is returned when a plugin is not found in any plugin directory.
+var ErrPluginNotFound = errors.New("plugin not found")
+
+// PluginDir is a directory in the plugin search path.
+type PluginDir struct {
+	Path string
+	// Source is the setting that the directory came from, one of the PluginDirSource* constants.
+	Source string
+}
+
+// ResolvedPlugin is a plugin found in the plugin search path.
+type ResolvedPlugin struct {
+	Name string
+	Path string
+	Dir  PluginDir
+}
+
+// GetPluginDirs returns the plugin search path, in order of precedence: the directories in
+// COFIDECTL_PLUGIN_PATH, the directories in configPath (the plugins.path setting), and finally
+// the default plugin directory, ~/.cofide/plugins. Relative directories in configPath are
+// resolved against configDir, the directory containing the config file, so that they do not
+// depend on where cofidectl is run from. Other relative directories, and those in configPath if
+// configDir is empty, are resolved against the working directory. Duplicates are removed.
+func GetPluginDirs(configPath []string, configDir string) ([]PluginDir, error) {
+	dirs := []PluginDir{}
+	seen := map[string]bool{}
+	add := func(path, source string) error {
+		if path == "" {
+			return nil
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("invalid plugin directory %s from %s: %w", path, source, err)
+		}
+		if !seen[absPath] {
+			seen[absPath] = true
+			dirs = append(dirs, PluginDir{Path: absPath, Source: source})
+		}
+		return nil
+	}
+
+	for _, path := range filepath.SplitList(os.Getenv(PluginPathEnvVar)) {
+		if err := add(path, PluginDirSourceEnv); err != nil {
+			return nil, err
+		}
+	}
+	for _, path := range configPath {
+		if path != "" && configDir != "" && !filepath.IsAbs(path) {
+			path = filepath.Join(configDir, path)
+		}
+		if err := add(path, PluginDirSourceConfig); err != nil {
+			return nil, err
+		}
+	}
+	defaultDir, err := GetPluginDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := add(defaultDir, PluginDirSourceDefault); err != nil {
+		return nil, err
+	}
+	return dirs, nil
+}
+
+// FindPlugin returns the first plugin with the specified name in the plugin directories.
+// It returns an error wrapping ErrPluginNotFound if none of the directories contain the plugin.
+func FindPlugin(dirs []PluginDir, name string) (*ResolvedPlugin, error) {
+	if err := validatePluginName(name); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPluginNotFound, err)
+	}
+	for _, dir := range dirs {
+		path := filepath.Join(dir.Path, name)
+		info, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+		return &ResolvedPlugin{Name: name, Path: path, Dir: dir}, nil
+	}
+
+	searched := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		searched = append(searched, dir.Path)
+	}
+	return nil, fmt.Errorf("%w: %s (searched %s)", ErrPluginNotFound, name, strings.Join(searched, ", "))
+}
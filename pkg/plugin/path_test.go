@@ -0,0 +1,75 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPluginDirs(t *testing.T) {
+	envDir1 := t.TempDir()
+	envDir2 := t.TempDir()
+	configDir := t.TempDir()
+	t.Setenv(PluginPathEnvVar, envDir1+string(filepath.ListSeparator)+envDir2)
+
+	defaultDir, err := GetPluginDir()
+	require.NoError(t, err)
+
+	dirs, err := GetPluginDirs([]string{configDir, envDir1}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []PluginDir{
+		{Path: envDir1, Source: PluginDirSourceEnv},
+		{Path: envDir2, Source: PluginDirSourceEnv},
+		{Path: configDir, Source: PluginDirSourceConfig},
+		{Path: defaultDir, Source: PluginDirSourceDefault},
+	}, dirs)
+}
+
+func TestGetPluginDirs_relative(t *testing.T) {
+	t.Setenv(PluginPathEnvVar, "")
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	dirs, err := GetPluginDirs([]string{"plugins"}, "")
+	require.NoError(t, err)
+	require.Len(t, dirs, 2)
+	assert.Equal(t, PluginDir{Path: filepath.Join(dir, "plugins"), Source: PluginDirSourceConfig}, dirs[0])
+
+	// Relative directories are resolved against the directory of the config file.
+	configDir := t.TempDir()
+	absDir := t.TempDir()
+	dirs, err = GetPluginDirs([]string{"plugins", absDir}, configDir)
+	require.NoError(t, err)
+	require.Len(t, dirs, 3)
+	assert.Equal(t, PluginDir{Path: filepath.Join(configDir, "plugins"), Source: PluginDirSourceConfig}, dirs[0])
+	assert.Equal(t, PluginDir{Path: absDir, Source: PluginDirSourceConfig}, dirs[1])
+}
+
+func TestFindPlugin(t *testing.T) {
+	dir1 := PluginDir{Path: t.TempDir(), Source: PluginDirSourceEnv}
+	dir2 := PluginDir{Path: t.TempDir(), Source: PluginDirSourceConfig}
+	writeTestPlugin(t, dir1.Path, "plugin1", "binary")
+	writeTestPlugin(t, dir2.Path, "plugin1", "shadowed")
+	writeTestPlugin(t, dir2.Path, "plugin2", "binary")
+	dirs := []PluginDir{dir1, dir2}
+
+	resolved, err := FindPlugin(dirs, "plugin1")
+	require.NoError(t, err)
+	assert.Equal(t, &ResolvedPlugin{Name: "plugin1", Path: filepath.Join(dir1.Path, "plugin1"), Dir: dir1}, resolved)
+
+	resolved, err = FindPlugin(dirs, "plugin2")
+	require.NoError(t, err)
+	assert.Equal(t, dir2, resolved.Dir)
+
+	_, err = FindPlugin(dirs, "plugin3")
+	assert.ErrorIs(t, err, ErrPluginNotFound)
+	assert.ErrorContains(t, err, dir2.Path)
+
+	_, err = FindPlugin(dirs, "../plugin1")
+	assert.ErrorIs(t, err, ErrPluginNotFound)
+}
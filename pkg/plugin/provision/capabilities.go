@@ -0,0 +1,74 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package provision
+
+import (
+	"context"
+	"fmt"
+
+	provpb "github.com/cofide/cofidectl/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIVersion is the version of the provision plugin API implemented by this package.
+const APIVersion = "v1alpha2"
+
+// GetCapabilities returns the capabilities of a provision implementation. If impl implements
+// plugin.CapabilityReporter, it is asked for its capabilities, otherwise they are inferred from
+// the optional interfaces that it implements.
+func GetCapabilities(ctx context.Context, impl any) (*plugin.Capabilities, error) {
+	if reporter, ok := impl.(plugin.CapabilityReporter); ok {
+		return reporter.Capabilities(ctx)
+	}
+
+	capabilities := baselineCapabilities()
+	if _, ok := impl.(StatusReporter); ok {
+		capabilities.Features = append(capabilities.Features, FeatureStatus)
+	}
+	if provider, ok := impl.(plugin.ConfigSchemaProvider); ok {
+		capabilities.ConfigSchema = provider.ConfigSchema()
+	}
+	return capabilities, nil
+}
+
+// baselineCapabilities returns the capabilities of a provision plugin that supports the current
+// API version and no optional features.
+func baselineCapabilities() *plugin.Capabilities {
+	return &plugin.Capabilities{APIVersions: []string{APIVersion}, Features: []string{}}
+}
+
+// GetCapabilitiesGRPC returns the capabilities of a provision plugin over a gRPC connection.
+// Plugins that do not implement the capabilities service are assumed to support the current API
+// version and no optional features.
+func GetCapabilitiesGRPC(ctx context.Context, conn grpc.ClientConnInterface) (*plugin.Capabilities, error) {
+	resp, err := provpb.NewProvisionCapabilitiesServiceClient(conn).GetCapabilities(ctx, &provpb.GetCapabilitiesRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return baselineCapabilities(), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get plugin capabilities: %w", err)
+	}
+	return plugin.CapabilitiesFromProto(resp.GetCapabilities()), nil
+}
+
+var _ provpb.ProvisionCapabilitiesServiceServer = &GRPCServer{}
+
+// GetCapabilities implements the ProvisionCapabilitiesService.
+func (s *GRPCServer) GetCapabilities(ctx context.Context, _ *provpb.GetCapabilitiesRequest) (*provpb.GetCapabilitiesResponse, error) {
+	capabilities, err := GetCapabilities(ctx, s.impl)
+	if err != nil {
+		return nil, err
+	}
+	return &provpb.GetCapabilitiesResponse{Capabilities: capabilities.ToProto()}, nil
+}
+
+// Capabilities implements plugin.CapabilityReporter.
+func (c *ProvisionPluginClientGRPC) Capabilities(ctx context.Context) (*plugin.Capabilities, error) {
+	if c.conn == nil {
+		return baselineCapabilities(), nil
+	}
+	return GetCapabilitiesGRPC(ctx, c.conn)
+}
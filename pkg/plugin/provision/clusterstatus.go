@@ -0,0 +1,103 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package provision
+
+import (
+	"context"
+	"time"
+
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+)
+
+// FeatureStatus indicates that a provision plugin can report the deployment status of clusters.
+const FeatureStatus = "status"
+
+// ErrStatusNotSupported is returned when a provision plugin does not support status queries.
+// It wraps plugin.ErrNotSupported.
+var ErrStatusNotSupported error = statusNotSupportedError{}
+
+type statusNotSupportedError struct{}
+
+func (statusNotSupportedError) Error() string {
+	return "provision plugin does not support status"
+}
+
+func (statusNotSupportedError) Unwrap() error {
+	return plugin.ErrNotSupported
+}
+
+// StatusReporter is an optional interface that provision plugins may implement to report the
+// deployment status of clusters.
+type StatusReporter interface {
+	// IsDeployed returns whether the workload identity configuration has been deployed to a
+	// cluster.
+	IsDeployed(ctx context.Context, ds datasource.DataSource, opts *IsDeployedOpts) (bool, error)
+
+	// GetStatus returns the status of the workload identity configuration deployed to a cluster.
+	// If it has not been deployed, the returned ClusterStatus has Deployed set to false.
+	GetStatus(ctx context.Context, ds datasource.DataSource, opts *GetStatusOpts) (*ClusterStatus, error)
+}
+
+type IsDeployedOpts struct {
+	KubeCfgFile string
+	ClusterID   string
+}
+
+type GetStatusOpts struct {
+	KubeCfgFile string
+	ClusterID   string
+}
+
+// ClusterStatus describes the status of the workload identity configuration deployed to a
+// cluster.
+type ClusterStatus struct {
+	Deployed bool          `json:"deployed"`
+	Server   *ServerStatus `json:"server,omitempty"`
+	Agents   *AgentStatus  `json:"agents,omitempty"`
+	Bundles  *BundleStatus `json:"bundles,omitempty"`
+}
+
+// ServerStatus describes the status of the SPIRE servers in a cluster.
+type ServerStatus struct {
+	Replicas           int               `json:"replicas"`
+	ReadyReplicas      int               `json:"ready_replicas"`
+	Containers         []ContainerStatus `json:"containers"`
+	ControllerManagers []ContainerStatus `json:"controller_managers"`
+}
+
+// ContainerStatus describes the status of a container in a pod.
+type ContainerStatus struct {
+	Pod   string `json:"pod"`
+	Ready bool   `json:"ready"`
+}
+
+// AgentStatus describes the status of the SPIRE agents attested to the SPIRE servers in a
+// cluster.
+type AgentStatus struct {
+	Expected int     `json:"expected"`
+	Ready    int     `json:"ready"`
+	Agents   []Agent `json:"agents"`
+}
+
+// Agent describes the status of a SPIRE agent.
+type Agent struct {
+	Pod             string    `json:"pod"`
+	Status          string    `json:"status"`
+	ID              string    `json:"id"`
+	AttestationType string    `json:"attestation_type"`
+	ExpirationTime  time.Time `json:"expiration_time"`
+	Serial          string    `json:"serial"`
+	CanReattest     bool      `json:"can_reattest"`
+}
+
+// BundleStatus describes the trust bundles held by the SPIRE servers in a cluster.
+type BundleStatus struct {
+	// ServerCABundle describes the X.509 authorities of the local trust domain's bundle. It may
+	// be compared with the FederatedBundles of other clusters to check federation health.
+	ServerCABundle string `json:"server_ca_bundle"`
+	// FederatedBundles maps federated trust domain names to the X.509 authorities of their
+	// bundles, described in the same form as ServerCABundle.
+	FederatedBundles map[string]string `json:"federated_bundles"`
+}
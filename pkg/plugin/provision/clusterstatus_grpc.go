@@ -0,0 +1,219 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package provision
+
+import (
+	"context"
+
+	provpb "github.com/cofide/cofidectl/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Type check to ensure that ProvisionPluginClientGRPC implements the StatusReporter interface.
+var _ StatusReporter = &ProvisionPluginClientGRPC{}
+
+// IsDeployed implements StatusReporter.
+// It returns ErrStatusNotSupported if the plugin does not support status queries.
+func (c *ProvisionPluginClientGRPC) IsDeployed(ctx context.Context, source datasource.DataSource, opts *IsDeployedOpts) (bool, error) {
+	if c.conn == nil {
+		return false, ErrStatusNotSupported
+	}
+
+	server, brokerID := c.startDataSourceServer(source)
+	defer server.Stop()
+
+	resp, err := provpb.NewProvisionStatusServiceClient(c.conn).IsDeployed(ctx, &provpb.IsDeployedRequest{
+		DataSource:  &brokerID,
+		KubeCfgFile: &opts.KubeCfgFile,
+		ClusterId:   &opts.ClusterID,
+	})
+	if err != nil {
+		return false, wrapStatusError(err)
+	}
+	return resp.GetDeployed(), nil
+}
+
+// GetStatus implements StatusReporter.
+// It returns ErrStatusNotSupported if the plugin does not support status queries.
+func (c *ProvisionPluginClientGRPC) GetStatus(ctx context.Context, source datasource.DataSource, opts *GetStatusOpts) (*ClusterStatus, error) {
+	if c.conn == nil {
+		return nil, ErrStatusNotSupported
+	}
+
+	server, brokerID := c.startDataSourceServer(source)
+	defer server.Stop()
+
+	resp, err := provpb.NewProvisionStatusServiceClient(c.conn).GetStatus(ctx, &provpb.GetStatusRequest{
+		DataSource:  &brokerID,
+		KubeCfgFile: &opts.KubeCfgFile,
+		ClusterId:   &opts.ClusterID,
+	})
+	if err != nil {
+		return nil, wrapStatusError(err)
+	}
+	return clusterStatusFromProto(resp.GetStatus()), nil
+}
+
+// wrapStatusError returns ErrStatusNotSupported if a status service call failed with an
+// Unimplemented status, or the wrapped error otherwise.
+func wrapStatusError(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return ErrStatusNotSupported
+	}
+	return wrapError(err)
+}
+
+var _ provpb.ProvisionStatusServiceServer = &GRPCServer{}
+
+func (s *GRPCServer) IsDeployed(ctx context.Context, req *provpb.IsDeployedRequest) (*provpb.IsDeployedResponse, error) {
+	reporter, err := s.statusReporter()
+	if err != nil {
+		return nil, err
+	}
+	client, conn, err := s.getDataSourceClient(ctx, req.GetDataSource())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	deployed, err := reporter.IsDeployed(ctx, client, &IsDeployedOpts{KubeCfgFile: req.GetKubeCfgFile(), ClusterID: req.GetClusterId()})
+	if err != nil {
+		return nil, err
+	}
+	return &provpb.IsDeployedResponse{Deployed: &deployed}, nil
+}
+
+func (s *GRPCServer) GetStatus(ctx context.Context, req *provpb.GetStatusRequest) (*provpb.GetStatusResponse, error) {
+	reporter, err := s.statusReporter()
+	if err != nil {
+		return nil, err
+	}
+	client, conn, err := s.getDataSourceClient(ctx, req.GetDataSource())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	clusterStatus, err := reporter.GetStatus(ctx, client, &GetStatusOpts{KubeCfgFile: req.GetKubeCfgFile(), ClusterID: req.GetClusterId()})
+	if err != nil {
+		return nil, err
+	}
+	return &provpb.GetStatusResponse{Status: clusterStatus.toProto()}, nil
+}
+
+// statusReporter returns the server's StatusReporter, or an Unimplemented status if the plugin
+// does not support status queries.
+func (s *GRPCServer) statusReporter() (StatusReporter, error) {
+	reporter, ok := s.impl.(StatusReporter)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, ErrStatusNotSupported.Error())
+	}
+	return reporter, nil
+}
+
+func (cs *ClusterStatus) toProto() *provpb.ClusterStatus {
+	result := &provpb.ClusterStatus{Deployed: &cs.Deployed}
+	if server := cs.Server; server != nil {
+		result.Server = &provpb.ServerStatus{
+			Replicas:           int32Ptr(server.Replicas),
+			ReadyReplicas:      int32Ptr(server.ReadyReplicas),
+			Containers:         containerStatusesToProto(server.Containers),
+			ControllerManagers: containerStatusesToProto(server.ControllerManagers),
+		}
+	}
+	if agents := cs.Agents; agents != nil {
+		result.Agents = &provpb.AgentStatus{
+			Expected: int32Ptr(agents.Expected),
+			Ready:    int32Ptr(agents.Ready),
+		}
+		for _, agent := range agents.Agents {
+			pb := &provpb.Agent{
+				Pod:             &agent.Pod,
+				Status:          &agent.Status,
+				Id:              &agent.ID,
+				AttestationType: &agent.AttestationType,
+				Serial:          &agent.Serial,
+				CanReattest:     &agent.CanReattest,
+			}
+			if !agent.ExpirationTime.IsZero() {
+				pb.ExpirationTime = timestamppb.New(agent.ExpirationTime)
+			}
+			result.Agents.Agents = append(result.Agents.Agents, pb)
+		}
+	}
+	if bundles := cs.Bundles; bundles != nil {
+		result.Bundles = &provpb.BundleStatus{
+			ServerCaBundle:   &bundles.ServerCABundle,
+			FederatedBundles: bundles.FederatedBundles,
+		}
+	}
+	return result
+}
+
+func clusterStatusFromProto(pb *provpb.ClusterStatus) *ClusterStatus {
+	result := &ClusterStatus{Deployed: pb.GetDeployed()}
+	if server := pb.GetServer(); server != nil {
+		result.Server = &ServerStatus{
+			Replicas:           int(server.GetReplicas()),
+			ReadyReplicas:      int(server.GetReadyReplicas()),
+			Containers:         containerStatusesFromProto(server.GetContainers()),
+			ControllerManagers: containerStatusesFromProto(server.GetControllerManagers()),
+		}
+	}
+	if agents := pb.GetAgents(); agents != nil {
+		result.Agents = &AgentStatus{
+			Expected: int(agents.GetExpected()),
+			Ready:    int(agents.GetReady()),
+		}
+		for _, agent := range agents.GetAgents() {
+			a := Agent{
+				Pod:             agent.GetPod(),
+				Status:          agent.GetStatus(),
+				ID:              agent.GetId(),
+				AttestationType: agent.GetAttestationType(),
+				Serial:          agent.GetSerial(),
+				CanReattest:     agent.GetCanReattest(),
+			}
+			if agent.GetExpirationTime() != nil {
+				a.ExpirationTime = agent.GetExpirationTime().AsTime()
+			}
+			result.Agents.Agents = append(result.Agents.Agents, a)
+		}
+	}
+	if bundles := pb.GetBundles(); bundles != nil {
+		result.Bundles = &BundleStatus{
+			ServerCABundle:   bundles.GetServerCaBundle(),
+			FederatedBundles: bundles.GetFederatedBundles(),
+		}
+	}
+	return result
+}
+
+func containerStatusesToProto(containers []ContainerStatus) []*provpb.ContainerStatus {
+	result := make([]*provpb.ContainerStatus, 0, len(containers))
+	for _, container := range containers {
+		result = append(result, &provpb.ContainerStatus{Pod: &container.Pod, Ready: &container.Ready})
+	}
+	return result
+}
+
+func containerStatusesFromProto(containers []*provpb.ContainerStatus) []ContainerStatus {
+	result := make([]ContainerStatus, 0, len(containers))
+	for _, container := range containers {
+		result = append(result, ContainerStatus{Pod: container.GetPod(), Ready: container.GetReady()})
+	}
+	return result
+}
+
+func int32Ptr(i int) *int32 {
+	v := int32(i)
+	return &v
+}
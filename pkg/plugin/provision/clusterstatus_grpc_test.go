@@ -0,0 +1,142 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package provision
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	go_plugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvision is a Provision that does nothing.
+type fakeProvision struct{}
+
+func (p *fakeProvision) Validate(_ context.Context) error {
+	return nil
+}
+
+func (p *fakeProvision) Deploy(_ context.Context, _ datasource.DataSource, _ *DeployOpts) (<-chan *provisionpb.Status, error) {
+	return nil, nil
+}
+
+func (p *fakeProvision) TearDown(_ context.Context, _ datasource.DataSource, _ *TearDownOpts) (<-chan *provisionpb.Status, error) {
+	return nil, nil
+}
+
+func (p *fakeProvision) GetHelmValues(_ context.Context, _ datasource.DataSource, _ *GetHelmValuesOpts) (map[string]any, error) {
+	return nil, nil
+}
+
+// fakeStatusReporter is a Provision that reports the status of clusters in trust zone tz1 as
+// deployed, using the data source to look up the cluster.
+type fakeStatusReporter struct {
+	fakeProvision
+	status *ClusterStatus
+}
+
+func (p *fakeStatusReporter) IsDeployed(_ context.Context, ds datasource.DataSource, opts *IsDeployedOpts) (bool, error) {
+	cluster, err := ds.GetCluster(opts.ClusterID)
+	if err != nil {
+		return false, err
+	}
+	return cluster.GetTrustZoneId() == "tz1-id" && opts.KubeCfgFile == "kube.cfg", nil
+}
+
+func (p *fakeStatusReporter) GetStatus(_ context.Context, ds datasource.DataSource, opts *GetStatusOpts) (*ClusterStatus, error) {
+	if _, err := ds.GetCluster(opts.ClusterID); err != nil {
+		return nil, err
+	}
+	return p.status, nil
+}
+
+func newProvisionClient(t *testing.T, impl Provision) *ProvisionPluginClientGRPC {
+	client, server := go_plugin.TestPluginGRPCConn(t, false, map[string]go_plugin.Plugin{
+		ProvisionPluginName: &ProvisionPlugin{Impl: impl},
+	})
+	t.Cleanup(func() {
+		_ = client.Close()
+		server.Stop()
+	})
+	raw, err := client.Dispense(ProvisionPluginName)
+	require.NoError(t, err)
+	return raw.(*ProvisionPluginClientGRPC)
+}
+
+func newStatusDataSource(t *testing.T) datasource.DataSource {
+	loader, err := config.NewMemoryLoader(&config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{fixtures.TrustZone("tz1")},
+		Clusters:   []*clusterpb.Cluster{fixtures.Cluster("local1")},
+		Plugins:    fixtures.Plugins("plugins1"),
+	})
+	require.NoError(t, err)
+	ds, err := local.NewLocalDataSource(loader)
+	require.NoError(t, err)
+	return ds
+}
+
+func TestProvisionPluginClientGRPC_status(t *testing.T) {
+	want := &ClusterStatus{
+		Deployed: true,
+		Server: &ServerStatus{
+			Replicas:           1,
+			ReadyReplicas:      1,
+			Containers:         []ContainerStatus{{Pod: "spire-server-0", Ready: true}},
+			ControllerManagers: []ContainerStatus{{Pod: "spire-server-0", Ready: true}},
+		},
+		Agents: &AgentStatus{
+			Expected: 1,
+			Ready:    1,
+			Agents: []Agent{
+				{Pod: "spire-agent-abc", Status: "ok", ID: "spiffe://td1/agent", ExpirationTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		Bundles: &BundleStatus{ServerCABundle: "bundle1", FederatedBundles: map[string]string{"td2": "bundle2"}},
+	}
+	client := newProvisionClient(t, &fakeStatusReporter{status: want})
+	ds := newStatusDataSource(t)
+	ctx := context.Background()
+
+	capabilities, err := client.Capabilities(ctx)
+	require.NoError(t, err)
+	assert.True(t, capabilities.HasFeature(FeatureStatus))
+
+	deployed, err := client.IsDeployed(ctx, ds, &IsDeployedOpts{KubeCfgFile: "kube.cfg", ClusterID: "local1-id"})
+	require.NoError(t, err)
+	assert.True(t, deployed)
+
+	got, err := client.GetStatus(ctx, ds, &GetStatusOpts{KubeCfgFile: "kube.cfg", ClusterID: "local1-id"})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = client.GetStatus(ctx, ds, &GetStatusOpts{ClusterID: "invalid"})
+	assert.ErrorContains(t, err, "failed to find cluster invalid")
+}
+
+func TestProvisionPluginClientGRPC_statusNotSupported(t *testing.T) {
+	client := newProvisionClient(t, &fakeProvision{})
+	ds := newStatusDataSource(t)
+	ctx := context.Background()
+
+	capabilities, err := client.Capabilities(ctx)
+	require.NoError(t, err)
+	assert.False(t, capabilities.HasFeature(FeatureStatus))
+
+	_, err = client.IsDeployed(ctx, ds, &IsDeployedOpts{ClusterID: "local1-id"})
+	assert.ErrorIs(t, err, ErrStatusNotSupported)
+	_, err = client.GetStatus(ctx, ds, &GetStatusOpts{ClusterID: "local1-id"})
+	assert.ErrorIs(t, err, ErrStatusNotSupported)
+	assert.ErrorIs(t, err, plugin.ErrNotSupported)
+}
@@ -9,8 +9,8 @@ import (
 	"fmt"
 	"io"
 
-	cofidectl_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
 	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	provpb "github.com/cofide/cofidectl/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 	go_plugin "github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
@@ -31,11 +31,14 @@ type ProvisionPlugin struct {
 }
 
 func (pp *ProvisionPlugin) GRPCClient(ctx context.Context, broker *go_plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
-	return &ProvisionPluginClientGRPC{client: provisionpb.NewProvisionPluginServiceClient(c), broker: broker}, nil
+	return &ProvisionPluginClientGRPC{client: provisionpb.NewProvisionPluginServiceClient(c), broker: broker, conn: c}, nil
 }
 
 func (pp *ProvisionPlugin) GRPCServer(broker *go_plugin.GRPCBroker, s *grpc.Server) error {
-	provisionpb.RegisterProvisionPluginServiceServer(s, &GRPCServer{impl: pp.Impl, broker: broker})
+	server := &GRPCServer{impl: pp.Impl, broker: broker}
+	provisionpb.RegisterProvisionPluginServiceServer(s, server)
+	provpb.RegisterProvisionStatusServiceServer(s, server)
+	provpb.RegisterProvisionCapabilitiesServiceServer(s, server)
 	return nil
 }
 
@@ -47,6 +50,9 @@ var _ Provision = &ProvisionPluginClientGRPC{}
 type ProvisionPluginClientGRPC struct {
 	broker *go_plugin.GRPCBroker
 	client provisionpb.ProvisionPluginServiceClient
+	// conn is used for calls to the capabilities service. If nil, the plugin is assumed to
+	// support only the baseline API.
+	conn grpc.ClientConnInterface
 }
 
 func (c *ProvisionPluginClientGRPC) Validate(ctx context.Context) error {
@@ -151,7 +157,7 @@ func (c *ProvisionPluginClientGRPC) startDataSourceServer(source datasource.Data
 	serverCh := make(chan *grpc.Server)
 	serverFunc := func(opts []grpc.ServerOption) *grpc.Server {
 		server := grpc.NewServer(opts...)
-		cofidectl_proto.RegisterDataSourcePluginServiceServer(server, dsServer)
+		datasource.RegisterDataSourcePluginServer(server, dsServer)
 		serverCh <- server
 		return server
 	}
@@ -310,6 +316,6 @@ func (s *GRPCServer) getDataSourceClient(ctx context.Context, dataSourceID uint3
 		return nil, nil, err
 	}
 
-	client := datasource.NewDataSourcePluginClientGRPC(ctx, cofidectl_proto.NewDataSourcePluginServiceClient(conn))
+	client := datasource.NewDataSourcePluginClientGRPCFromConn(ctx, conn)
 	return client, conn, nil
 }
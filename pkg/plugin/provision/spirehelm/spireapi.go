@@ -27,6 +27,16 @@ type SPIREAPI interface {
 
 	// GetBundle retrieves a SPIFFE bundle for the local trust zone.
 	GetBundle(ctx context.Context) (*spiretypes.Bundle, error)
+
+	// GetServerStatus retrieves the status of the SPIRE server pods.
+	GetServerStatus(ctx context.Context) (*spire.ServerStatus, error)
+
+	// GetAgentStatus retrieves the status of the SPIRE agents attested to the SPIRE server.
+	GetAgentStatus(ctx context.Context) (*spire.AgentStatus, error)
+
+	// GetServerCABundleAndFederatedBundles retrieves the server CA bundle and any federated
+	// bundles held by the SPIRE server.
+	GetServerCABundleAndFederatedBundles(ctx context.Context) (string, map[string]string, error)
 }
 
 // SPIREAPIFactoryImpl implements the SPIREAPIFactory interface, building a SPIREAPIImpl.
@@ -54,3 +64,15 @@ func (s *SPIREAPIImpl) WaitForServerIP(ctx context.Context) (string, error) {
 func (s *SPIREAPIImpl) GetBundle(ctx context.Context) (*spiretypes.Bundle, error) {
 	return spire.GetBundle(ctx, s.client)
 }
+
+func (s *SPIREAPIImpl) GetServerStatus(ctx context.Context) (*spire.ServerStatus, error) {
+	return spire.GetServerStatus(ctx, s.client)
+}
+
+func (s *SPIREAPIImpl) GetAgentStatus(ctx context.Context) (*spire.AgentStatus, error) {
+	return spire.GetAgentStatus(ctx, s.client)
+}
+
+func (s *SPIREAPIImpl) GetServerCABundleAndFederatedBundles(ctx context.Context) (string, map[string]string, error) {
+	return spire.GetServerCABundleAndFederatedBundles(ctx, s.client)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/cofide/cofidectl/internal/pkg/trustzone"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"github.com/cofide/cofidectl/pkg/spire"
 )
 
 // Control flow and error handling require some care in this package due to the asynchronous nature
@@ -24,8 +25,9 @@ import (
 // then any errors raised (rather than propagated) there should also be sent to the Status channel.
 // They should also return the error, to allow the caller to halt execution early.
 
-// Type check that SpireHelm implements the Provision interface.
+// Type check that SpireHelm implements the Provision and StatusReporter interfaces.
 var _ provision.Provision = &SpireHelm{}
+var _ provision.StatusReporter = &SpireHelm{}
 
 // SpireHelm implements the `Provision` interface by deploying a SPIRE cluster using the SPIRE Helm charts.
 type SpireHelm struct {
@@ -78,7 +80,84 @@ func (h *SpireHelm) TearDown(ctx context.Context, ds datasource.DataSource, opts
 }
 
 func (h *SpireHelm) GetHelmValues(ctx context.Context, ds datasource.DataSource, opts *provision.GetHelmValuesOpts) (map[string]any, error) {
-	cluster, err := ds.GetCluster(opts.ClusterID)
+	tzc, err := GetTrustZoneCluster(ds, opts.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.providerFactory.GetHelmValues(ctx, ds, tzc.TrustZone, tzc.Cluster)
+}
+
+// IsDeployed implements provision.StatusReporter, returning whether the SPIRE Helm chart has been
+// installed in a cluster.
+func (h *SpireHelm) IsDeployed(ctx context.Context, ds datasource.DataSource, opts *provision.IsDeployedOpts) (bool, error) {
+	tzc, err := GetTrustZoneCluster(ds, opts.ClusterID)
+	if err != nil {
+		return false, err
+	}
+	return h.isDeployed(ctx, ds, tzc, opts.KubeCfgFile)
+}
+
+func (h *SpireHelm) isDeployed(ctx context.Context, ds datasource.DataSource, tzc *TrustZoneCluster, kubeConfig string) (bool, error) {
+	prov, err := h.providerFactory.Build(ctx, ds, tzc.TrustZone, tzc.Cluster, false, kubeConfig)
+	if err != nil {
+		return false, err
+	}
+	return prov.CheckIfAlreadyInstalled()
+}
+
+// GetStatus implements provision.StatusReporter, querying the SPIRE server in a cluster for the
+// status of the servers, agents and bundles.
+func (h *SpireHelm) GetStatus(ctx context.Context, ds datasource.DataSource, opts *provision.GetStatusOpts) (*provision.ClusterStatus, error) {
+	tzc, err := GetTrustZoneCluster(ds, opts.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if deployed, err := h.isDeployed(ctx, ds, tzc, opts.KubeCfgFile); err != nil {
+		return nil, err
+	} else if !deployed {
+		return &provision.ClusterStatus{Deployed: false}, nil
+	}
+
+	kubeContext := tzc.Cluster.GetKubernetesContext()
+	if kubeContext == "" {
+		return nil, fmt.Errorf("no kubernetes context for cluster %s in trust zone %s", tzc.Cluster.GetName(), tzc.TrustZone.GetName())
+	}
+	spireAPI, err := h.spireAPIFactory.Build(opts.KubeCfgFile, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := spireAPI.GetServerStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	agents, err := spireAPI.GetAgentStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverCABundle, federatedBundles, err := spireAPI.GetServerCABundleAndFederatedBundles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provision.ClusterStatus{
+		Deployed: true,
+		Server:   convertServerStatus(server),
+		Agents:   convertAgentStatus(agents),
+		Bundles: &provision.BundleStatus{
+			ServerCABundle:   serverCABundle,
+			FederatedBundles: federatedBundles,
+		},
+	}, nil
+}
+
+// GetTrustZoneCluster returns a cluster and its trust zone.
+func GetTrustZoneCluster(ds datasource.DataSource, clusterID string) (*TrustZoneCluster, error) {
+	cluster, err := ds.GetCluster(clusterID)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +167,43 @@ func (h *SpireHelm) GetHelmValues(ctx context.Context, ds datasource.DataSource,
 		return nil, err
 	}
 
-	return h.providerFactory.GetHelmValues(ctx, ds, trustZone, cluster)
+	return &TrustZoneCluster{TrustZone: trustZone, Cluster: cluster}, nil
+}
+
+func convertServerStatus(server *spire.ServerStatus) *provision.ServerStatus {
+	result := &provision.ServerStatus{
+		Replicas:           server.Replicas,
+		ReadyReplicas:      server.ReadyReplicas,
+		Containers:         []provision.ContainerStatus{},
+		ControllerManagers: []provision.ContainerStatus{},
+	}
+	for _, container := range server.Containers {
+		result.Containers = append(result.Containers, provision.ContainerStatus{Pod: container.Name, Ready: container.Ready})
+	}
+	for _, scm := range server.SCMs {
+		result.ControllerManagers = append(result.ControllerManagers, provision.ContainerStatus{Pod: scm.Name, Ready: scm.Ready})
+	}
+	return result
+}
+
+func convertAgentStatus(agents *spire.AgentStatus) *provision.AgentStatus {
+	result := &provision.AgentStatus{
+		Expected: agents.Expected,
+		Ready:    agents.Ready,
+		Agents:   []provision.Agent{},
+	}
+	for _, agent := range agents.Agents {
+		result.Agents = append(result.Agents, provision.Agent{
+			Pod:             agent.Name,
+			Status:          agent.Status,
+			ID:              agent.Id,
+			AttestationType: agent.AttestationType,
+			ExpirationTime:  agent.ExpirationTime,
+			Serial:          agent.Serial,
+			CanReattest:     agent.CanReattest,
+		})
+	}
+	return result
 }
 
 func (h *SpireHelm) deploy(ctx context.Context, ds datasource.DataSource, opts *provision.DeployOpts, statusCh chan<- *provisionpb.Status) error {
@@ -154,6 +269,12 @@ func (h *SpireHelm) tearDown(ctx context.Context, ds datasource.DataSource, opts
 
 // ListTrustZoneClusters returns a slice of TrustZoneClusters. If no trust zones exist, it returns an error.
 func (h *SpireHelm) ListTrustZoneClusters(ds datasource.DataSource, trustZoneIDs []string) ([]TrustZoneCluster, error) {
+	return ListTrustZoneClusters(ds, trustZoneIDs)
+}
+
+// ListTrustZoneClusters returns a slice of TrustZoneClusters for the specified trust zones, or all
+// trust zones if none are specified. If no trust zones exist, it returns an error.
+func ListTrustZoneClusters(ds datasource.DataSource, trustZoneIDs []string) ([]TrustZoneCluster, error) {
 	var trustZones []*trust_zone_proto.TrustZone
 	if len(trustZoneIDs) == 0 {
 		var err error
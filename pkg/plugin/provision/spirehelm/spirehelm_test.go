@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	attestation_policy_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/attestation_policy/v1alpha1"
 	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
@@ -19,6 +20,7 @@ import (
 	"github.com/cofide/cofidectl/pkg/plugin/local"
 	"github.com/cofide/cofidectl/pkg/plugin/provision"
 	"github.com/cofide/cofidectl/pkg/provider/helm"
+	"github.com/cofide/cofidectl/pkg/spire"
 	spiretypes "github.com/spiffe/spire-api-sdk/proto/spire/api/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -239,6 +241,75 @@ func TestSpireHelm_GetHelmValues(t *testing.T) {
 	assert.EqualExportedValues(t, want, values)
 }
 
+func TestSpireHelm_IsDeployed(t *testing.T) {
+	for _, installed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("installed %t", installed), func(t *testing.T) {
+			providerFactory := &fakeHelmSPIREProviderFactory{installed: installed}
+			spireHelm := NewSpireHelm(providerFactory, newFakeSPIREAPIFactory())
+			ds := newFakeDataSource(t, defaultConfig())
+
+			opts := provision.IsDeployedOpts{KubeCfgFile: "fake-kube.cfg", ClusterID: "local1-id"}
+			deployed, err := spireHelm.IsDeployed(context.Background(), ds, &opts)
+			require.NoError(t, err)
+			assert.Equal(t, installed, deployed)
+		})
+	}
+}
+
+func TestSpireHelm_GetStatus(t *testing.T) {
+	expiry := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	spireAPIFactory := newFakeSPIREAPIFactory()
+	spireAPIFactory.api = &fakeSPIREAPI{
+		serverStatus: &spire.ServerStatus{
+			Replicas:      1,
+			ReadyReplicas: 1,
+			Containers:    []spire.ServerContainer{{Name: "spire-server-0", Ready: true}},
+			SCMs:          []spire.SCMContainer{{Name: "spire-server-0", Ready: false}},
+		},
+		agentStatus: &spire.AgentStatus{
+			Expected: 2,
+			Ready:    1,
+			Agents: []spire.Agent{
+				{Name: "spire-agent-abc", Status: "ok", Id: "spiffe://td1/agent", AttestationType: "k8s_psat", ExpirationTime: expiry, Serial: "1", CanReattest: true},
+			},
+		},
+		serverCABundle:   "bundle1",
+		federatedBundles: map[string]string{"td2": "bundle2"},
+	}
+	ds := newFakeDataSource(t, defaultConfig())
+	opts := provision.GetStatusOpts{KubeCfgFile: "fake-kube.cfg", ClusterID: "local1-id"}
+
+	spireHelm := NewSpireHelm(&fakeHelmSPIREProviderFactory{installed: false}, spireAPIFactory)
+	got, err := spireHelm.GetStatus(context.Background(), ds, &opts)
+	require.NoError(t, err)
+	assert.Equal(t, &provision.ClusterStatus{Deployed: false}, got)
+
+	spireHelm = NewSpireHelm(&fakeHelmSPIREProviderFactory{installed: true}, spireAPIFactory)
+	got, err = spireHelm.GetStatus(context.Background(), ds, &opts)
+	require.NoError(t, err)
+	want := &provision.ClusterStatus{
+		Deployed: true,
+		Server: &provision.ServerStatus{
+			Replicas:           1,
+			ReadyReplicas:      1,
+			Containers:         []provision.ContainerStatus{{Pod: "spire-server-0", Ready: true}},
+			ControllerManagers: []provision.ContainerStatus{{Pod: "spire-server-0", Ready: false}},
+		},
+		Agents: &provision.AgentStatus{
+			Expected: 2,
+			Ready:    1,
+			Agents: []provision.Agent{
+				{Pod: "spire-agent-abc", Status: "ok", ID: "spiffe://td1/agent", AttestationType: "k8s_psat", ExpirationTime: expiry, Serial: "1", CanReattest: true},
+			},
+		},
+		Bundles: &provision.BundleStatus{
+			ServerCABundle:   "bundle1",
+			FederatedBundles: map[string]string{"td2": "bundle2"},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
 func collectStatuses(statusCh <-chan *provisionpb.Status) []*provisionpb.Status {
 	statuses := []*provisionpb.Status{}
 	for status := range statusCh {
@@ -247,7 +318,9 @@ func collectStatuses(statusCh <-chan *provisionpb.Status) []*provisionpb.Status
 	return statuses
 }
 
-type fakeHelmSPIREProviderFactory struct{}
+type fakeHelmSPIREProviderFactory struct {
+	installed bool
+}
 
 func newFakeHelmSPIREProviderFactory() *fakeHelmSPIREProviderFactory {
 	return &fakeHelmSPIREProviderFactory{}
@@ -261,7 +334,7 @@ func (f *fakeHelmSPIREProviderFactory) Build(
 	genValues bool,
 	kubeConfig string,
 ) (helm.Provider, error) {
-	return newFakeHelmSPIREProvider(trustZone, cluster), nil
+	return newFakeHelmSPIREProvider(trustZone, cluster, f.installed), nil
 }
 
 func (f *fakeHelmSPIREProviderFactory) GetHelmValues(
@@ -277,10 +350,11 @@ func (f *fakeHelmSPIREProviderFactory) GetHelmValues(
 type fakeHelmSPIREProvider struct {
 	trustZone *trust_zone_proto.TrustZone
 	cluster   *clusterpb.Cluster
+	installed bool
 }
 
-func newFakeHelmSPIREProvider(trustZone *trust_zone_proto.TrustZone, cluster *clusterpb.Cluster) helm.Provider {
-	return &fakeHelmSPIREProvider{trustZone: trustZone, cluster: cluster}
+func newFakeHelmSPIREProvider(trustZone *trust_zone_proto.TrustZone, cluster *clusterpb.Cluster, installed bool) helm.Provider {
+	return &fakeHelmSPIREProvider{trustZone: trustZone, cluster: cluster, installed: installed}
 }
 
 func (p *fakeHelmSPIREProvider) AddRepository(statusCh chan<- *provisionpb.Status) error {
@@ -319,17 +393,19 @@ func (p *fakeHelmSPIREProvider) ExecuteUninstall(statusCh chan<- *provisionpb.St
 }
 
 func (p *fakeHelmSPIREProvider) CheckIfAlreadyInstalled() (bool, error) {
-	return false, nil
+	return p.installed, nil
 }
 
-type fakeSPIREAPIFactory struct{}
+type fakeSPIREAPIFactory struct {
+	api *fakeSPIREAPI
+}
 
-func newFakeSPIREAPIFactory() SPIREAPIFactory {
-	return &fakeSPIREAPIFactory{}
+func newFakeSPIREAPIFactory() *fakeSPIREAPIFactory {
+	return &fakeSPIREAPIFactory{api: &fakeSPIREAPI{}}
 }
 
 func (f *fakeSPIREAPIFactory) Build(kubeCfgFile, kubeContext string) (SPIREAPI, error) {
-	return &fakeSPIREAPI{}, nil
+	return f.api, nil
 }
 
 type fakeSPIREAPI struct {
@@ -337,6 +413,11 @@ type fakeSPIREAPI struct {
 	ipErr     error
 	bundle    *spiretypes.Bundle
 	bundleErr error
+
+	serverStatus     *spire.ServerStatus
+	agentStatus      *spire.AgentStatus
+	serverCABundle   string
+	federatedBundles map[string]string
 }
 
 func (s *fakeSPIREAPI) WaitForServerIP(ctx context.Context) (string, error) {
@@ -347,6 +428,18 @@ func (s *fakeSPIREAPI) GetBundle(ctx context.Context) (*spiretypes.Bundle, error
 	return s.bundle, s.bundleErr
 }
 
+func (s *fakeSPIREAPI) GetServerStatus(ctx context.Context) (*spire.ServerStatus, error) {
+	return s.serverStatus, nil
+}
+
+func (s *fakeSPIREAPI) GetAgentStatus(ctx context.Context) (*spire.AgentStatus, error) {
+	return s.agentStatus, nil
+}
+
+func (s *fakeSPIREAPI) GetServerCABundleAndFederatedBundles(ctx context.Context) (string, map[string]string, error) {
+	return s.serverCABundle, s.federatedBundles, nil
+}
+
 func newFakeDataSource(t *testing.T, cfg *config.Config) datasource.DataSource {
 	configLoader, err := config.NewMemoryLoader(cfg)
 	require.Nil(t, err)
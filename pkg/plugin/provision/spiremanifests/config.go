@@ -0,0 +1,157 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spiremanifests
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// WrapperArgoCD wraps the manifests for each cluster in an Argo CD Application.
+	WrapperArgoCD = "argocd"
+	// WrapperFlux wraps the charts for each cluster in Flux HelmReleases.
+	WrapperFlux = "flux"
+
+	defaultOutputDir            = "manifests"
+	defaultWrapperDirName       = "gitops"
+	defaultArgoCDTargetRevision = "HEAD"
+	defaultArgoCDProject        = "default"
+	defaultArgoCDNamespace      = "argocd"
+	defaultFluxNamespace        = "flux-system"
+	defaultFluxInterval         = "10m"
+)
+
+// configSchema is the CUE schema for the plugin's configuration in the plugin_config section of
+// the config file.
+const configSchema = `close({
+	output_dir?:  string & !=""
+	wrapper?:     "argocd" | "flux"
+	wrapper_dir?: string & !=""
+	argocd?: close({
+		repo_url?:        string & !=""
+		target_revision?: string & !=""
+		path?:            string & !=""
+		project?:         string & !=""
+		namespace?:       string & !=""
+	})
+	flux?: close({
+		namespace?: string & !=""
+		interval?:  string & !=""
+	})
+	if wrapper != _|_ if wrapper == "argocd" {
+		argocd: repo_url: string & !=""
+	}
+})`
+
+// Config is the configuration of the spire-manifests provision plugin.
+type Config struct {
+	// OutputDir is the directory to which manifests are written.
+	OutputDir string `json:"output_dir"`
+	// Wrapper is the type of GitOps wrapper to emit for each cluster, if any.
+	Wrapper string `json:"wrapper"`
+	// WrapperDir is the directory to which GitOps wrappers are written. It must not overlap
+	// OutputDir, so that a GitOps tool pointed at the wrappers does not also apply the manifests.
+	// It defaults to a gitops directory alongside OutputDir.
+	WrapperDir string       `json:"wrapper_dir"`
+	ArgoCD     ArgoCDConfig `json:"argocd"`
+	Flux       FluxConfig   `json:"flux"`
+}
+
+// ArgoCDConfig configures the Argo CD Applications emitted for each cluster.
+type ArgoCDConfig struct {
+	// RepoURL is the URL of the Git repository to which the manifests are committed.
+	RepoURL string `json:"repo_url"`
+	// TargetRevision is the Git revision that Argo CD syncs.
+	TargetRevision string `json:"target_revision"`
+	// Path is the path of the output directory in the Git repository. It defaults to OutputDir.
+	Path string `json:"path"`
+	// Project is the Argo CD project of the Applications.
+	Project string `json:"project"`
+	// Namespace is the namespace in which Argo CD is installed.
+	Namespace string `json:"namespace"`
+}
+
+// FluxConfig configures the Flux HelmReleases emitted for each cluster.
+type FluxConfig struct {
+	// Namespace is the namespace of the HelmRepository and HelmRelease resources.
+	Namespace string `json:"namespace"`
+	// Interval is the reconciliation interval of the HelmRepository and HelmRelease resources.
+	Interval string `json:"interval"`
+}
+
+// ParseConfig returns the plugin's configuration from its plugin_config section, with defaults
+// applied. pluginConfig may be nil.
+func ParseConfig(pluginConfig *structpb.Struct) (*Config, error) {
+	config := &Config{}
+	if pluginConfig != nil {
+		data, err := protojson.Marshal(pluginConfig)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, config); err != nil {
+			return nil, fmt.Errorf("invalid spire-manifests plugin config: %w", err)
+		}
+	}
+
+	if config.OutputDir == "" {
+		config.OutputDir = defaultOutputDir
+	}
+	if config.WrapperDir == "" {
+		config.WrapperDir = filepath.Join(filepath.Dir(config.OutputDir), defaultWrapperDirName)
+	}
+	if config.ArgoCD.TargetRevision == "" {
+		config.ArgoCD.TargetRevision = defaultArgoCDTargetRevision
+	}
+	if config.ArgoCD.Path == "" {
+		config.ArgoCD.Path = config.OutputDir
+	}
+	if config.ArgoCD.Project == "" {
+		config.ArgoCD.Project = defaultArgoCDProject
+	}
+	if config.ArgoCD.Namespace == "" {
+		config.ArgoCD.Namespace = defaultArgoCDNamespace
+	}
+	if config.Flux.Namespace == "" {
+		config.Flux.Namespace = defaultFluxNamespace
+	}
+	if config.Flux.Interval == "" {
+		config.Flux.Interval = defaultFluxInterval
+	}
+	return config, nil
+}
+
+// validate returns an error if the configuration is incomplete.
+func (c *Config) validate() error {
+	switch c.Wrapper {
+	case "", WrapperFlux:
+	case WrapperArgoCD:
+		if c.ArgoCD.RepoURL == "" {
+			return fmt.Errorf("argocd.repo_url must be set when wrapper is %s", WrapperArgoCD)
+		}
+	default:
+		return fmt.Errorf("unsupported wrapper %q, expected %s or %s", c.Wrapper, WrapperArgoCD, WrapperFlux)
+	}
+	if c.Wrapper != "" && c.WrapperDir == "" {
+		return fmt.Errorf("wrapper_dir must be set when wrapper is %s", c.Wrapper)
+	}
+	if c.Wrapper != "" && (isWithin(c.WrapperDir, c.OutputDir) || isWithin(c.OutputDir, c.WrapperDir)) {
+		return fmt.Errorf("wrapper_dir %s must not overlap output_dir %s, or the manifests may be applied twice", c.WrapperDir, c.OutputDir)
+	}
+	return nil
+}
+
+// isWithin returns whether path is dir or a descendant of it.
+func isWithin(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
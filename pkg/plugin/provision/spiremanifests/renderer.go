@@ -0,0 +1,58 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spiremanifests
+
+import (
+	"context"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+
+	"github.com/cofide/cofidectl/pkg/provider/helm"
+)
+
+// Type check that HelmRenderer implements the Renderer interface.
+var _ Renderer = &HelmRenderer{}
+
+// Renderer is an interface that abstracts the rendering of the SPIRE Helm charts.
+type Renderer interface {
+	// AddRepository adds the SPIRE Helm repository to the local repositories.yaml.
+	// The action is performed synchronously and status is streamed through the provided status channel.
+	AddRepository(ctx context.Context, statusCh chan<- *provisionpb.Status) error
+
+	// Render renders the SPIRE CRDs and SPIRE Helm charts for a cluster using the provided values
+	// for the SPIRE chart.
+	Render(
+		ctx context.Context,
+		trustZone *trust_zone_proto.TrustZone,
+		cluster *clusterpb.Cluster,
+		values map[string]any,
+	) ([]*helm.RenderedChart, error)
+}
+
+// HelmRenderer implements the Renderer interface, templating the charts locally using a
+// HelmSPIREProvider.
+type HelmRenderer struct{}
+
+func (r *HelmRenderer) AddRepository(ctx context.Context, statusCh chan<- *provisionpb.Status) error {
+	prov, err := helm.NewHelmSPIREProvider(ctx, "", nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	return prov.AddRepository(statusCh)
+}
+
+func (r *HelmRenderer) Render(
+	ctx context.Context,
+	trustZone *trust_zone_proto.TrustZone,
+	cluster *clusterpb.Cluster,
+	values map[string]any,
+) ([]*helm.RenderedChart, error) {
+	prov, err := helm.NewHelmSPIREProvider(ctx, trustZone.GetName(), cluster, values, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+	return prov.Render()
+}
@@ -0,0 +1,374 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spiremanifests implements a provision plugin for GitOps workflows. Rather than
+// installing the SPIRE Helm charts, it renders them locally to Kubernetes manifests, and writes
+// them to an output directory to be committed to a Git repository:
+//
+//	<output_dir>/clusters/<trust zone>/<cluster>/<chart>.yaml    rendered manifests
+//	<output_dir>/deletions/<trust zone>/<cluster>.yaml           resources to delete after tear down
+//	<wrapper_dir>/argocd/<trust zone>-<cluster>.yaml             Argo CD Application (optional)
+//	<wrapper_dir>/flux/<trust zone>/<cluster>/helmrelease.yaml   Flux HelmReleases (optional)
+//
+// GitOps tools should target a single cluster's directory rather than either root. The manifests
+// of a cluster may be applied directly, and are the source of its Argo CD Application. Flux
+// HelmReleases install the charts themselves, so a Flux Kustomization should target the cluster's
+// directory under <wrapper_dir>/flux only; the wrappers are written to a separate root from the
+// manifests so that the two are never applied together.
+package spiremanifests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"github.com/cofide/cofidectl/pkg/plugin/provision/spirehelm"
+	"github.com/cofide/cofidectl/pkg/provider/helm"
+)
+
+const (
+	clustersDir  = "clusters"
+	argoCDDir    = "argocd"
+	fluxDir      = "flux"
+	deletionsDir = "deletions"
+)
+
+// Type check that SpireManifests implements the Provision and ConfigSchemaProvider interfaces.
+var _ provision.Provision = &SpireManifests{}
+var _ plugin.ConfigSchemaProvider = &SpireManifests{}
+
+// SpireManifests implements the `Provision` interface by rendering the SPIRE Helm charts to
+// Kubernetes manifests for each cluster, to be deployed by a GitOps tool.
+type SpireManifests struct {
+	config   *Config
+	renderer Renderer
+}
+
+func NewSpireManifests(config *Config, renderer Renderer) *SpireManifests {
+	if config == nil {
+		config, _ = ParseConfig(nil)
+	}
+	if renderer == nil {
+		renderer = &HelmRenderer{}
+	}
+	return &SpireManifests{config: config, renderer: renderer}
+}
+
+func (m *SpireManifests) Validate(_ context.Context) error {
+	return nil
+}
+
+// ConfigSchema implements plugin.ConfigSchemaProvider.
+func (m *SpireManifests) ConfigSchema() string {
+	return configSchema
+}
+
+// Deploy renders the SPIRE charts for each cluster and writes the manifests, and any GitOps
+// wrappers, to the output directory. It does not contact the clusters.
+func (m *SpireManifests) Deploy(ctx context.Context, ds datasource.DataSource, opts *provision.DeployOpts) (<-chan *provisionpb.Status, error) {
+	statusCh := make(chan *provisionpb.Status)
+
+	go func() {
+		defer close(statusCh)
+		// Ignore returned errors - they should be sent via the Status channel.
+		_ = m.deploy(ctx, ds, opts, statusCh)
+	}()
+
+	return statusCh, nil
+}
+
+// TearDown removes the manifests and GitOps wrappers of each cluster from the output directory,
+// and writes the set of resources that should be deleted from the cluster.
+func (m *SpireManifests) TearDown(ctx context.Context, ds datasource.DataSource, opts *provision.TearDownOpts) (<-chan *provisionpb.Status, error) {
+	statusCh := make(chan *provisionpb.Status)
+
+	go func() {
+		defer close(statusCh)
+		// Ignore returned errors - they should be sent via the Status channel.
+		_ = m.tearDown(ds, opts, statusCh)
+	}()
+
+	return statusCh, nil
+}
+
+func (m *SpireManifests) GetHelmValues(_ context.Context, ds datasource.DataSource, opts *provision.GetHelmValuesOpts) (map[string]any, error) {
+	tzc, err := spirehelm.GetTrustZoneCluster(ds, opts.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	return getHelmValues(ds, tzc)
+}
+
+func getHelmValues(ds datasource.DataSource, tzc *spirehelm.TrustZoneCluster) (map[string]any, error) {
+	generator := helm.NewHelmValuesGenerator(tzc.TrustZone, tzc.Cluster, ds, nil)
+	return generator.GenerateValues()
+}
+
+func (m *SpireManifests) deploy(ctx context.Context, ds datasource.DataSource, opts *provision.DeployOpts, statusCh chan<- *provisionpb.Status) error {
+	if err := m.config.validate(); err != nil {
+		statusCh <- provision.StatusError("Preparing", "Invalid spire-manifests plugin configuration", err)
+		return err
+	}
+
+	trustZoneClusters, err := spirehelm.ListTrustZoneClusters(ds, opts.TrustZoneIDs)
+	if err != nil {
+		statusCh <- provision.StatusError("Rendering", "Failed listing trust zones", err)
+		return err
+	}
+
+	if repo, ok := os.LookupEnv("HELM_REPO_PATH"); ok && repo != "" {
+		statusCh <- provision.StatusOk("Preparing", fmt.Sprintf("Found HELM_REPO_PATH value, using local chart: %s", repo))
+	} else if err := m.renderer.AddRepository(ctx, statusCh); err != nil {
+		return err
+	}
+
+	for _, tzc := range trustZoneClusters {
+		if err := m.renderCluster(ctx, ds, &tzc, statusCh); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderCluster renders the SPIRE charts for a cluster and writes the manifests and any GitOps
+// wrapper to the output directory.
+func (m *SpireManifests) renderCluster(ctx context.Context, ds datasource.DataSource, tzc *spirehelm.TrustZoneCluster, statusCh chan<- *provisionpb.Status) error {
+	trustZoneName := tzc.TrustZone.GetName()
+	clusterName := tzc.Cluster.GetName()
+	sb := provision.NewStatusBuilder(trustZoneName, clusterName)
+	statusCh <- sb.Ok("Rendering", "Rendering SPIRE manifests")
+
+	if err := m.checkFederations(ds, tzc, sb, statusCh); err != nil {
+		return err
+	}
+
+	values, err := getHelmValues(ds, tzc)
+	if err != nil {
+		statusCh <- sb.Error("Rendering", "Failed to generate Helm values", err)
+		return err
+	}
+
+	charts, err := m.renderer.Render(ctx, tzc.TrustZone, tzc.Cluster, values)
+	if err != nil {
+		statusCh <- sb.Error("Rendering", "Failed to render SPIRE charts", err)
+		return err
+	}
+
+	if err := m.writeCluster(trustZoneName, clusterName, charts); err != nil {
+		statusCh <- sb.Error("Rendering", "Failed to write SPIRE manifests", err)
+		return err
+	}
+
+	statusCh <- sb.Done("Rendered", fmt.Sprintf("Wrote SPIRE manifests to %s", m.config.OutputDir))
+	return nil
+}
+
+// checkFederations reports federations that cannot be rendered because the remote trust zone
+// has no bundle endpoint URL. Unlike the spire-helm plugin, bundle endpoints cannot be discovered
+// from the cluster after installation, so they must be configured in the trust zone.
+func (m *SpireManifests) checkFederations(ds datasource.DataSource, tzc *spirehelm.TrustZoneCluster, sb *provision.StatusBuilder, statusCh chan<- *provisionpb.Status) error {
+	federations, err := ds.ListFederations(&datasourcepb.ListFederationsRequest_Filter{TrustZoneId: tzc.TrustZone.Id})
+	if err != nil {
+		statusCh <- sb.Error("Rendering", "Failed listing federations", err)
+		return err
+	}
+	for _, federation := range federations {
+		remote, err := ds.GetTrustZone(federation.GetRemoteTrustZoneId())
+		if err != nil {
+			statusCh <- sb.Error("Rendering", "Failed to get federated trust zone", err)
+			return err
+		}
+		if remote.GetBundleEndpointUrl() == "" {
+			msg := fmt.Sprintf("Omitting federation with %s, which has no bundle endpoint URL", remote.GetName())
+			statusCh <- sb.Ok("Rendering", msg)
+		}
+	}
+	return nil
+}
+
+// writeCluster writes the rendered charts of a cluster and any GitOps wrapper to the output
+// directory, and removes any deletion set left by a previous tear down.
+func (m *SpireManifests) writeCluster(trustZoneName, clusterName string, charts []*helm.RenderedChart) error {
+	clusterDir := m.clusterDir(trustZoneName, clusterName)
+	for _, chart := range charts {
+		if err := writeFile(filepath.Join(clusterDir, chart.Name+".yaml"), []byte(chart.Manifest)); err != nil {
+			return err
+		}
+	}
+
+	switch m.config.Wrapper {
+	case WrapperArgoCD:
+		data, err := marshalDocuments(argoCDApplication(m.config, trustZoneName, clusterName))
+		if err != nil {
+			return err
+		}
+		if err := writeFile(m.argoCDFile(trustZoneName, clusterName), data); err != nil {
+			return err
+		}
+	case WrapperFlux:
+		data, err := marshalDocuments(fluxHelmResources(m.config, charts)...)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(m.fluxFile(trustZoneName, clusterName), data); err != nil {
+			return err
+		}
+	}
+
+	return removeIfExists(m.deletionsFile(trustZoneName, clusterName))
+}
+
+func (m *SpireManifests) tearDown(ds datasource.DataSource, opts *provision.TearDownOpts, statusCh chan<- *provisionpb.Status) error {
+	trustZoneClusters, err := spirehelm.ListTrustZoneClusters(ds, opts.TrustZoneIDs)
+	if err != nil {
+		statusCh <- provision.StatusError("Uninstalling", "Failed listing trust zones", err)
+		return err
+	}
+
+	for _, tzc := range trustZoneClusters {
+		trustZoneName := tzc.TrustZone.GetName()
+		clusterName := tzc.Cluster.GetName()
+		sb := provision.NewStatusBuilder(trustZoneName, clusterName)
+		statusCh <- sb.Ok("Uninstalling", "Removing SPIRE manifests")
+
+		deletionsFile, err := m.tearDownCluster(trustZoneName, clusterName)
+		if err != nil {
+			statusCh <- sb.Error("Uninstalling", "Failed to remove SPIRE manifests", err)
+			return err
+		}
+		if deletionsFile == "" {
+			statusCh <- sb.Done("Uninstalled", "No SPIRE manifests found")
+		} else {
+			statusCh <- sb.Done("Uninstalled", fmt.Sprintf("Wrote resources to delete to %s", deletionsFile))
+		}
+	}
+	return nil
+}
+
+// tearDownCluster removes the manifests and any GitOps wrappers of a cluster from the output
+// directory, and writes the resources that they contained to a deletion set. It returns the path
+// of the deletion set, or an empty string if the cluster has no manifests.
+func (m *SpireManifests) tearDownCluster(trustZoneName, clusterName string) (string, error) {
+	clusterDir := m.clusterDir(trustZoneName, clusterName)
+	if _, err := os.Stat(clusterDir); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+
+	// Delete resources in the reverse order to their installation.
+	refs := []*resourceRef{}
+	for _, chartName := range []string{helm.SPIREChartName, helm.SPIRECRDChartName} {
+		data, err := os.ReadFile(filepath.Join(clusterDir, chartName+".yaml"))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return "", err
+		}
+		chartRefs, err := parseResourceRefs(data)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse %s manifests: %w", chartName, err)
+		}
+		refs = append(refs, chartRefs...)
+	}
+
+	data, err := marshalDeletionSet(refs)
+	if err != nil {
+		return "", err
+	}
+	deletionsFile := m.deletionsFile(trustZoneName, clusterName)
+	if err := writeFile(deletionsFile, data); err != nil {
+		return "", err
+	}
+
+	for _, path := range []string{clusterDir, m.argoCDFile(trustZoneName, clusterName), filepath.Dir(m.fluxFile(trustZoneName, clusterName))} {
+		if err := os.RemoveAll(path); err != nil {
+			return "", err
+		}
+	}
+	return deletionsFile, nil
+}
+
+func (m *SpireManifests) clusterDir(trustZoneName, clusterName string) string {
+	return filepath.Join(m.config.OutputDir, clustersDir, trustZoneName, clusterName)
+}
+
+func (m *SpireManifests) argoCDFile(trustZoneName, clusterName string) string {
+	return filepath.Join(m.config.WrapperDir, argoCDDir, fmt.Sprintf("%s-%s.yaml", trustZoneName, clusterName))
+}
+
+func (m *SpireManifests) fluxFile(trustZoneName, clusterName string) string {
+	return filepath.Join(m.config.WrapperDir, fluxDir, trustZoneName, clusterName, "helmrelease.yaml")
+}
+
+func (m *SpireManifests) deletionsFile(trustZoneName, clusterName string) string {
+	return filepath.Join(m.config.OutputDir, deletionsDir, trustZoneName, clusterName+".yaml")
+}
+
+// resourceRef identifies a Kubernetes resource.
+type resourceRef struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace,omitempty"`
+	} `yaml:"metadata"`
+}
+
+// parseResourceRefs returns references to the resources in a multi-document YAML manifest.
+func parseResourceRefs(data []byte) ([]*resourceRef, error) {
+	refs := []*resourceRef{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		ref := &resourceRef{}
+		if err := decoder.Decode(ref); err != nil {
+			if errors.Is(err, io.EOF) {
+				return refs, nil
+			}
+			return nil, err
+		}
+		if ref.Kind != "" {
+			refs = append(refs, ref)
+		}
+	}
+}
+
+// marshalDeletionSet returns a multi-document YAML manifest of the resources to delete, which may
+// be passed to `kubectl delete`.
+func marshalDeletionSet(refs []*resourceRef) ([]byte, error) {
+	data, err := marshalDocuments(refs...)
+	if err != nil {
+		return nil, err
+	}
+	header := fmt.Sprintf(
+		"# Resources to delete, in order. Namespaced resources without a namespace are in %[1]s:\n"+
+			"#   kubectl delete --ignore-not-found --namespace %[1]s -f <file>\n",
+		helm.SPIREManagementNamespace,
+	)
+	return append([]byte(header), data...), nil
+}
+
+// writeFile writes data to a file, creating its parent directories if necessary.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,460 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spiremanifests
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	provisionpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/provision_plugin/v1alpha2"
+	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/config"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/cofide/cofidectl/pkg/plugin"
+	"github.com/cofide/cofidectl/pkg/plugin/datasource"
+	"github.com/cofide/cofidectl/pkg/plugin/local"
+	"github.com/cofide/cofidectl/pkg/plugin/provision"
+	"github.com/cofide/cofidectl/pkg/provider/helm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	fakeCRDsManifest = `---
+# Source: spire-crds/templates/crd.yaml
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: clusterspiffeids.spire.spiffe.io
+`
+	fakeSPIREManifest = `---
+# Source: spire/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: spire-server
+  namespace: spire-server
+---
+---
+# Source: spire/templates/role.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: spire-server
+`
+)
+
+func TestSpireManifests_Deploy(t *testing.T) {
+	outputDir := t.TempDir()
+	m := NewSpireManifests(&Config{OutputDir: outputDir}, &fakeRenderer{})
+	ds := newFakeDataSource(t, defaultConfig())
+
+	statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{})
+	require.NoError(t, err)
+	want := []*provisionpb.Status{
+		provision.StatusOk("Preparing", "Adding SPIRE Helm repo"),
+		provision.StatusDone("Prepared", "Added SPIRE Helm repo"),
+		provision.StatusOk("Rendering", "Rendering SPIRE manifests for local1 in tz1"),
+		provision.StatusDone("Rendered", "Wrote SPIRE manifests to "+outputDir+" for local1 in tz1"),
+		provision.StatusOk("Rendering", "Rendering SPIRE manifests for local2 in tz2"),
+		provision.StatusDone("Rendered", "Wrote SPIRE manifests to "+outputDir+" for local2 in tz2"),
+	}
+	assert.EqualExportedValues(t, want, collectStatuses(statusCh))
+
+	assertFileContent(t, filepath.Join(outputDir, "clusters", "tz1", "local1", "spire-crds.yaml"), fakeCRDsManifest)
+	assertFileContent(t, filepath.Join(outputDir, "clusters", "tz1", "local1", "spire.yaml"), fakeSPIREManifest)
+	assert.FileExists(t, filepath.Join(outputDir, "clusters", "tz2", "local2", "spire.yaml"))
+	assert.NoDirExists(t, filepath.Join(outputDir, "argocd"))
+	assert.NoDirExists(t, filepath.Join(outputDir, "flux"))
+}
+
+func TestSpireManifests_Deploy_specificTrustZone(t *testing.T) {
+	outputDir := t.TempDir()
+	m := NewSpireManifests(&Config{OutputDir: outputDir}, &fakeRenderer{})
+	ds := newFakeDataSource(t, defaultConfig())
+
+	statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{TrustZoneIDs: []string{"tz2-id"}})
+	require.NoError(t, err)
+	collectStatuses(statusCh)
+
+	assert.NoDirExists(t, filepath.Join(outputDir, "clusters", "tz1"))
+	assert.FileExists(t, filepath.Join(outputDir, "clusters", "tz2", "local2", "spire.yaml"))
+}
+
+func TestSpireManifests_Deploy_federationWithoutBundleEndpoint(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.TrustZones[1].BundleEndpointUrl = nil
+	cfg.Federations = append(cfg.Federations, fixtures.Federation("fed1"))
+	renderer := &fakeRenderer{}
+	m := NewSpireManifests(&Config{OutputDir: t.TempDir()}, renderer)
+	ds := newFakeDataSource(t, cfg)
+
+	statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{TrustZoneIDs: []string{"tz1-id"}})
+	require.NoError(t, err)
+	statuses := collectStatuses(statusCh)
+	assert.Contains(t, statuses[3].GetMessage(), "Omitting federation with tz2, which has no bundle endpoint URL for local1 in tz1")
+	assert.Equal(t, "Rendered", statuses[len(statuses)-1].GetStage())
+}
+
+func TestSpireManifests_Deploy_argoCD(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "manifests")
+	cfg, err := ParseConfig(newStruct(t, map[string]any{
+		"output_dir": outputDir,
+		"wrapper":    "argocd",
+		"argocd": map[string]any{
+			"repo_url": "https://git.example.com/gitops.git",
+			"path":     "deploy/spire",
+		},
+	}))
+	require.NoError(t, err)
+	m := NewSpireManifests(cfg, &fakeRenderer{})
+	ds := newFakeDataSource(t, defaultConfig())
+
+	statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{TrustZoneIDs: []string{"tz1-id"}})
+	require.NoError(t, err)
+	collectStatuses(statusCh)
+
+	assertFileContent(t, filepath.Join(filepath.Dir(outputDir), "gitops", "argocd", "tz1-local1.yaml"), `apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: spire-tz1-local1
+  namespace: argocd
+spec:
+  destination:
+    name: local1
+    namespace: spire-mgmt
+  project: default
+  source:
+    path: deploy/spire/clusters/tz1/local1
+    repoURL: https://git.example.com/gitops.git
+    targetRevision: HEAD
+  syncPolicy:
+    syncOptions:
+      - CreateNamespace=true
+      - ServerSideApply=true
+`)
+}
+
+func TestSpireManifests_Deploy_argoCDWithoutRepoURL(t *testing.T) {
+	m := NewSpireManifests(&Config{OutputDir: t.TempDir(), Wrapper: WrapperArgoCD}, &fakeRenderer{})
+	ds := newFakeDataSource(t, defaultConfig())
+
+	statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{})
+	require.NoError(t, err)
+	want := []*provisionpb.Status{
+		provision.StatusError(
+			"Preparing",
+			"Invalid spire-manifests plugin configuration",
+			errors.New("argocd.repo_url must be set when wrapper is argocd"),
+		),
+	}
+	assert.EqualExportedValues(t, want, collectStatuses(statusCh))
+}
+
+func TestSpireManifests_Deploy_overlappingWrapperDir(t *testing.T) {
+	outputDir := t.TempDir()
+	tests := []struct {
+		name       string
+		wrapperDir string
+	}{
+		{name: "same", wrapperDir: outputDir},
+		{name: "inside output dir", wrapperDir: filepath.Join(outputDir, "gitops")},
+		{name: "contains output dir", wrapperDir: filepath.Dir(outputDir)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewSpireManifests(&Config{OutputDir: outputDir, Wrapper: WrapperFlux, WrapperDir: tt.wrapperDir}, &fakeRenderer{})
+			ds := newFakeDataSource(t, defaultConfig())
+
+			statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{})
+			require.NoError(t, err)
+			statuses := collectStatuses(statusCh)
+			require.Len(t, statuses, 1)
+			assert.Contains(t, statuses[0].GetError(), "must not overlap output_dir")
+			assert.NoDirExists(t, filepath.Join(outputDir, "clusters"))
+		})
+	}
+}
+
+func TestSpireManifests_Deploy_flux(t *testing.T) {
+	outputDir := t.TempDir()
+	wrapperDir := t.TempDir()
+	cfg, err := ParseConfig(newStruct(t, map[string]any{"output_dir": outputDir, "wrapper": "flux", "wrapper_dir": wrapperDir}))
+	require.NoError(t, err)
+	m := NewSpireManifests(cfg, &fakeRenderer{})
+	ds := newFakeDataSource(t, defaultConfig())
+
+	statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{TrustZoneIDs: []string{"tz1-id"}})
+	require.NoError(t, err)
+	collectStatuses(statusCh)
+
+	assert.FileExists(t, filepath.Join(outputDir, "clusters", "tz1", "local1", "spire.yaml"))
+	assert.NoDirExists(t, filepath.Join(outputDir, "flux"))
+	assertFileContent(t, filepath.Join(wrapperDir, "flux", "tz1", "local1", "helmrelease.yaml"), `apiVersion: source.toolkit.fluxcd.io/v1
+kind: HelmRepository
+metadata:
+  name: cofide
+  namespace: flux-system
+spec:
+  interval: 10m
+  url: https://charts.cofide.dev
+---
+apiVersion: helm.toolkit.fluxcd.io/v2
+kind: HelmRelease
+metadata:
+  name: spire-crds
+  namespace: flux-system
+spec:
+  chart:
+    spec:
+      chart: spire-crds
+      sourceRef:
+        kind: HelmRepository
+        name: cofide
+      version: 0.5.0-cofide.1
+  install:
+    createNamespace: true
+  interval: 10m
+  releaseName: spire-crds
+  storageNamespace: spire-mgmt
+  targetNamespace: spire-mgmt
+---
+apiVersion: helm.toolkit.fluxcd.io/v2
+kind: HelmRelease
+metadata:
+  name: spire
+  namespace: flux-system
+spec:
+  chart:
+    spec:
+      chart: spire
+      sourceRef:
+        kind: HelmRepository
+        name: cofide
+      version: 0.27.1-cofide.0
+  dependsOn:
+    - name: spire-crds
+  install:
+    createNamespace: true
+  interval: 10m
+  releaseName: spire
+  storageNamespace: spire-mgmt
+  targetNamespace: spire-mgmt
+  values:
+    global:
+      spire:
+        trustDomain: td1
+`)
+}
+
+func TestSpireManifests_TearDown(t *testing.T) {
+	outputDir := t.TempDir()
+	wrapperDir := t.TempDir()
+	m := NewSpireManifests(&Config{OutputDir: outputDir, Wrapper: WrapperFlux, WrapperDir: wrapperDir}, &fakeRenderer{})
+	ds := newFakeDataSource(t, defaultConfig())
+
+	statusCh, err := m.Deploy(context.Background(), ds, &provision.DeployOpts{TrustZoneIDs: []string{"tz1-id"}})
+	require.NoError(t, err)
+	collectStatuses(statusCh)
+
+	statusCh, err = m.TearDown(context.Background(), ds, &provision.TearDownOpts{})
+	require.NoError(t, err)
+	deletionsFile := filepath.Join(outputDir, "deletions", "tz1", "local1.yaml")
+	want := []*provisionpb.Status{
+		provision.StatusOk("Uninstalling", "Removing SPIRE manifests for local1 in tz1"),
+		provision.StatusDone("Uninstalled", "Wrote resources to delete to "+deletionsFile+" for local1 in tz1"),
+		provision.StatusOk("Uninstalling", "Removing SPIRE manifests for local2 in tz2"),
+		provision.StatusDone("Uninstalled", "No SPIRE manifests found for local2 in tz2"),
+	}
+	assert.EqualExportedValues(t, want, collectStatuses(statusCh))
+
+	assertFileContent(t, deletionsFile, `# Resources to delete, in order. Namespaced resources without a namespace are in spire-mgmt:
+#   kubectl delete --ignore-not-found --namespace spire-mgmt -f <file>
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: spire-server
+  namespace: spire-server
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: spire-server
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: clusterspiffeids.spire.spiffe.io
+`)
+	assert.NoDirExists(t, filepath.Join(outputDir, "clusters", "tz1", "local1"))
+	assert.NoDirExists(t, filepath.Join(wrapperDir, "flux", "tz1", "local1"))
+
+	// Deploying again removes the deletion set.
+	statusCh, err = m.Deploy(context.Background(), ds, &provision.DeployOpts{TrustZoneIDs: []string{"tz1-id"}})
+	require.NoError(t, err)
+	collectStatuses(statusCh)
+	assert.NoFileExists(t, deletionsFile)
+}
+
+func TestSpireManifests_GetHelmValues(t *testing.T) {
+	m := NewSpireManifests(nil, &fakeRenderer{})
+	ds := newFakeDataSource(t, defaultConfig())
+
+	values, err := m.GetHelmValues(context.Background(), ds, &provision.GetHelmValuesOpts{ClusterID: "local1-id"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, values)
+
+	_, err = m.GetHelmValues(context.Background(), ds, &provision.GetHelmValuesOpts{ClusterID: "invalid"})
+	assert.Error(t, err)
+}
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		pluginConfig map[string]any
+		want         *Config
+	}{
+		{
+			name: "defaults",
+			want: &Config{
+				OutputDir:  "manifests",
+				WrapperDir: "gitops",
+				ArgoCD:     ArgoCDConfig{TargetRevision: "HEAD", Path: "manifests", Project: "default", Namespace: "argocd"},
+				Flux:       FluxConfig{Namespace: "flux-system", Interval: "10m"},
+			},
+		},
+		{
+			name: "custom",
+			pluginConfig: map[string]any{
+				"output_dir": "deploy/out",
+				"wrapper":    "argocd",
+				"argocd":     map[string]any{"repo_url": "https://git.example.com/gitops.git", "project": "spire"},
+				"flux":       map[string]any{"interval": "1h"},
+			},
+			want: &Config{
+				OutputDir:  "deploy/out",
+				Wrapper:    "argocd",
+				WrapperDir: "deploy/gitops",
+				ArgoCD: ArgoCDConfig{
+					RepoURL:        "https://git.example.com/gitops.git",
+					TargetRevision: "HEAD",
+					Path:           "deploy/out",
+					Project:        "spire",
+					Namespace:      "argocd",
+				},
+				Flux: FluxConfig{Namespace: "flux-system", Interval: "1h"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pluginConfig *structpb.Struct
+			if tt.pluginConfig != nil {
+				pluginConfig = newStruct(t, tt.pluginConfig)
+			}
+			got, err := ParseConfig(pluginConfig)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSpireManifests_ConfigSchema(t *testing.T) {
+	schema := NewSpireManifests(nil, nil).ConfigSchema()
+	tests := []struct {
+		name         string
+		pluginConfig map[string]any
+		wantErr      string
+	}{
+		{name: "empty", pluginConfig: map[string]any{}},
+		{name: "flux", pluginConfig: map[string]any{"wrapper": "flux"}},
+		{name: "flux with wrapper dir", pluginConfig: map[string]any{"wrapper": "flux", "wrapper_dir": "gitops"}},
+		{name: "argocd", pluginConfig: map[string]any{"wrapper": "argocd", "argocd": map[string]any{"repo_url": "https://git.example.com/gitops.git"}}},
+		{name: "argocd without repo URL", pluginConfig: map[string]any{"wrapper": "argocd"}, wantErr: `argocd.repo_url: incomplete value !=""`},
+		{name: "unknown field", pluginConfig: map[string]any{"foo": "bar"}, wantErr: "foo: field not allowed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := plugin.ValidateConfig(schema, newStruct(t, tt.pluginConfig))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// fakeRenderer renders each chart to a fixed manifest.
+type fakeRenderer struct{}
+
+func (r *fakeRenderer) AddRepository(_ context.Context, statusCh chan<- *provisionpb.Status) error {
+	statusCh <- provision.StatusOk("Preparing", "Adding SPIRE Helm repo")
+	statusCh <- provision.StatusDone("Prepared", "Added SPIRE Helm repo")
+	return nil
+}
+
+func (r *fakeRenderer) Render(
+	_ context.Context,
+	trustZone *trust_zone_proto.TrustZone,
+	_ *clusterpb.Cluster,
+	_ map[string]any,
+) ([]*helm.RenderedChart, error) {
+	return []*helm.RenderedChart{
+		{Name: helm.SPIRECRDChartName, Version: helm.SPIRECRDChartVersion, Values: map[string]any{}, Manifest: fakeCRDsManifest},
+		{
+			Name:     helm.SPIREChartName,
+			Version:  helm.SPIREChartVersion,
+			Values:   map[string]any{"global": map[string]any{"spire": map[string]any{"trustDomain": trustZone.GetTrustDomain()}}},
+			Manifest: fakeSPIREManifest,
+		},
+	}, nil
+}
+
+func collectStatuses(statusCh <-chan *provisionpb.Status) []*provisionpb.Status {
+	statuses := []*provisionpb.Status{}
+	for status := range statusCh {
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func assertFileContent(t *testing.T, path, want string) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, string(data))
+}
+
+func newStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
+
+func newFakeDataSource(t *testing.T, cfg *config.Config) datasource.DataSource {
+	configLoader, err := config.NewMemoryLoader(cfg)
+	require.NoError(t, err)
+	lds, err := local.NewLocalDataSource(configLoader)
+	require.NoError(t, err)
+	return lds
+}
+
+func defaultConfig() *config.Config {
+	return &config.Config{
+		TrustZones: []*trust_zone_proto.TrustZone{
+			fixtures.TrustZone("tz1"),
+			fixtures.TrustZone("tz2"),
+		},
+		Clusters: []*clusterpb.Cluster{
+			fixtures.Cluster("local1"),
+			fixtures.Cluster("local2"),
+		},
+		Plugins: fixtures.Plugins("plugins1"),
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package spiremanifests
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+
+	"github.com/cofide/cofidectl/pkg/provider/helm"
+	"gopkg.in/yaml.v3"
+)
+
+// argoCDApplication returns an Argo CD Application that syncs the manifests of a cluster from
+// the Git repository to the cluster. The destination cluster is the Argo CD cluster with the same
+// name as the cofidectl cluster.
+func argoCDApplication(config *Config, trustZoneName, clusterName string) map[string]any {
+	return map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Application",
+		"metadata": map[string]any{
+			"name":      fmt.Sprintf("spire-%s-%s", trustZoneName, clusterName),
+			"namespace": config.ArgoCD.Namespace,
+		},
+		"spec": map[string]any{
+			"project": config.ArgoCD.Project,
+			"source": map[string]any{
+				"repoURL":        config.ArgoCD.RepoURL,
+				"targetRevision": config.ArgoCD.TargetRevision,
+				"path":           path.Join(config.ArgoCD.Path, clustersDir, trustZoneName, clusterName),
+			},
+			"destination": map[string]any{
+				"name":      clusterName,
+				"namespace": helm.SPIREManagementNamespace,
+			},
+			"syncPolicy": map[string]any{
+				"syncOptions": []string{"CreateNamespace=true", "ServerSideApply=true"},
+			},
+		},
+	}
+}
+
+// fluxHelmResources returns a Flux HelmRepository for the SPIRE Helm repository and a HelmRelease
+// for each chart, which Flux installs in order.
+func fluxHelmResources(config *Config, charts []*helm.RenderedChart) []map[string]any {
+	resources := []map[string]any{
+		{
+			"apiVersion": "source.toolkit.fluxcd.io/v1",
+			"kind":       "HelmRepository",
+			"metadata": map[string]any{
+				"name":      helm.SPIRERepositoryName,
+				"namespace": config.Flux.Namespace,
+			},
+			"spec": map[string]any{
+				"interval": config.Flux.Interval,
+				"url":      helm.SPIRERepositoryURL,
+			},
+		},
+	}
+
+	var previous string
+	for _, chart := range charts {
+		spec := map[string]any{
+			"interval":         config.Flux.Interval,
+			"releaseName":      chart.Name,
+			"targetNamespace":  helm.SPIREManagementNamespace,
+			"storageNamespace": helm.SPIREManagementNamespace,
+			"install":          map[string]any{"createNamespace": true},
+			"chart": map[string]any{
+				"spec": map[string]any{
+					"chart":   chart.Name,
+					"version": chart.Version,
+					"sourceRef": map[string]any{
+						"kind": "HelmRepository",
+						"name": helm.SPIRERepositoryName,
+					},
+				},
+			},
+		}
+		if len(chart.Values) > 0 {
+			spec["values"] = chart.Values
+		}
+		if previous != "" {
+			spec["dependsOn"] = []map[string]any{{"name": previous}}
+		}
+		resources = append(resources, map[string]any{
+			"apiVersion": "helm.toolkit.fluxcd.io/v2",
+			"kind":       "HelmRelease",
+			"metadata": map[string]any{
+				"name":      chart.Name,
+				"namespace": config.Flux.Namespace,
+			},
+			"spec": spec,
+		})
+		previous = chart.Name
+	}
+	return resources
+}
+
+// marshalDocuments encodes resources as a multi-document YAML string.
+func marshalDocuments[T any](resources ...T) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	for _, resource := range resources {
+		if err := encoder.Encode(resource); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// SignatureExtension is appended to the name of a plugin binary to give the name of its
+	// detached signature file.
+	SignatureExtension = ".sig"
+	// AllowUnsignedPluginsEnvVar may be set to true to allow unsigned plugins, as an alternative
+	// to the --allow-unsigned-plugins flag. This is an intentional opt-out of signature
+	// verification for development and CI environments, where plugins are built locally and
+	// not signed. It is passed to CLI plugins, and applies to CLI plugin placeholder commands,
+	// which do not parse the root command's flags.
+	AllowUnsignedPluginsEnvVar = "COFIDECTL_ALLOW_UNSIGNED_PLUGINS"
+	// TrustedKeysDirEnvVar may be set to the directory containing trusted public keys, to
+	// override the default of ~/.cofide/trusted-keys.
+	TrustedKeysDirEnvVar = "COFIDECTL_TRUSTED_KEYS_DIR"
+
+	relativeTrustedKeysDir = ".cofide/trusted-keys"
+)
+
+var (
+	// ErrPluginUnsigned is returned when a plugin binary has no signature file.
+	ErrPluginUnsigned = errors.New("plugin is not signed")
+	// ErrInvalidSignature is returned when a plugin signature was not made by a trusted key.
+	ErrInvalidSignature = errors.New("plugin signature does not match any trusted key")
+)
+
+// SignaturePolicy determines whether a plugin binary may be executed, based on a detached
+// ed25519 signature of the binary in a file next to it with the SignatureExtension.
+// The signature may be raw or base64-encoded, e.g. as produced by:
+//
+//	openssl pkeyutl -sign -inkey key.pem -rawin -in PLUGIN | base64 > PLUGIN.sig
+type SignaturePolicy struct {
+	// TrustedKeysDir contains files with PEM-encoded ed25519 public keys. A signature by any of
+	// these keys is trusted.
+	TrustedKeysDir string
+	// AllowUnsigned allows plugins that are unsigned, or whose signatures are not trusted, to be
+	// executed with a warning.
+	AllowUnsigned bool
+}
+
+// NewSignaturePolicy returns a SignaturePolicy that trusts the keys in the directory given by
+// COFIDECTL_TRUSTED_KEYS_DIR, or ~/.cofide/trusted-keys if it is not set.
+func NewSignaturePolicy(allowUnsigned bool) (*SignaturePolicy, error) {
+	keysDir, err := GetTrustedKeysDir()
+	if err != nil {
+		return nil, err
+	}
+	return &SignaturePolicy{
+		TrustedKeysDir: keysDir,
+		AllowUnsigned:  allowUnsigned,
+	}, nil
+}
+
+// GetTrustedKeysDir returns the directory containing trusted public keys: the value of
+// COFIDECTL_TRUSTED_KEYS_DIR if set, otherwise ~/.cofide/trusted-keys.
+func GetTrustedKeysDir() (string, error) {
+	if dir := os.Getenv(TrustedKeysDirEnvVar); dir != "" {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return "", fmt.Errorf("invalid trusted keys directory %s from %s: %w", dir, TrustedKeysDirEnvVar, err)
+		}
+		return absDir, nil
+	}
+	usr, err := user.Current()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home: %w", err)
+	}
+	return filepath.Join(usr.HomeDir, relativeTrustedKeysDir), nil
+}
+
+// AllowUnsignedFromEnv returns whether unsigned plugins are allowed by the
+// COFIDECTL_ALLOW_UNSIGNED_PLUGINS environment variable.
+func AllowUnsignedFromEnv() bool {
+	allow, err := strconv.ParseBool(os.Getenv(AllowUnsignedPluginsEnvVar))
+	return err == nil && allow
+}
+
+// Verify returns an error if the plugin binary at pluginPath may not be executed.
+func (p *SignaturePolicy) Verify(pluginPath string) error {
+	keys, err := LoadTrustedKeys(p.TrustedKeysDir)
+	if err != nil {
+		return err
+	}
+
+	err = VerifySignature(pluginPath, keys)
+	if err != nil && p.AllowUnsigned {
+		slog.Warn("Executing plugin without a trusted signature", "plugin", filepath.Base(pluginPath), "error", err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("refusing to execute plugin %s: %w (use --allow-unsigned-plugins to override)", filepath.Base(pluginPath), err)
+	}
+	return nil
+}
+
+// VerifySignature checks the detached signature of the plugin binary at pluginPath against the
+// trusted keys.
+func VerifySignature(pluginPath string, keys []ed25519.PublicKey) error {
+	signature, err := readSignature(pluginPath + SignatureExtension)
+	if err != nil {
+		return err
+	}
+
+	binary, err := os.ReadFile(pluginPath)
+	if err != nil {
+		return fmt.Errorf("failed to read plugin: %w", err)
+	}
+
+	for _, key := range keys {
+		if ed25519.Verify(key, binary, signature) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// readSignature reads a raw or base64-encoded ed25519 signature from path.
+func readSignature(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrPluginUnsigned
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read plugin signature: %w", err)
+	}
+
+	if len(data) == ed25519.SignatureSize {
+		return data, nil
+	}
+	signature, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("invalid plugin signature file %s: expected a raw or base64-encoded ed25519 signature", path)
+	}
+	return signature, nil
+}
+
+// LoadTrustedKeys returns the ed25519 public keys in the PEM files in dir. A missing directory is
+// treated as empty.
+func LoadTrustedKeys(dir string) ([]ed25519.PublicKey, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []ed25519.PublicKey{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read trusted keys directory: %w", err)
+	}
+
+	keys := []ed25519.PublicKey{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trusted key: %w", err)
+		}
+		fileKeys, err := parsePublicKeys(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key file %s: %w", path, err)
+		}
+		keys = append(keys, fileKeys...)
+	}
+	return keys, nil
+}
+
+// parsePublicKeys parses the PEM-encoded ed25519 public keys in data.
+func parsePublicKeys(data []byte) ([]ed25519.PublicKey, error) {
+	keys := []ed25519.PublicKey{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("unexpected public key type %T, expected an ed25519 key", key)
+		}
+		keys = append(keys, edKey)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM-encoded public keys found")
+	}
+	return keys, nil
+}
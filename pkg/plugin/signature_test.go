@@ -0,0 +1,203 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return public, private
+}
+
+func writeTrustedKey(t *testing.T, dir, name string, key ed25519.PublicKey) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0600))
+}
+
+func signTestPlugin(t *testing.T, pluginPath string, key ed25519.PrivateKey) {
+	binary, err := os.ReadFile(pluginPath)
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(key, binary))
+	require.NoError(t, os.WriteFile(pluginPath+SignatureExtension, []byte(signature+"\n"), 0644))
+}
+
+func TestSignaturePolicy_Verify(t *testing.T) {
+	trustedPublic, trustedPrivate := newTestKey(t)
+	_, untrustedPrivate := newTestKey(t)
+
+	tests := []struct {
+		name          string
+		sign          func(t *testing.T, pluginPath string)
+		allowUnsigned bool
+		wantErr       error
+	}{
+		{
+			name:    "trusted",
+			sign:    func(t *testing.T, pluginPath string) { signTestPlugin(t, pluginPath, trustedPrivate) },
+			wantErr: nil,
+		},
+		{
+			name: "trusted raw signature",
+			sign: func(t *testing.T, pluginPath string) {
+				binary, err := os.ReadFile(pluginPath)
+				require.NoError(t, err)
+				require.NoError(t, os.WriteFile(pluginPath+SignatureExtension, ed25519.Sign(trustedPrivate, binary), 0644))
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "unsigned",
+			sign:    func(t *testing.T, pluginPath string) {},
+			wantErr: ErrPluginUnsigned,
+		},
+		{
+			name:    "untrusted",
+			sign:    func(t *testing.T, pluginPath string) { signTestPlugin(t, pluginPath, untrustedPrivate) },
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "modified",
+			sign: func(t *testing.T, pluginPath string) {
+				signTestPlugin(t, pluginPath, trustedPrivate)
+				require.NoError(t, os.WriteFile(pluginPath, []byte("tampered"), 0755))
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:          "unsigned allowed",
+			sign:          func(t *testing.T, pluginPath string) {},
+			allowUnsigned: true,
+			wantErr:       nil,
+		},
+		{
+			name:          "untrusted allowed",
+			sign:          func(t *testing.T, pluginPath string) { signTestPlugin(t, pluginPath, untrustedPrivate) },
+			allowUnsigned: true,
+			wantErr:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keysDir := filepath.Join(t.TempDir(), "trusted-keys")
+			writeTrustedKey(t, keysDir, "cofide.pem", trustedPublic)
+			pluginPath := writeTestPlugin(t, t.TempDir(), "my-plugin", "binary")
+			tt.sign(t, pluginPath)
+
+			policy := &SignaturePolicy{TrustedKeysDir: keysDir, AllowUnsigned: tt.allowUnsigned}
+			err := policy.Verify(pluginPath)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorContains(t, err, "use --allow-unsigned-plugins to override")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifySignature_invalidSignatureFile(t *testing.T) {
+	pluginPath := writeTestPlugin(t, t.TempDir(), "my-plugin", "binary")
+	require.NoError(t, os.WriteFile(pluginPath+SignatureExtension, []byte("not a signature"), 0644))
+
+	err := VerifySignature(pluginPath, nil)
+	assert.ErrorContains(t, err, "expected a raw or base64-encoded ed25519 signature")
+}
+
+func TestLoadTrustedKeys(t *testing.T) {
+	key1, _ := newTestKey(t)
+	key2, _ := newTestKey(t)
+	dir := t.TempDir()
+	writeTrustedKey(t, dir, "key1.pem", key1)
+	writeTrustedKey(t, dir, "key2.pem", key2)
+
+	keys, err := LoadTrustedKeys(dir)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []ed25519.PublicKey{key1, key2}, keys)
+
+	keys, err = LoadTrustedKeys(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.pem"), []byte("invalid"), 0600))
+	_, err = LoadTrustedKeys(dir)
+	assert.ErrorContains(t, err, "no PEM-encoded public keys found")
+}
+
+func TestInstallPlugin_signature(t *testing.T) {
+	public, private := newTestKey(t)
+	pluginDir := t.TempDir()
+	sourceDir := t.TempDir()
+	source := writeTestPlugin(t, sourceDir, "my-plugin", "binary")
+	signTestPlugin(t, source, private)
+
+	installed, err := InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+	require.NoError(t, VerifySignature(installed.Path, []ed25519.PublicKey{public}))
+
+	// Reinstalling without a signature removes the stale signature.
+	require.NoError(t, os.Remove(source+SignatureExtension))
+	_, err = InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, VerifySignature(installed.Path, []ed25519.PublicKey{public}), ErrPluginUnsigned)
+
+	// Signature files are not listed as plugins.
+	signTestPlugin(t, installed.Path, private)
+	plugins, err := ListPlugins(pluginDir)
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	assert.Equal(t, "my-plugin", plugins[0].Name)
+
+	require.NoError(t, RemovePlugin(pluginDir, "my-plugin"))
+	assert.NoFileExists(t, installed.Path+SignatureExtension)
+}
+
+func TestInstallPlugin_tarballSignature(t *testing.T) {
+	public, private := newTestKey(t)
+	pluginDir := t.TempDir()
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(private, []byte("binary")))
+	source := filepath.Join(t.TempDir(), "plugin.tgz")
+	writeTestTarball(t, source, map[string]string{"my-plugin.sig": signature, "my-plugin": "binary"})
+
+	installed, err := InstallPlugin(pluginDir, source, "")
+	require.NoError(t, err)
+	assert.NoError(t, VerifySignature(installed.Path, []ed25519.PublicKey{public}))
+}
+
+func TestAllowUnsignedFromEnv(t *testing.T) {
+	t.Setenv(AllowUnsignedPluginsEnvVar, "")
+	assert.False(t, AllowUnsignedFromEnv())
+	t.Setenv(AllowUnsignedPluginsEnvVar, "true")
+	assert.True(t, AllowUnsignedFromEnv())
+	t.Setenv(AllowUnsignedPluginsEnvVar, "not-a-bool")
+	assert.False(t, AllowUnsignedFromEnv())
+}
+
+func TestGetTrustedKeysDir(t *testing.T) {
+	keysDir := t.TempDir()
+	t.Setenv(TrustedKeysDirEnvVar, keysDir)
+	got, err := GetTrustedKeysDir()
+	require.NoError(t, err)
+	assert.Equal(t, keysDir, got)
+
+	t.Setenv(TrustedKeysDirEnvVar, "")
+	got, err = GetTrustedKeysDir()
+	require.NoError(t, err)
+	assert.Equal(t, relativeTrustedKeysDir, filepath.Join(filepath.Base(filepath.Dir(got)), filepath.Base(got)))
+}
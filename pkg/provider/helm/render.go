@@ -0,0 +1,93 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// RenderedChart contains the Kubernetes manifests rendered from a Helm chart.
+type RenderedChart struct {
+	// Name is the name of the chart, which is also used as the release name.
+	Name string
+	// Version is the version of the chart.
+	Version string
+	// Values are the values used to render the chart.
+	Values map[string]any
+	// Manifest contains the rendered resources as a multi-document YAML string.
+	Manifest string
+}
+
+// Render renders the SPIRE CRDs and SPIRE Helm charts to Kubernetes manifests, as they would be
+// installed by Execute. The charts are templated locally, without contacting the cluster.
+func (h *HelmSPIREProvider) Render() ([]*RenderedChart, error) {
+	charts := []*RenderedChart{}
+	if h.installCRDs {
+		chart, err := h.renderChart(h.spireCRDChartName, h.spireCRDChartVersion, h.spireCRDsValues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s chart: %w", h.spireCRDChartName, err)
+		}
+		charts = append(charts, chart)
+	}
+
+	chart, err := h.renderChart(h.spireChartName, h.spireChartVersion, h.spireValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s chart: %w", h.spireChartName, err)
+	}
+	return append(charts, chart), nil
+}
+
+func (h *HelmSPIREProvider) renderChart(chartName string, version string, values map[string]any) (*RenderedChart, error) {
+	// A client-only dry run installs the release into in-memory storage, as for `helm template`.
+	client := newInstall(&action.Configuration{Log: DiscardLogger}, chartName, version)
+	client.DryRun = true
+	client.ClientOnly = true
+	client.IncludeCRDs = true
+
+	chartRef, err := getChartRef(h.spireRepositoryName, chartName)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := client.LocateChart(chartRef, h.settings)
+	if err != nil {
+		return nil, err
+	}
+
+	cr, err := loader.Load(options)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := client.RunWithContext(h.ctx, cr, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RenderedChart{
+		Name:     chartName,
+		Version:  cr.Metadata.Version,
+		Values:   values,
+		Manifest: renderedManifest(rel),
+	}, nil
+}
+
+// renderedManifest returns the manifest of a release, including any hooks other than tests.
+func renderedManifest(rel *release.Release) string {
+	var sb strings.Builder
+	sb.WriteString(rel.Manifest)
+	for _, hook := range rel.Hooks {
+		if slices.Contains(hook.Events, release.HookTest) {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n---\n# Source: %s\n%s", hook.Path, hook.Manifest)
+	}
+	return sb.String()
+}
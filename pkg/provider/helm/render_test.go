@@ -0,0 +1,80 @@
+// Copyright 2026 Cofide Limited.
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
+	"github.com/cofide/cofidectl/internal/pkg/test/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeChart writes a minimal chart with a single template to dir.
+func writeChart(t *testing.T, dir, name, template string) {
+	chartDir := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Join(chartDir, "templates"), 0755))
+	chartYAML := "apiVersion: v2\nname: " + name + "\nversion: 1.2.3\n"
+	require.NoError(t, os.WriteFile(filepath.Join(chartDir, "Chart.yaml"), []byte(chartYAML), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(chartDir, "templates", "resource.yaml"), []byte(template), 0644))
+}
+
+func TestHelmSPIREProvider_Render(t *testing.T) {
+	repoDir := t.TempDir()
+	writeChart(t, repoDir, SPIRECRDChartName, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: crds\n")
+	writeChart(t, repoDir, SPIREChartName, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  trustDomain: {{ .Values.trustDomain }}
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test
+  annotations:
+    helm.sh/hook: test
+`)
+	t.Setenv("HELM_REPO_PATH", repoDir)
+
+	cluster := &clusterpb.Cluster{Name: fixtures.StringPtr("fake-cluster")}
+	values := map[string]any{"trustDomain": "td1"}
+	p, err := NewHelmSPIREProvider(context.Background(), "fake-trust-zone", cluster, values, map[string]any{})
+	require.NoError(t, err)
+
+	charts, err := p.Render()
+	require.NoError(t, err)
+	require.Len(t, charts, 2)
+
+	assert.Equal(t, SPIRECRDChartName, charts[0].Name)
+	assert.Equal(t, "1.2.3", charts[0].Version)
+	assert.Contains(t, charts[0].Manifest, "name: crds")
+
+	assert.Equal(t, SPIREChartName, charts[1].Name)
+	assert.Equal(t, values, charts[1].Values)
+	assert.Contains(t, charts[1].Manifest, "name: spire\n  namespace: spire-mgmt")
+	assert.Contains(t, charts[1].Manifest, "trustDomain: td1")
+	assert.NotContains(t, charts[1].Manifest, "helm.sh/hook: test")
+}
+
+func TestHelmSPIREProvider_Render_withoutCRDs(t *testing.T) {
+	repoDir := t.TempDir()
+	writeChart(t, repoDir, SPIREChartName, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: spire\n")
+	t.Setenv("HELM_REPO_PATH", repoDir)
+
+	cluster := &clusterpb.Cluster{Name: fixtures.StringPtr("fake-cluster")}
+	p, err := NewHelmSPIREProvider(context.Background(), "fake-trust-zone", cluster, nil, nil, WithInstallSPIRECRDs(false))
+	require.NoError(t, err)
+
+	charts, err := p.Render()
+	require.NoError(t, err)
+	require.Len(t, charts, 1)
+	assert.Equal(t, SPIREChartName, charts[0].Name)
+}
@@ -7,11 +7,9 @@ import (
 	"fmt"
 
 	clusterpb "github.com/cofide/cofidectl-sdk/gen/go/proto/cluster/v1alpha1"
-	datasourcepb "github.com/cofide/cofidectl-sdk/gen/go/proto/cofidectl/datasource_plugin/v1alpha2"
 	trust_zone_proto "github.com/cofide/cofidectl-sdk/gen/go/proto/trust_zone/v1alpha1"
-	"github.com/cofide/cofidectl/internal/pkg/attestationpolicy"
-	"github.com/cofide/cofidectl/internal/pkg/federation"
 	"github.com/cofide/cofidectl/internal/pkg/trustprovider"
+	"github.com/cofide/cofidectl/internal/pkg/trustzone"
 	"github.com/cofide/cofidectl/pkg/plugin/datasource"
 )
 
@@ -177,67 +175,35 @@ func (g *HelmValuesGenerator) GenerateValues() (map[string]any, error) {
 			return nil, fmt.Errorf("failed to get clusterStaticEntries map from identities: %w", err)
 		}
 
-		filter := &datasourcepb.ListAPBindingsRequest_Filter{TrustZoneId: g.trustZone.Id}
-		bindings, err := g.source.ListAPBindings(filter)
+		crds, err := trustzone.GetSPIRECRDs(g.trustZone, g.source)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list attestation policy bindings: %w", err)
+			return nil, err
 		}
 
 		// Adds the attestation policies as either ClusterSPIFFEID or ClusterStaticEntry CRs to be reconciled by the spire-controller-manager.
-		for _, binding := range bindings {
-			policy, err := g.source.GetAttestationPolicy(binding.GetPolicyId())
-			if err != nil {
-				return nil, err
-			}
-
-			if kubernetes := policy.GetKubernetes(); kubernetes != nil {
-				clusterSPIFFEID, err := attestationpolicy.MakeClusterSPIFFEID(policy.GetKubernetes(), g.source, binding)
-				if err != nil {
-					return nil, err
-				}
-
-				csids[policy.GetName()] = clusterSPIFFEID
-			} else if static := policy.GetStatic(); static != nil {
-				clusterStaticEntry, err := attestationpolicy.MakeClusterStaticEntry(policy.GetStatic(), g.source, binding)
-				if err != nil {
-					return nil, err
-				}
-
-				cses[policy.GetName()] = clusterStaticEntry
-			}
+		for _, csid := range crds.ClusterSPIFFEIDs {
+			csids[csid.GetName()] = csid.Spec.HelmValues()
 		}
-
-		federationFilter := &datasourcepb.ListFederationsRequest_Filter{TrustZoneId: g.trustZone.Id}
-		federations, err := g.source.ListFederations(federationFilter)
-		if err != nil {
-			return nil, err
+		for _, cse := range crds.ClusterStaticEntries {
+			cses[cse.GetName()] = cse.Spec.HelmValues()
 		}
-		// Adds the federations as ClusterFederatedTrustDomain CRs
-		if len(federations) > 0 {
-			for _, fed := range federations {
-				tz, err := g.source.GetTrustZone(fed.GetRemoteTrustZoneId())
-				if err != nil {
-					return nil, err
-				}
 
-				if tz.GetBundleEndpointUrl() != "" {
-					fedMap, err := getOrCreateNestedMap(spireServer, "federation")
-					if err != nil {
-						return nil, fmt.Errorf("failed to get federation map from spireServer: %w", err)
-					}
+		// Adds the federations as ClusterFederatedTrustDomain CRs
+		if len(crds.ClusterFederatedTrustDomains) > 0 {
+			fedMap, err := getOrCreateNestedMap(spireServer, "federation")
+			if err != nil {
+				return nil, fmt.Errorf("failed to get federation map from spireServer: %w", err)
+			}
 
-					fedMap["enabled"] = true
+			fedMap["enabled"] = true
 
-					cftd, err := getOrCreateNestedMap(identities, "clusterFederatedTrustDomains")
-					if err != nil {
-						return nil, fmt.Errorf("failed to get clusterFederatedTrustDomains map from identities: %w", err)
-					}
+			cftd, err := getOrCreateNestedMap(identities, "clusterFederatedTrustDomains")
+			if err != nil {
+				return nil, fmt.Errorf("failed to get clusterFederatedTrustDomains map from identities: %w", err)
+			}
 
-					cftd[tz.GetName()], err = federation.NewFederation(tz).GetHelmConfig()
-					if err != nil {
-						return nil, err
-					}
-				}
+			for _, fed := range crds.ClusterFederatedTrustDomains {
+				cftd[fed.GetName()] = fed.Spec.HelmValues()
 			}
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
@@ -142,6 +143,8 @@ type Agent struct {
 	ExpirationTime  time.Time
 	Serial          string
 	CanReattest     bool
+	Banned          bool
+	Selectors       []string
 }
 
 // GetAgentStatus queries a SPIRE server for the status of agents attested to it and returns an `*AgentStatus`.
@@ -275,6 +278,50 @@ func GetRegistrationEntries(ctx context.Context, client *kubeutil.Client) (map[s
 	return registrationEntriesMap, nil
 }
 
+// Entry is a SPIRE registration entry.
+type Entry struct {
+	ID            string
+	SPIFFEID      string
+	ParentID      string
+	Selectors     []string
+	DNSNames      []string
+	FederatesWith []string
+	X509SVIDTTL   time.Duration
+	JWTSVIDTTL    time.Duration
+	Admin         bool
+	Downstream    bool
+	Hint          string
+	// ExpiresAt is the zero time if the entry does not expire.
+	ExpiresAt time.Time
+}
+
+// ListEntries retrieves all registration entries by exec'ing into a SPIRE server.
+func ListEntries(ctx context.Context, client *kubeutil.Client) ([]*Entry, error) {
+	command := []string{"entry", "show", "-output", "json"}
+	stdout, _, err := execInServerContainer(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+	return parseEntries(stdout)
+}
+
+// GetEntry retrieves a registration entry by its ID by exec'ing into a SPIRE server.
+func GetEntry(ctx context.Context, client *kubeutil.Client, id string) (*Entry, error) {
+	command := []string{"entry", "show", "-entryID", id, "-output", "json"}
+	stdout, _, err := execInServerContainer(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := parseEntries(stdout)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, fmt.Errorf("failed to find registration entry %s", id)
+	}
+	return entries[0], nil
+}
+
 // WaitForServerIP waits for a SPIRE server pod and service to become ready, then returns the external IP of the service.
 func WaitForServerIP(ctx context.Context, client *kubeutil.Client) (string, error) {
 	podWatcher, err := createPodWatcher(ctx, client)
@@ -342,6 +389,87 @@ func GetBundle(ctx context.Context, client *kubeutil.Client) (*types.Bundle, err
 	return parseBundleShow(stdout)
 }
 
+// GetAgent retrieves a SPIRE agent by its SPIFFE ID by exec'ing into a SPIRE server.
+func GetAgent(ctx context.Context, client *kubeutil.Client, id string) (*Agent, error) {
+	command := []string{"agent", "show", "-spiffeID", id, "-output", "json"}
+	stdout, _, err := execInServerContainer(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+	return parseAgentShow(stdout)
+}
+
+// EvictAgent evicts a SPIRE agent by exec'ing into a SPIRE server. The agent must re-attest to
+// obtain a new SVID.
+func EvictAgent(ctx context.Context, client *kubeutil.Client, id string) error {
+	command := []string{"agent", "evict", "-spiffeID", id}
+	_, _, err := execInServerContainer(ctx, client, command)
+	return err
+}
+
+// BanAgent bans a SPIRE agent by exec'ing into a SPIRE server. The agent is prevented from
+// re-attesting until it is evicted.
+func BanAgent(ctx context.Context, client *kubeutil.Client, id string) error {
+	command := []string{"agent", "ban", "-spiffeID", id}
+	_, _, err := execInServerContainer(ctx, client, command)
+	return err
+}
+
+// JoinToken is a join token used to attest a SPIRE agent.
+type JoinToken struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+// CreateJoinToken creates a join token with the specified TTL by exec'ing into a SPIRE server.
+// If spiffeID is non-empty, the server also creates an entry that aliases the agent to it.
+func CreateJoinToken(ctx context.Context, client *kubeutil.Client, ttl time.Duration, spiffeID string) (*JoinToken, error) {
+	command := []string{"token", "generate", "-ttl", strconv.Itoa(int(ttl.Seconds())), "-output", "json"}
+	if spiffeID != "" {
+		command = append(command, "-spiffeID", spiffeID)
+	}
+	stdout, _, err := execInServerContainer(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+	return parseTokenGenerate(stdout, time.Now().Add(ttl))
+}
+
+// AttestedJoinToken is a join token that has been used to attest a SPIRE agent.
+type AttestedJoinToken struct {
+	Value string
+	// AgentID is the SPIFFE ID of the agent attested using the token.
+	AgentID string
+	// AliasIDs are the SPIFFE IDs of entries that alias the agent.
+	AliasIDs []string
+	// ExpirationTime is the expiry time of the agent's SVID.
+	ExpirationTime time.Time
+}
+
+// ListAttestedJoinTokens returns the join tokens that have been used to attest SPIRE agents by
+// exec'ing into a SPIRE server. SPIRE does not provide a way to list join tokens that have not
+// been used.
+func ListAttestedJoinTokens(ctx context.Context, client *kubeutil.Client) ([]*AttestedJoinToken, error) {
+	stdout, _, err := execInServerContainer(ctx, client, []string{"agent", "list", "-output", "json"})
+	if err != nil {
+		return nil, err
+	}
+	agents, err := parseAgentList(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, _, err = execInServerContainer(ctx, client, []string{"entry", "show", "-output", "json"})
+	if err != nil {
+		return nil, err
+	}
+	entries, err := parseEntryList(stdout)
+	if err != nil {
+		return nil, err
+	}
+	return getAttestedJoinTokens(agents, entries)
+}
+
 func createPodWatcher(ctx context.Context, client *kubeutil.Client) (watch.Interface, error) {
 	watchFunc := func(opts metav1.ListOptions) (watch.Interface, error) {
 		timeout := int64(120)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -17,10 +18,11 @@ import (
 )
 
 const (
-	k8sPSATSelectorType     = "k8s_psat"
-	agentPodNameSelector    = "agent_pod_name:"
-	k8sSelectorType         = "k8s"
-	k8sPodUIDSelectorPrefix = "pod-uid:"
+	joinTokenAttestationType = "join_token"
+	k8sPSATSelectorType      = "k8s_psat"
+	agentPodNameSelector     = "agent_pod_name:"
+	k8sSelectorType          = "k8s"
+	k8sPodUIDSelectorPrefix  = "pod-uid:"
 )
 
 // execInServerContainer executes a command in the SPIRE server container.
@@ -58,10 +60,35 @@ type agentJson struct {
 	ExpirationTime  string            `json:"x509svid_expires_at"`
 	Serial          string            `json:"x509svid_serial_number"`
 	CanReattest     bool              `json:"can_reattest"`
+	Banned          bool              `json:"banned"`
 	Id              *types.SPIFFEID   `json:"id"`
 	Selectors       []*types.Selector `json:"selectors"`
 }
 
+func (a agentJson) toAgent() Agent {
+	podName := getPodNameSelector(a)
+	id := fmt.Sprintf("spiffe://%s%s", a.Id.TrustDomain, a.Id.Path)
+	expTime, err := strconv.ParseInt(a.ExpirationTime, 10, 64)
+	if err != nil {
+		fmt.Println("unable to parse agent expiration timestamp:", a.ExpirationTime)
+	}
+	selectors := make([]string, 0, len(a.Selectors))
+	for _, selector := range a.Selectors {
+		selectors = append(selectors, fmt.Sprintf("%s:%s", selector.Type, selector.Value))
+	}
+	return Agent{
+		Name:            podName,
+		Status:          "unknown",
+		Id:              id,
+		AttestationType: a.AttestationType,
+		ExpirationTime:  time.Unix(expTime, 0),
+		Serial:          a.Serial,
+		CanReattest:     a.CanReattest,
+		Banned:          a.Banned,
+		Selectors:       selectors,
+	}
+}
+
 // parseAgentList parses the output of `spire-server agent list -output json` and returns a slice of `Agent`.
 func parseAgentList(output []byte) ([]Agent, error) {
 	agents := &agentListJson{}
@@ -72,26 +99,24 @@ func parseAgentList(output []byte) ([]Agent, error) {
 
 	statuses := []Agent{}
 	for _, agent := range agents.Agents {
-		podName := getPodNameSelector(agent)
-		id := fmt.Sprintf("spiffe://%s%s", agent.Id.TrustDomain, agent.Id.Path)
-		expTime, err := strconv.ParseInt(agent.ExpirationTime, 10, 64)
-		if err != nil {
-			fmt.Println("unable to parse agent expiration timestamp:", agent.ExpirationTime)
-		}
-		status := Agent{
-			Name:            podName,
-			Status:          "unknown",
-			Id:              id,
-			AttestationType: agent.AttestationType,
-			ExpirationTime:  time.Unix(expTime, 0),
-			Serial:          agent.Serial,
-			CanReattest:     agent.CanReattest,
-		}
-		statuses = append(statuses, status)
+		statuses = append(statuses, agent.toAgent())
 	}
 	return statuses, nil
 }
 
+// parseAgentShow parses the output of `spire-server agent show -output json`.
+func parseAgentShow(output []byte) (*Agent, error) {
+	agent := &agentJson{}
+	if err := json.Unmarshal(output, agent); err != nil {
+		return nil, err
+	}
+	if agent.Id == nil {
+		return nil, fmt.Errorf("no agent in SPIRE server output")
+	}
+	result := agent.toAgent()
+	return &result, nil
+}
+
 func getPodNameSelector(agent agentJson) string {
 	for _, selector := range agent.Selectors {
 		if selector.Type == k8sPSATSelectorType && strings.HasPrefix(selector.Value, agentPodNameSelector) {
@@ -106,8 +131,57 @@ type entryListJson struct {
 }
 
 type entryJson struct {
-	Selectors []*types.Selector `json:"selectors"`
-	Id        *types.SPIFFEID   `json:"spiffe_id"`
+	EntryId       string            `json:"id"`
+	Selectors     []*types.Selector `json:"selectors"`
+	Id            *types.SPIFFEID   `json:"spiffe_id"`
+	ParentId      *types.SPIFFEID   `json:"parent_id"`
+	DNSNames      []string          `json:"dns_names"`
+	FederatesWith []string          `json:"federates_with"`
+	X509SVIDTTL   int32             `json:"x509_svid_ttl"`
+	JWTSVIDTTL    int32             `json:"jwt_svid_ttl"`
+	Admin         bool              `json:"admin"`
+	Downstream    bool              `json:"downstream"`
+	Hint          string            `json:"hint"`
+	ExpiresAt     string            `json:"expires_at"`
+}
+
+func (e entryJson) toEntry() (*Entry, error) {
+	if e.Id == nil || e.ParentId == nil {
+		return nil, fmt.Errorf("entry %s has no SPIFFE ID or parent ID", e.EntryId)
+	}
+	spiffeID, err := formatIdUrl(e.Id)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := formatIdUrl(e.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	selectors := make([]string, 0, len(e.Selectors))
+	for _, selector := range e.Selectors {
+		selectors = append(selectors, fmt.Sprintf("%s:%s", selector.Type, selector.Value))
+	}
+	entry := &Entry{
+		ID:            e.EntryId,
+		SPIFFEID:      spiffeID,
+		ParentID:      parentID,
+		Selectors:     selectors,
+		DNSNames:      e.DNSNames,
+		FederatesWith: e.FederatesWith,
+		X509SVIDTTL:   time.Duration(e.X509SVIDTTL) * time.Second,
+		JWTSVIDTTL:    time.Duration(e.JWTSVIDTTL) * time.Second,
+		Admin:         e.Admin,
+		Downstream:    e.Downstream,
+		Hint:          e.Hint,
+	}
+	if e.ExpiresAt != "" && e.ExpiresAt != "0" {
+		expiresAt, err := strconv.ParseInt(e.ExpiresAt, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse entry expiry timestamp %q: %w", e.ExpiresAt, err)
+		}
+		entry.ExpiresAt = time.Unix(expiresAt, 0)
+	}
+	return entry, nil
 }
 
 func parseEntryList(output []byte) (*entryListJson, error) {
@@ -119,6 +193,25 @@ func parseEntryList(output []byte) (*entryListJson, error) {
 	return entries, nil
 }
 
+// parseEntries parses the output of `spire-server entry show -output json` and returns a slice of
+// `*Entry`.
+func parseEntries(output []byte) ([]*Entry, error) {
+	entries, err := parseEntryList(output)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Entry, 0, len(entries.Entries))
+	for _, entry := range entries.Entries {
+		e, err := entry.toEntry()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+	return result, nil
+}
+
 type bundleJson struct {
 	JwtAuthorities  []jwtAuthorityJson  `json:"jwt_authorities"`
 	RefreshHint     string              `json:"refresh_hint"`
@@ -207,3 +300,65 @@ func parseBundleShow(output []byte) (*types.Bundle, error) {
 	}
 	return bundle.toBundle()
 }
+
+// tokenGenerateJson represents the JSON-formatted output of the SPIRE server token generate command.
+type tokenGenerateJson struct {
+	Value     string `json:"value"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// parseTokenGenerate parses the output of the 'token generate -output json' command.
+// defaultExpiresAt is used if the output does not include an expiry time.
+func parseTokenGenerate(output []byte, defaultExpiresAt time.Time) (*JoinToken, error) {
+	token := &tokenGenerateJson{}
+	if err := json.Unmarshal(output, token); err != nil {
+		return nil, err
+	}
+	if token.Value == "" {
+		return nil, fmt.Errorf("no join token in SPIRE server output")
+	}
+
+	expiresAt := defaultExpiresAt
+	if token.ExpiresAt != "" {
+		seconds, err := strconv.ParseInt(token.ExpiresAt, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		expiresAt = time.Unix(seconds, 0)
+	}
+	return &JoinToken{Value: token.Value, ExpiresAt: expiresAt}, nil
+}
+
+// getAttestedJoinTokens returns the join tokens of agents attested using the join_token node
+// attestor, whose SPIFFE IDs end with the token, along with the IDs of any entries that alias them.
+func getAttestedJoinTokens(agents []Agent, entries *entryListJson) ([]*AttestedJoinToken, error) {
+	aliases := map[string][]string{}
+	for _, entry := range entries.Entries {
+		if entry.ParentId == nil || entry.Id == nil {
+			continue
+		}
+		parentID, err := formatIdUrl(entry.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		id, err := formatIdUrl(entry.Id)
+		if err != nil {
+			return nil, err
+		}
+		aliases[parentID] = append(aliases[parentID], id)
+	}
+
+	tokens := []*AttestedJoinToken{}
+	for _, agent := range agents {
+		if agent.AttestationType != joinTokenAttestationType {
+			continue
+		}
+		tokens = append(tokens, &AttestedJoinToken{
+			Value:          path.Base(agent.Id),
+			AgentID:        agent.Id,
+			AliasIDs:       aliases[agent.Id],
+			ExpirationTime: agent.ExpirationTime,
+		})
+	}
+	return tokens, nil
+}
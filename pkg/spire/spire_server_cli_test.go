@@ -91,6 +91,7 @@ func Test_parseAgentList(t *testing.T) {
 					ExpirationTime:  time.Unix(1729275243, 0),
 					Serial:          "281715470147913728055350377728086773688",
 					CanReattest:     true,
+					Selectors:       oneAgentSelectors,
 				},
 			},
 			wantErr: false,
@@ -111,6 +112,68 @@ func Test_parseAgentList(t *testing.T) {
 	}
 }
 
+var oneAgentSelectors = []string{
+	"k8s_psat:agent_node_ip:172.18.0.3",
+	"k8s_psat:agent_node_name:connect-control-plane",
+	"k8s_psat:agent_node_uid:831b9aa2-de44-4f20-bd61-238e756600ce",
+	"k8s_psat:agent_ns:spire-system",
+	"k8s_psat:agent_pod_name:spire-agent-52plm",
+	"k8s_psat:agent_pod_uid:5ca6358e-9c57-4b26-84e8-fbbb57dd4c6f",
+	"k8s_psat:agent_sa:spire-agent",
+	"k8s_psat:cluster:connect",
+}
+
+func Test_parseAgentShow(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    *Agent
+		wantErr bool
+	}{
+		{
+			name: "banned agent",
+			output: `{
+  "attestation_type": "join_token",
+  "banned": true,
+  "can_reattest": false,
+  "id": {
+    "path": "/spire/agent/join_token/fake-token",
+    "trust_domain": "td1"
+  },
+  "selectors": [],
+  "x509svid_expires_at": "1729275243",
+  "x509svid_serial_number": "1234"
+}`,
+			want: &Agent{
+				Name:            "unknown",
+				Status:          "unknown",
+				Id:              "spiffe://td1/spire/agent/join_token/fake-token",
+				AttestationType: "join_token",
+				ExpirationTime:  time.Unix(1729275243, 0),
+				Serial:          "1234",
+				Banned:          true,
+				Selectors:       []string{},
+			},
+		},
+		{
+			name:    "no agent",
+			output:  `{}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAgentShow([]byte(tt.output))
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 var bundleShow = `{
   "jwt_authorities": [
     {
@@ -189,3 +252,187 @@ func Test_parseBundleShow(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseTokenGenerate(t *testing.T) {
+	defaultExpiresAt := time.Unix(1700000000, 0)
+	tests := []struct {
+		name    string
+		output  string
+		want    *JoinToken
+		wantErr bool
+	}{
+		{
+			name:   "with expiry",
+			output: `{"value": "fake-token", "expires_at": "1700003600"}`,
+			want:   &JoinToken{Value: "fake-token", ExpiresAt: time.Unix(1700003600, 0)},
+		},
+		{
+			name:   "without expiry",
+			output: `{"value": "fake-token"}`,
+			want:   &JoinToken{Value: "fake-token", ExpiresAt: defaultExpiresAt},
+		},
+		{
+			name:    "no value",
+			output:  `{}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid expiry",
+			output:  `{"value": "fake-token", "expires_at": "invalid"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTokenGenerate([]byte(tt.output), defaultExpiresAt)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_getAttestedJoinTokens(t *testing.T) {
+	expirationTime := time.Unix(1700000000, 0)
+	agents := []Agent{
+		{
+			Id:              "spiffe://td1/spire/agent/join_token/token1",
+			AttestationType: "join_token",
+			ExpirationTime:  expirationTime,
+		},
+		{
+			Id:              "spiffe://td1/spire/agent/join_token/token2",
+			AttestationType: "join_token",
+			ExpirationTime:  expirationTime,
+		},
+		{
+			Id:              "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			AttestationType: "k8s_psat",
+			ExpirationTime:  expirationTime,
+		},
+	}
+	entries := &entryListJson{
+		Entries: []entryJson{
+			{
+				Id:       &types.SPIFFEID{TrustDomain: "td1", Path: "/vm1"},
+				ParentId: &types.SPIFFEID{TrustDomain: "td1", Path: "/spire/agent/join_token/token1"},
+			},
+			{
+				Id:       &types.SPIFFEID{TrustDomain: "td1", Path: "/ns/ns1/sa/sa1"},
+				ParentId: &types.SPIFFEID{TrustDomain: "td1", Path: "/spire/agent/k8s_psat/local1/node1"},
+			},
+		},
+	}
+
+	got, err := getAttestedJoinTokens(agents, entries)
+	require.NoError(t, err)
+	want := []*AttestedJoinToken{
+		{
+			Value:          "token1",
+			AgentID:        "spiffe://td1/spire/agent/join_token/token1",
+			AliasIDs:       []string{"spiffe://td1/vm1"},
+			ExpirationTime: expirationTime,
+		},
+		{
+			Value:          "token2",
+			AgentID:        "spiffe://td1/spire/agent/join_token/token2",
+			ExpirationTime: expirationTime,
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func Test_parseEntries(t *testing.T) {
+	output := `{
+  "entries": [
+    {
+      "id": "entry1",
+      "spiffe_id": {
+        "trust_domain": "td1",
+        "path": "/ns/ns1/sa/sa1"
+      },
+      "parent_id": {
+        "trust_domain": "td1",
+        "path": "/spire/agent/k8s_psat/local1/node1"
+      },
+      "selectors": [
+        {
+          "type": "k8s",
+          "value": "ns:ns1"
+        },
+        {
+          "type": "k8s",
+          "value": "pod-uid:1234"
+        }
+      ],
+      "x509_svid_ttl": 3600,
+      "federates_with": [
+        "td2"
+      ],
+      "admin": false,
+      "downstream": false,
+      "expires_at": "0",
+      "dns_names": [
+        "foo.ns1.svc"
+      ],
+      "revision_number": "0",
+      "store_svid": false,
+      "jwt_svid_ttl": 300,
+      "hint": "",
+      "created_at": "1729275243"
+    },
+    {
+      "id": "entry2",
+      "spiffe_id": {
+        "trust_domain": "td1",
+        "path": "/vm1"
+      },
+      "parent_id": {
+        "trust_domain": "td1",
+        "path": "/spire/agent/join_token/token1"
+      },
+      "selectors": [
+        {
+          "type": "unix",
+          "value": "uid:1000"
+        }
+      ],
+      "admin": true,
+      "downstream": true,
+      "expires_at": "1729275243",
+      "hint": "vm"
+    }
+  ]
+}`
+	got, err := parseEntries([]byte(output))
+	require.NoError(t, err)
+	want := []*Entry{
+		{
+			ID:            "entry1",
+			SPIFFEID:      "spiffe://td1/ns/ns1/sa/sa1",
+			ParentID:      "spiffe://td1/spire/agent/k8s_psat/local1/node1",
+			Selectors:     []string{"k8s:ns:ns1", "k8s:pod-uid:1234"},
+			DNSNames:      []string{"foo.ns1.svc"},
+			FederatesWith: []string{"td2"},
+			X509SVIDTTL:   time.Hour,
+			JWTSVIDTTL:    5 * time.Minute,
+		},
+		{
+			ID:         "entry2",
+			SPIFFEID:   "spiffe://td1/vm1",
+			ParentID:   "spiffe://td1/spire/agent/join_token/token1",
+			Selectors:  []string{"unix:uid:1000"},
+			Admin:      true,
+			Downstream: true,
+			Hint:       "vm",
+			ExpiresAt:  time.Unix(1729275243, 0),
+		},
+	}
+	assert.Equal(t, want, got)
+
+	_, err = parseEntries([]byte(`{"entries": [{"id": "entry1"}]}`))
+	require.EqualError(t, err, "entry entry1 has no SPIFFE ID or parent ID")
+}
@@ -20,7 +20,6 @@ TRUST_ZONE=${TRUST_ZONE:-tz1}
 TRUST_DOMAIN=${TRUST_DOMAIN:-td1}
 
 AGENT_ID_PATH=test-agent
-AGENT_ID=spiffe://$TRUST_DOMAIN/$AGENT_ID_PATH
 AGENT_NAME=$TRUST_DOMAIN-spire-agent
 
 PING_PONG_CLIENT_ID_PATH=app/ping-pong-client
@@ -62,21 +61,19 @@ function configure() {
 }
 
 function configure_spire_agent() {
-  mkdir -p $CONF_DIR
-  kubectl --context $K8S_CLUSTER_CONTEXT -n spire-server \
-    exec spire-server-0 -- \
-    spire-server bundle show > $CONF_DIR/bundle.crt
-  local server_address=$(kubectl --context $K8S_CLUSTER_CONTEXT -n spire-server \
-    get svc spire-server \
-    -o jsonpath='{.status.loadBalancer.ingress[0].ip}')
-  local join_token=$(kubectl --context $K8S_CLUSTER_CONTEXT -n spire-server \
-    exec spire-server-0 -- \
-    spire-server token generate -spiffeID $AGENT_ID -output json \
-    | jq -er .value)
-  TRUST_DOMAIN=$TRUST_DOMAIN \
-    SERVER_ADDRESS=$server_address \
-    JOIN_TOKEN=$join_token \
-    envsubst <$TEST_DIR/templates/agent.conf >$CONF_DIR/agent.conf
+  local join_token=$(./cofidectl agent token create \
+    --trust-zone $TRUST_ZONE \
+    --spiffe-id $AGENT_ID_PATH \
+    --output value)
+  ./cofidectl agent config \
+    --trust-zone $TRUST_ZONE \
+    --output-dir $CONF_DIR \
+    --socket-path /opt/spire/conf/spire-agent.sock \
+    --agent-log-level DEBUG \
+    --join-token $join_token \
+    --workload-attestor docker
+  # The agent container may run as a different user.
+  chmod a+r $CONF_DIR/agent.conf
 }
 
 function deploy_spire_agent() {
@@ -96,6 +96,7 @@ function show_workload_status() {
     --context $K8S_CLUSTER_1_CONTEXT)
   WORKLOAD_STATUS_RESPONSE=$(./cofidectl workload status --namespace $NAMESPACE_POLICY_NAMESPACE \
     --pod-name $POD_NAME \
+    --debug-container \
     --trust-zone $TRUST_ZONE_1)
 
   if [[ $WORKLOAD_STATUS_RESPONSE != *"SVID verified against trust bundle"* ]]; then
@@ -64,6 +64,7 @@ function show_workload_status() {
     --context $K8S_CLUSTER_CONTEXT)
   WORKLOAD_STATUS_RESPONSE=$(./cofidectl workload status --namespace $NAMESPACE_POLICY_NAMESPACE \
     --pod-name $POD_NAME \
+    --debug-container \
     --trust-zone $TRUST_ZONE)
 
   if [[ $WORKLOAD_STATUS_RESPONSE != *"SVID verified against trust bundle"* ]]; then